@@ -163,6 +163,11 @@ module.exports = {
                 },
             ]
             },
+            {
+              title: "Verified Queries",
+              directory: false,
+              path: "/apps/verified-queries/overview.html"
+            },
           ]
         },
         {
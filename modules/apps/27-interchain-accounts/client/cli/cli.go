@@ -24,3 +24,20 @@ func GetQueryCmd() *cobra.Command {
 
 	return icaQueryCmd
 }
+
+// GetTxCmd returns the transaction commands for the interchain-accounts submodule
+func GetTxCmd() *cobra.Command {
+	icaTxCmd := &cobra.Command{
+		Use:                        "interchain-accounts",
+		Aliases:                    []string{"ica"},
+		Short:                      "interchain-accounts subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	icaTxCmd.AddCommand(
+		controllercli.NewTxCmd(),
+	)
+
+	return icaTxCmd
+}
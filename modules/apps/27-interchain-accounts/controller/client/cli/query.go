@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 )
 
 // GetCmdParams returns the command handler for the controller submodule parameter querying.
@@ -39,3 +41,145 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdInterchainAccount returns the command handler for querying the interchain account address of an owner on a connection.
+func GetCmdInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [owner] [connection-id]",
+		Short:   "Query the interchain account address of an owner on a connection",
+		Long:    "Query the interchain account address of an owner, registered on the provided connection of the controller chain",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccounts returns the command handler for querying the interchain accounts registered on a connection.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts [connection-id]",
+		Short:   "Query the interchain accounts registered on a connection",
+		Long:    "Query the interchain accounts registered on the provided connection of the controller chain",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-accounts connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInterchainAccountsRequest{
+				ConnectionId: args[0],
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdActiveChannel returns the command handler for querying the active channel of a controller port on a connection.
+func GetCmdActiveChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-channel [port-id] [connection-id]",
+		Short:   "Query the active channel of a controller port on a connection",
+		Long:    "Query the active interchain accounts channel of the provided controller port, on the provided connection of the controller chain",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller active-channel icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryActiveChannelRequest{
+				PortId:       args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.ActiveChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDecodeAcknowledgement returns the command handler for decoding a structured interchain accounts acknowledgement.
+func GetCmdDecodeAcknowledgement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-ack [acknowledgement]",
+		Short: "Decode a structured interchain accounts acknowledgement",
+		Long: `Decode and print a structured interchain accounts acknowledgement, returned by a host chain on a channel using the ics27-ack-1 acknowledgement version.
+The acknowledgement may be provided as JSON or hex encoded, as in the packet_ack and packet_ack_hex attributes of the write_acknowledgement event.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller decode-ack '{\"code\":0,\"codespace\":\"\",\"failed_index\":\"-1\",\"height\":\"100\",\"results\":[],\"version\":\"ics27-ack-1\"}'", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := hex.DecodeString(args[0])
+			if err != nil {
+				bz = []byte(args[0])
+			}
+
+			ack, err := icatypes.UnmarshalAcknowledgement(bz)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&ack)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+const (
+	flagVersion               = "version"
+	flagOrdering              = "ordering"
+	flagRelativePacketTimeout = "relative-packet-timeout"
+)
+
+// defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+// relative to the current block timestamp of the controller chain. The default is 10 minutes.
+var defaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+// NewRegisterInterchainAccountCmd returns the command to create a MsgRegisterInterchainAccount transaction
+func NewRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Short: "Register an interchain account on the provided connection",
+		Long: strings.TrimSpace(`Register an interchain account on the provided connection. The signer of the transaction
+is the owner of the interchain account. A channel is opened for the interchain account, owned by the controller submodule.
+The default interchain accounts metadata is used as the channel version unless the "version" flag is set.
+An ORDERED channel is opened unless the "ordering" flag is set to ORDER_UNORDERED.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller register connection-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			connectionID := args[0]
+
+			version, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			orderingStr, err := cmd.Flags().GetString(flagOrdering)
+			if err != nil {
+				return err
+			}
+
+			ordering, found := channeltypes.Order_value[strings.ToUpper(orderingStr)]
+			if !found {
+				return fmt.Errorf("invalid channel ordering %s, expected %s or %s", orderingStr, channeltypes.ORDERED, channeltypes.UNORDERED)
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, version, channeltypes.Order(ordering))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVersion, "", "Controller chain channel version, the default interchain accounts metadata is used if empty.")
+	cmd.Flags().String(flagOrdering, channeltypes.ORDERED.String(), fmt.Sprintf("Channel ordering, can be one of: %s, %s", channeltypes.ORDERED, channeltypes.UNORDERED))
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSendTxCmd returns the command to create a MsgSendTx transaction
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [connection-id] [path/to/packet_msg.json]",
+		Short: "Send an interchain account tx on the provided connection",
+		Long: strings.TrimSpace(`Send an interchain account tx on the provided connection. The signer of the transaction
+is the owner of the interchain account. The interchain account packet data is provided as a JSON file, or as a JSON string.
+The packet timeout timestamp is relative to the block time of the controller chain when the transaction is executed.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller send-tx connection-0 packet-data.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			connectionID := args[0]
+
+			// attempt to read the packet data from a file, otherwise treat the argument as a JSON string
+			packetDataBz, err := os.ReadFile(args[1])
+			if err != nil {
+				packetDataBz = []byte(args[1])
+			}
+
+			var packetData icatypes.InterchainAccountPacketData
+			if err := clientCtx.Codec.UnmarshalJSON(packetDataBz, &packetData); err != nil {
+				return fmt.Errorf("failed to unmarshal interchain account packet data: %w", err)
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendTx(owner, connectionID, relativeTimeout, packetData)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativePacketTimeout, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from the block time of the controller chain. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCloseChannelCmd returns the command to create a MsgCloseChannel transaction
+func NewCloseChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-channel [connection-id]",
+		Short: "Close the active channel of an interchain account on the provided connection",
+		Long: strings.TrimSpace(`Close the active channel of an interchain account on the provided connection. The signer of the transaction
+is the owner of the interchain account. The channel must be owned by the controller submodule. The interchain account
+may be used again once the channel is reopened with the "reopen-channel" command.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller close-channel connection-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCloseChannel(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewReopenChannelCmd returns the command to create a MsgReopenChannel transaction
+func NewReopenChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen-channel [connection-id]",
+		Short: "Reopen the closed active channel of an interchain account on the provided connection",
+		Long: strings.TrimSpace(`Reopen the closed active channel of an interchain account on the provided connection. The signer of the
+transaction is the owner of the interchain account. A new channel is opened with the metadata of the closed channel,
+bound to the existing interchain account address. The ordering of the closed channel is used unless the "ordering" flag
+is set, an ORDER_ORDERED channel may be reopened as an ORDER_UNORDERED channel.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller reopen-channel connection-0 --ordering ORDER_UNORDERED", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderingStr, err := cmd.Flags().GetString(flagOrdering)
+			if err != nil {
+				return err
+			}
+
+			ordering := channeltypes.NONE
+			if orderingStr != "" {
+				order, found := channeltypes.Order_value[strings.ToUpper(orderingStr)]
+				if !found {
+					return fmt.Errorf("invalid channel ordering %s, expected %s or %s", orderingStr, channeltypes.ORDERED, channeltypes.UNORDERED)
+				}
+
+				ordering = channeltypes.Order(order)
+			}
+
+			msg := types.NewMsgReopenChannel(clientCtx.GetFromAddress().String(), args[0], ordering)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagOrdering, "", fmt.Sprintf("Channel ordering, can be one of: %s, %s. The ordering of the closed channel is used if empty.", channeltypes.ORDERED, channeltypes.UNORDERED))
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
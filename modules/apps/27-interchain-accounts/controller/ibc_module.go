@@ -10,6 +10,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -19,7 +20,9 @@ type IBCModule struct {
 	app    porttypes.IBCModule
 }
 
-// NewIBCModule creates a new IBCModule given the associated keeper and underlying application
+// NewIBCModule creates a new IBCModule given the associated keeper and underlying application.
+// The underlying application may be nil, in which case interchain accounts may only be registered
+// and used through the controller Msg service.
 func NewIBCModule(k keeper.Keeper, app porttypes.IBCModule) IBCModule {
 	return IBCModule{
 		keeper: k,
@@ -33,6 +36,9 @@ func NewIBCModule(k keeper.Keeper, app porttypes.IBCModule) IBCModule {
 // the controller side. The connected modules may not change the controller side portID or
 // version. They will be allowed to perform custom logic without changing
 // the parameters stored within a channel struct.
+//
+// The channel capability of channels registered through the controller Msg service is claimed
+// by the controller submodule and the underlying application callbacks are not executed.
 func (im IBCModule) OnChanOpenInit(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -51,6 +57,10 @@ func (im IBCModule) OnChanOpenInit(
 		return err
 	}
 
+	if !im.isMiddlewareEnabled(ctx, portID, connectionHops[0]) {
+		return im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+	}
+
 	// call underlying app's OnChanOpenInit callback with the appVersion
 	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
 		chanCap, counterparty, version)
@@ -91,6 +101,15 @@ func (im IBCModule) OnChanOpenAck(
 		return err
 	}
 
+	connectionID, err := im.keeper.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if !im.isMiddlewareEnabled(ctx, portID, connectionID) {
+		return nil
+	}
+
 	// call underlying app's OnChanOpenAck callback with the counterparty app version.
 	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
 }
@@ -110,6 +129,11 @@ func (im IBCModule) OnChanCloseInit(
 	portID,
 	channelID string,
 ) error {
+	// Allow the controller submodule to close the active channel of an interchain account on MsgCloseChannel
+	if im.keeper.IsClosingActiveChannel(ctx, portID, channelID) {
+		return nil
+	}
+
 	// Disallow user-initiated channel closing for interchain account channels
 	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
 }
@@ -143,6 +167,15 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return types.ErrControllerSubModuleDisabled
 	}
 
+	connectionID, err := im.keeper.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	if !im.isMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
+		return nil
+	}
+
 	// call underlying app's OnAcknowledgementPacket callback.
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
@@ -161,5 +194,32 @@ func (im IBCModule) OnTimeoutPacket(
 		return err
 	}
 
+	connectionID, err := im.keeper.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	if !im.isMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
+		return nil
+	}
+
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
+
+// isMiddlewareEnabled returns true if the underlying application callbacks must be executed for the
+// given port and connection identifiers. This is not the case if no underlying application is set or
+// if the channel was registered through the controller Msg service.
+func (im IBCModule) isMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) bool {
+	return im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, portID, connectionID)
+}
+
+// UnmarshalPacketData decodes the interchain account packet data. It implements the PacketDataUnmarshaler
+// interface of the callbacks middleware.
+func (im IBCModule) UnmarshalPacketData(_ sdk.Context, _, _ string, bz []byte) (interface{}, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal interchain account packet data: %s", err.Error())
+	}
+
+	return &data, nil
+}
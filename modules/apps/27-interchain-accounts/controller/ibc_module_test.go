@@ -137,8 +137,13 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 			}, false,
 		},
 		{
-			"ICA OnChanOpenInit fails - UNORDERED channel", func() {
+			"success: UNORDERED channel", func() {
 				channel.Ordering = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"ICA OnChanOpenInit fails - channel order not set", func() {
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 		{
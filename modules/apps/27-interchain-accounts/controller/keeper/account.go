@@ -5,67 +5,212 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // RegisterInterchainAccount is the entry point to registering an interchain account.
-// It generates a new port identifier using the owner address. It will bind to the 
+// It generates a new port identifier using the owner address. It will bind to the
 // port identifier and call 04-channel 'ChanOpenInit'. An error is returned if the port
 // identifier is already in use. Gaining access to interchain accounts whose channels
 // have closed cannot be done with this function. A regular MsgChanOpenInit must be used.
+// The callbacks of the underlying application are enabled for the channel, which is
+// owned by the underlying application.
 func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner string) error {
+	return k.RegisterInterchainAccountWithOrdering(ctx, connectionID, owner, channeltypes.ORDERED)
+}
+
+// RegisterInterchainAccountWithOrdering performs the same steps as RegisterInterchainAccount,
+// opening a channel with the provided ordering. UNORDERED channels are not closed when a packet
+// times out, allowing the interchain account to continue to be used without reopening the channel.
+func (k Keeper) RegisterInterchainAccountWithOrdering(ctx sdk.Context, connectionID, owner string, ordering channeltypes.Order) error {
 	portID, err := icatypes.NewControllerPortID(owner)
 	if err != nil {
 		return err
 	}
 
+	k.SetMiddlewareEnabled(ctx, portID, connectionID)
+
+	_, err = k.registerInterchainAccount(ctx, connectionID, portID, "", ordering)
+	return err
+}
+
+// registerInterchainAccount binds to the provided port identifier if it is not yet bound and
+// calls 04-channel 'ChanOpenInit' with the provided version and ordering. The default interchain
+// accounts metadata is used if the version is empty. The identifier of the channel is returned.
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID, version string, ordering channeltypes.Order) (string, error) {
 	// if there is an active channel for this portID / connectionID return an error
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if found {
-		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s", activeChannelID, portID, connectionID, owner)
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s", activeChannelID, portID, connectionID)
 	}
 
 	switch {
 	case k.portKeeper.IsBound(ctx, portID) && !k.IsBound(ctx, portID):
-		return sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
+		return "", sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
 	case !k.portKeeper.IsBound(ctx, portID):
 		cap := k.BindPort(ctx, portID)
 		if err := k.ClaimCapability(ctx, cap, host.PortPath(portID)); err != nil {
-			return sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
+			return "", sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
 		}
 	}
 
-	connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
-	if err != nil {
-		return err
+	if version == "" {
+		connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
+		if err != nil {
+			return "", err
+		}
+
+		// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
+		metadata := icatypes.NewMetadata(
+			icatypes.Version,
+			connectionID,
+			connectionEnd.GetCounterparty().GetConnectionID(),
+			"",
+			icatypes.EncodingProtobuf,
+			icatypes.TxTypeSDKMultiMsg,
+		)
+
+		versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+		if err != nil {
+			return "", err
+		}
+
+		version = string(versionBytes)
 	}
 
-	// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
-	metadata := icatypes.NewMetadata(
-		icatypes.Version,
-		connectionID,
-		connectionEnd.GetCounterparty().GetConnectionID(),
-		"",
-		icatypes.EncodingProtobuf,
-		icatypes.TxTypeSDKMultiMsg,
-	)
+	msg := channeltypes.NewMsgChannelOpenInit(portID, version, ordering, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
+	handler := k.msgRouter.Handler(msg)
 
-	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+	res, err := handler(ctx, msg)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	msg := channeltypes.NewMsgChannelOpenInit(portID, string(versionBytes), channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
+	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	var channelOpenInitResponse channeltypes.MsgChannelOpenInitResponse
+	if err := k.cdc.Unmarshal(res.Data, &channelOpenInitResponse); err != nil {
+		return "", err
+	}
+
+	return channelOpenInitResponse.ChannelId, nil
+}
+
+// closeActiveChannelKey is the context key under which closeActiveChannel records the channel it closes
+type closeActiveChannelKey struct{}
+
+// closeActiveChannel calls 04-channel 'ChanCloseInit' for the OPEN active channel of the provided connection and port
+// identifiers through the core IBC msg handler, such that the close callbacks of the middleware wrapping the interchain
+// accounts stack are executed, for instance to refund the escrowed fees of the channel. The interchain accounts
+// OnChanCloseInit callback, which disallows user-initiated channel closing, allows the channel closed by this function.
+// The active channel is kept in state, such that it may be reopened with reopenActiveChannel. The identifier of the
+// closed channel is returned.
+func (k Keeper) closeActiveChannel(ctx sdk.Context, connectionID, portID string) (string, error) {
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve open active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	msg := channeltypes.NewMsgChannelCloseInit(portID, activeChannelID, icatypes.ModuleName)
 	handler := k.msgRouter.Handler(msg)
 
-	res, err := handler(ctx, msg)
+	res, err := handler(ctx.WithValue(closeActiveChannelKey{}, host.ChannelPath(portID, activeChannelID)), msg)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
-	return nil
+	return activeChannelID, nil
+}
+
+// IsClosingActiveChannel returns true if the provided channel is being closed by the controller submodule, as the
+// open active channel of an interchain account whose owner sent MsgCloseChannel.
+func (k Keeper) IsClosingActiveChannel(ctx sdk.Context, portID, channelID string) bool {
+	channelPath, ok := ctx.Value(closeActiveChannelKey{}).(string)
+	return ok && channelPath == host.ChannelPath(portID, channelID)
+}
+
+// reopenActiveChannel calls 04-channel 'ChanOpenInit' for a new channel replacing the CLOSED active channel of the
+// provided connection and port identifiers. The new channel uses the provided ordering, or the ordering of the closed
+// channel if it is unspecified, and the metadata of the closed channel with the interchain account address stored in
+// state, thus the interchain account is unchanged. The version of a fee enabled channel is wrapped in the fee middleware
+// metadata again, such that fees remain enabled. The new channel is set as the active channel once the handshake
+// completes. The identifier of the new channel is returned.
+func (k Keeper) reopenActiveChannel(ctx sdk.Context, connectionID, portID string, ordering channeltypes.Order) (string, error) {
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", activeChannelID, portID)
+	}
+
+	if channel.State != channeltypes.CLOSED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "expected active channel %s to be in %s state, got %s", activeChannelID, channeltypes.CLOSED, channel.State)
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on connection %s for port %s", connectionID, portID)
+	}
+
+	// the version of the channel may be wrapped by the fee middleware, the interchain accounts metadata is read from
+	// the application version underneath any middleware
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, portID, activeChannelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve application version of channel %s on port %s", activeChannelID, portID)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &metadata); err != nil {
+		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	metadata.Address = address
+
+	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+	if err != nil {
+		return "", err
+	}
+
+	version, err := wrapFeeVersion(channel.Version, appVersion, string(versionBytes))
+	if err != nil {
+		return "", err
+	}
+
+	if ordering == channeltypes.NONE {
+		ordering = channel.Ordering
+	}
+
+	return k.registerInterchainAccount(ctx, connectionID, portID, version, ordering)
+}
+
+// wrapFeeVersion returns the provided application version wrapped in the fee middleware metadata of the channel
+// version, such that fees remain enabled on a reopened channel. The application version is returned unchanged
+// if the channel version is not wrapped.
+func wrapFeeVersion(channelVersion, previousAppVersion, appVersion string) (string, error) {
+	if channelVersion == previousAppVersion {
+		return appVersion, nil
+	}
+
+	var feeMetadata feetypes.Metadata
+	if err := feetypes.ModuleCdc.UnmarshalJSON([]byte(channelVersion), &feeMetadata); err != nil || feeMetadata.AppVersion != previousAppVersion {
+		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unwrap channel version %s", channelVersion)
+	}
+
+	feeMetadata.AppVersion = appVersion
+
+	versionBytes, err := feetypes.ModuleCdc.MarshalJSON(&feeMetadata)
+	if err != nil {
+		return "", err
+	}
+
+	return string(versionBytes), nil
 }
@@ -13,10 +13,12 @@ import (
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
-// The channel order must be ORDERED, the counterparty port identifier
+// The channel order must be ORDERED or UNORDERED, the counterparty port identifier
 // must be the host chain representation as defined in the types package,
 // the channel version must be equal to the version in the types package,
 // there must not be an active channel for the specfied port identifier,
+// a previously active channel may only be reopened with the same ordering,
+// or as an UNORDERED channel if it was ORDERED,
 // and the interchain accounts module must be able to claim the channel
 // capability.
 func (k Keeper) OnChanOpenInit(
@@ -29,8 +31,8 @@ func (k Keeper) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) error {
-	if order != channeltypes.ORDERED {
-		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.UNORDERED, order)
 	}
 
 	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
@@ -61,7 +63,13 @@ func (k Keeper) OnChanOpenInit(
 			return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is already OPEN", activeChannelID, portID)
 		}
 
-		if !icatypes.IsPreviousMetadataEqual(channel.Version, metadata) {
+		if !icatypes.IsPreviousOrderingCompatible(channel.Ordering, order) {
+			return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "channel ordering cannot change from %s to %s when reopening a channel", channel.Ordering, order)
+		}
+
+		// the version of a fee enabled channel is wrapped in the fee middleware metadata
+		appVersion, _ := k.ics4Wrapper.GetAppVersion(ctx, portID, activeChannelID)
+		if !icatypes.IsPreviousMetadataEqual(appVersion, metadata) {
 			return sdkerrors.Wrap(icatypes.ErrInvalidVersion, "previous active channel metadata does not match provided version")
 		}
 	}
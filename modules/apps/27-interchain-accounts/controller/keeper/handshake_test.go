@@ -76,9 +76,55 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			false,
 		},
 		{
-			"invalid order - UNORDERED",
+			"success - UNORDERED channel",
 			func() {
 				channel.Ordering = channeltypes.UNORDERED
+				path.EndpointA.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"success - previous ORDERED active channel reopened as UNORDERED",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+				counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				closedChannel := channeltypes.Channel{
+					State:          channeltypes.CLOSED,
+					Ordering:       channeltypes.ORDERED,
+					Counterparty:   counterparty,
+					ConnectionHops: []string{path.EndpointA.ConnectionID},
+					Version:        TestVersion,
+				}
+
+				path.EndpointA.SetChannel(closedChannel)
+
+				channel.Ordering = channeltypes.UNORDERED
+			},
+			true,
+		},
+		{
+			"invalid order - previous UNORDERED active channel reopened as ORDERED",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+				counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				closedChannel := channeltypes.Channel{
+					State:          channeltypes.CLOSED,
+					Ordering:       channeltypes.UNORDERED,
+					Counterparty:   counterparty,
+					ConnectionHops: []string{path.EndpointA.ConnectionID},
+					Version:        TestVersion,
+				}
+
+				path.EndpointA.SetChannel(closedChannel)
+			},
+			false,
+		},
+		{
+			"invalid order - NONE",
+			func() {
+				channel.Ordering = channeltypes.NONE
 			},
 			false,
 		},
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// This migration indexes the addresses of all the registered interchain accounts by connection.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	for _, account := range m.keeper.GetAllInterchainAccounts(ctx) {
+		m.keeper.SetInterchainAccountAddress(ctx, account.ConnectionId, account.PortId, account.AccountAddress)
+	}
+
+	return nil
+}
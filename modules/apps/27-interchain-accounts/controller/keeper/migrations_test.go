@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	ctx := suite.chainA.GetContext()
+
+	// store the interchain account addresses without the connection index, as in version 1
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Set(icatypes.KeyOwnerAccount(TestPortID, ibctesting.FirstConnectionID), []byte(TestAccAddress.String()))
+	store.Set(icatypes.KeyOwnerAccount(TestPortID, "connection-1"), []byte(TestAccAddress.String()))
+
+	req := &types.QueryInterchainAccountsRequest{ConnectionId: ibctesting.FirstConnectionID}
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.InterchainAccounts)
+
+	migrator := keeper.NewMigrator(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	err = migrator.Migrate1to2(ctx)
+	suite.Require().NoError(err)
+
+	res, err = suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]icatypes.IdentifiedInterchainAccount{icatypes.NewIdentifiedInterchainAccount(ibctesting.FirstConnectionID, TestPortID, TestAccAddress.String())}, res.InterchainAccounts)
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the interchain accounts controller MsgServer interface
+// for the provided Keeper
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
+// The channel opened for the interchain account is owned by the controller submodule,
+// which claims the channel capability. The callbacks of the underlying application are
+// disabled for the channel.
+func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SetMiddlewareDisabled(ctx, portID, msg.ConnectionId)
+
+	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version, msg.GetOrdering())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully registered interchain account channel", "port-id", portID, "channel-id", channelID, "ordering", msg.GetOrdering())
+
+	return &types.MsgRegisterInterchainAccountResponse{
+		ChannelId: channelID,
+	}, nil
+}
+
+// SendTx defines a rpc handler for MsgSendTx.
+// The packet is sent over the active channel of the interchain account, which must be owned by the
+// controller submodule. The absolute timeout timestamp of the packet is obtained by adding the relative
+// timeout to the current block time.
+func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.IsMiddlewareEnabled(ctx, portID, msg.ConnectionId) {
+		return nil, sdkerrors.Wrapf(types.ErrMiddlewareEnabled, "channel on port %s and connection %s must be used through the underlying application", portID, msg.ConnectionId)
+	}
+
+	activeChannelID, found := s.GetOpenActiveChannel(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", msg.ConnectionId, portID)
+	}
+
+	chanCap, found := s.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, activeChannelID))
+	if !found {
+		return nil, sdkerrors.Wrapf(capabilitytypes.ErrCapabilityNotOwned, "could not retrieve channel capability for channel %s on port %s", activeChannelID, portID)
+	}
+
+	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+	sequence, err := s.Keeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendTxResponse{
+		Sequence: sequence,
+	}, nil
+}
+
+// CloseChannel defines a rpc handler for MsgCloseChannel.
+// The open active channel of the interchain account, which must be owned by the controller
+// submodule, is closed. The interchain account may be used again once the channel is reopened
+// with MsgReopenChannel.
+func (s msgServer) CloseChannel(goCtx context.Context, msg *types.MsgCloseChannel) (*types.MsgCloseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.IsMiddlewareEnabled(ctx, portID, msg.ConnectionId) {
+		return nil, sdkerrors.Wrapf(types.ErrMiddlewareEnabled, "channel on port %s and connection %s must be closed through the underlying application", portID, msg.ConnectionId)
+	}
+
+	channelID, err := s.closeActiveChannel(ctx, msg.ConnectionId, portID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully closed interchain account channel", "port-id", portID, "channel-id", channelID)
+
+	return &types.MsgCloseChannelResponse{
+		ChannelId: channelID,
+	}, nil
+}
+
+// ReopenChannel defines a rpc handler for MsgReopenChannel.
+// A new channel is opened for the interchain account, whose active channel must be owned by the
+// controller submodule and CLOSED. The new channel reuses the metadata of the closed channel and the
+// interchain account address stored in state. An ORDERED channel may be reopened as UNORDERED, the
+// ordering of the closed channel is used if the message ordering is unspecified.
+func (s msgServer) ReopenChannel(goCtx context.Context, msg *types.MsgReopenChannel) (*types.MsgReopenChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.IsControllerEnabled(ctx) {
+		return nil, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.IsMiddlewareEnabled(ctx, portID, msg.ConnectionId) {
+		return nil, sdkerrors.Wrapf(types.ErrMiddlewareEnabled, "channel on port %s and connection %s must be reopened through the underlying application", portID, msg.ConnectionId)
+	}
+
+	channelID, err := s.reopenActiveChannel(ctx, msg.ConnectionId, portID, msg.Ordering)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully reopened interchain account channel", "port-id", portID, "channel-id", channelID)
+
+	return &types.MsgReopenChannelResponse{
+		ChannelId: channelID,
+	}, nil
+}
@@ -0,0 +1,551 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// SetupICAPathWithMsgServer invokes the controller Msg service RegisterInterchainAccount handler and subsequent channel handshake handlers
+func SetupICAPathWithMsgServer(path *ibctesting.Path, owner string) error {
+	channelSequence := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(path.EndpointA.Chain.GetContext())
+
+	msgServer := keeper.NewMsgServerImpl(&path.EndpointA.Chain.GetSimApp().ICAControllerKeeper)
+	msg := types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, "", path.EndpointA.ChannelConfig.Order)
+
+	if _, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(path.EndpointA.Chain.GetContext()), msg); err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	path.EndpointA.Chain.NextBlock()
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	// update port/channel ids
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointB.ChanOpenConfirm(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccountMsgServer() {
+	var (
+		msg  *types.MsgRegisterInterchainAccount
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: custom version", func() {
+				msg.Version = TestVersion
+			}, true,
+		},
+		{
+			"success: UNORDERED channel", func() {
+				msg.Ordering = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			}, false,
+		},
+		{
+			"invalid connection id", func() {
+				msg.ConnectionId = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"invalid version", func() {
+				msg.Version = "invalid-version"
+			}, false,
+		},
+		{
+			"active channel already open", func() {
+				err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			msg = types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, TestOwnerAddress, "", channeltypes.ORDERED)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(ibctesting.FirstChannelID, res.ChannelId)
+
+				channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(ctx, TestPortID, res.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.GetOrdering(), channel.Ordering)
+
+				controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+				suite.Require().False(controllerKeeper.IsMiddlewareEnabled(ctx, TestPortID, ibctesting.FirstConnectionID))
+
+				// the channel capability is owned by the controller submodule
+				_, found = suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(ctx, host.ChannelCapabilityPath(TestPortID, res.ChannelId))
+				suite.Require().True(found)
+
+				// the channel capability is not claimed by the underlying application
+				_, found = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(ctx, host.ChannelCapabilityPath(TestPortID, res.ChannelId))
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTxMsgServer() {
+	var (
+		msg  *types.MsgSendTx
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			}, false,
+		},
+		{
+			"channel owned by the underlying application", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+			}, false,
+		},
+		{
+			"no active channel for the owner", func() {
+				msg.Owner = suite.chainB.SenderAccount.GetAddress().String()
+			}, false,
+		},
+		{
+			"invalid packet data", func() {
+				msg.PacketData = icatypes.InterchainAccountPacketData{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaMsg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{icaMsg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			msg = types.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, uint64(time.Hour.Nanoseconds()), packetData)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.Sequence)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCloseChannelMsgServer() {
+	var (
+		msg  *types.MsgCloseChannel
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			}, false,
+		},
+		{
+			"channel owned by the underlying application", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+			}, false,
+		},
+		{
+			"no active channel for the owner", func() {
+				msg.Owner = suite.chainB.SenderAccount.GetAddress().String()
+			}, false,
+		},
+		{
+			"active channel is already closed", func() {
+				path.EndpointA.SetChannelClosed()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgCloseChannel(TestOwnerAddress, ibctesting.FirstConnectionID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.CloseChannel(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+
+				channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.CLOSED, channel.State)
+
+				// the closed channel is kept as the active channel
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(ctx, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// setupFeeEnabledICAPath registers an interchain account through the controller Msg service on a channel wrapped by
+// the fee middleware and completes the channel handshake
+func (suite *KeeperTestSuite) setupFeeEnabledICAPath() *ibctesting.Path {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	feeVersion := string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: TestVersion}))
+	path.EndpointA.ChannelConfig.Version = feeVersion
+	path.EndpointB.ChannelConfig.Version = feeVersion
+	suite.coordinator.SetupConnections(path)
+
+	channelSequence := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	_, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, TestOwnerAddress, feeVersion, channeltypes.ORDERED))
+	suite.Require().NoError(err)
+
+	// commit state changes for proof verification
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	return path
+}
+
+func (suite *KeeperTestSuite) TestCloseChannelMsgServerRefundsFees() {
+	suite.SetupTest()
+
+	path := suite.setupFeeEnabledICAPath()
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(ctx, TestPortID, path.EndpointA.ChannelID))
+
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	fee := feetypes.NewFee(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)))
+	packetID := channeltypes.NewPacketId(TestPortID, path.EndpointA.ChannelID, 1)
+	err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(ctx, packetID, feetypes.NewPacketFee(fee, refundAcc.String(), nil))
+	suite.Require().NoError(err)
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom)
+
+	_, err = msgServer.CloseChannel(sdk.WrapSDKContext(ctx), types.NewMsgCloseChannel(TestOwnerAddress, ibctesting.FirstConnectionID))
+	suite.Require().NoError(err)
+
+	channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(ctx, TestPortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CLOSED, channel.State)
+
+	// the fee middleware wrapping the controller submodule refunds the escrowed fees of the closed channel
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+	suite.Require().False(found)
+	suite.Require().Equal(balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)), suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestReopenChannelMsgServerFeeEnabled() {
+	suite.SetupTest()
+
+	path := suite.setupFeeEnabledICAPath()
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().True(found)
+
+	_, err := msgServer.CloseChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgCloseChannel(TestOwnerAddress, ibctesting.FirstConnectionID))
+	suite.Require().NoError(err)
+
+	// commit state changes for proof verification
+	path.EndpointA.Chain.NextBlock()
+
+	suite.Require().NoError(path.EndpointB.ChanCloseConfirm())
+
+	res, err := msgServer.ReopenChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgReopenChannel(TestOwnerAddress, ibctesting.FirstConnectionID, channeltypes.NONE))
+	suite.Require().NoError(err)
+
+	// the version of the new channel is wrapped by the fee middleware and contains the interchain account address
+	channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), TestPortID, res.ChannelId)
+	suite.Require().True(found)
+
+	var feeMetadata feetypes.Metadata
+	suite.Require().NoError(feetypes.ModuleCdc.UnmarshalJSON([]byte(channel.Version), &feeMetadata))
+	suite.Require().Equal(feetypes.Version, feeMetadata.FeeVersion)
+
+	var metadata icatypes.Metadata
+	suite.Require().NoError(icatypes.ModuleCdc.UnmarshalJSON([]byte(feeMetadata.AppVersion), &metadata))
+	suite.Require().Equal(interchainAccountAddr, metadata.Address)
+
+	// commit state changes for proof verification
+	path.EndpointA.Chain.NextBlock()
+
+	// complete the handshake of the new channel
+	path.EndpointA.ChannelID = res.ChannelId
+	path.EndpointA.ChannelConfig.Version = channel.Version
+	path.EndpointB.ChannelID = ""
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	// fees remain enabled on the reopened channel on both chains
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), TestPortID, res.ChannelId))
+	suite.Require().True(suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+	activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(res.ChannelId, activeChannelID)
+}
+
+func (suite *KeeperTestSuite) TestReopenChannelMsgServer() {
+	var (
+		msg  *types.MsgReopenChannel
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		ordering channeltypes.Order
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", channeltypes.ORDERED, func() {}, true,
+		},
+		{
+			"success - UNORDERED channel", channeltypes.UNORDERED, func() {}, true,
+		},
+		{
+			"success - ORDERED channel migrated to UNORDERED", channeltypes.ORDERED, func() {
+				msg.Ordering = channeltypes.UNORDERED
+				path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+				path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"UNORDERED channel cannot be reopened as ORDERED", channeltypes.UNORDERED, func() {
+				msg.Ordering = channeltypes.ORDERED
+			}, false,
+		},
+		{
+			"invalid ordering", channeltypes.ORDERED, func() {
+				msg.Ordering = channeltypes.Order(3)
+			}, false,
+		},
+		{
+			"controller submodule disabled", channeltypes.ORDERED, func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			}, false,
+		},
+		{
+			"channel owned by the underlying application", channeltypes.ORDERED, func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+			}, false,
+		},
+		{
+			"no active channel for the owner", channeltypes.ORDERED, func() {
+				msg.Owner = suite.chainB.SenderAccount.GetAddress().String()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			path.EndpointA.ChannelConfig.Order = tc.ordering
+			path.EndpointB.ChannelConfig.Order = tc.ordering
+
+			err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+			// reopening an open active channel fails
+			_, err = msgServer.ReopenChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgReopenChannel(TestOwnerAddress, ibctesting.FirstConnectionID, channeltypes.NONE))
+			suite.Require().Error(err)
+
+			_, err = msgServer.CloseChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgCloseChannel(TestOwnerAddress, ibctesting.FirstConnectionID))
+			suite.Require().NoError(err)
+
+			// commit state changes for proof verification
+			path.EndpointA.Chain.NextBlock()
+
+			err = path.EndpointB.ChanCloseConfirm()
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgReopenChannel(TestOwnerAddress, ibctesting.FirstConnectionID, channeltypes.NONE)
+
+			tc.malleate()
+
+			channelSequence := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+			res, err := msgServer.ReopenChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(channeltypes.FormatChannelIdentifier(channelSequence), res.ChannelId)
+
+				// commit state changes for proof verification
+				path.EndpointA.Chain.NextBlock()
+
+				// complete the handshake of the new channel, whose version contains the interchain account address
+				path.EndpointA.ChannelID = res.ChannelId
+				path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+				path.EndpointB.ChannelID = ""
+				suite.Require().NoError(path.EndpointB.ChanOpenTry())
+				suite.Require().NoError(path.EndpointA.ChanOpenAck())
+				suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+				channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, res.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(path.EndpointA.ChannelConfig.Order, channel.Ordering)
+				suite.Require().Equal(path.EndpointB.ChannelConfig.Order, path.EndpointB.GetChannel().Ordering)
+
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(res.ChannelId, activeChannelID)
+
+				// the interchain account is unchanged on both chains
+				address, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(interchainAccountAddr, address)
+
+				address, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(interchainAccountAddr, address)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
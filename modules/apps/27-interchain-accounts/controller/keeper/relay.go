@@ -13,8 +13,9 @@ import (
 // SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
 // The packet sequence for the outgoing packet is returned as a result.
 // If the base application has the capability to send on the provided portID. An appropriate
-// absolute timeoutTimestamp must be provided. If the packet is timed out, the channel will be closed.
+// absolute timeoutTimestamp must be provided. If the packet is timed out on an ORDERED channel, the channel will be closed.
 // In the case of channel closure, a new channel may be reopened to reconnect to the host chain.
+// UNORDERED channels remain open when a packet times out.
 func (k Keeper) SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if !found {
@@ -74,8 +75,8 @@ func (k Keeper) createOutgoingPacket(
 	return packet.Sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// OnTimeoutPacket is called when a packet sent by the controller times out. The underlying channel end is closed
+// by core IBC for ORDERED channels, UNORDERED channels remain open and may continue to be used
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	return nil
 }
@@ -7,4 +7,5 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrMiddlewareEnabled           = sdkerrors.Register(SubModuleName, 3, "channel is owned by the underlying application")
 )
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSendTx{}
+	_ sdk.Msg = &MsgCloseChannel{}
+	_ sdk.Msg = &MsgReopenChannel{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
+func NewMsgRegisterInterchainAccount(connectionID, owner, version string, ordering channeltypes.Order) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		ConnectionId: connectionID,
+		Owner:        owner,
+		Version:      version,
+		Ordering:     ordering,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterInterchainAccount fields
+func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse owner address %s: %s", msg.Owner, err)
+	}
+
+	return validateOrdering(msg.Ordering)
+}
+
+// GetOrdering returns the ordering of the channel opened for the interchain account.
+// ORDERED is returned if the ordering is unspecified.
+func (msg MsgRegisterInterchainAccount) GetOrdering() channeltypes.Order {
+	if msg.Ordering == channeltypes.NONE {
+		return channeltypes.ORDERED
+	}
+
+	return msg.Ordering
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgSendTx creates a new instance of MsgSendTx
+func NewMsgSendTx(owner, connectionID string, relativeTimeout uint64, packetData icatypes.InterchainAccountPacketData) *MsgSendTx {
+	return &MsgSendTx{
+		ConnectionId:    connectionID,
+		Owner:           owner,
+		RelativeTimeout: relativeTimeout,
+		PacketData:      packetData,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSendTx fields
+func (msg MsgSendTx) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse owner address %s: %s", msg.Owner, err)
+	}
+
+	if err := msg.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if msg.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSendTx) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgCloseChannel creates a new instance of MsgCloseChannel
+func NewMsgCloseChannel(owner, connectionID string) *MsgCloseChannel {
+	return &MsgCloseChannel{
+		Owner:        owner,
+		ConnectionId: connectionID,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgCloseChannel fields
+func (msg MsgCloseChannel) ValidateBasic() error {
+	return validateOwnerAndConnection(msg.Owner, msg.ConnectionId)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCloseChannel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgReopenChannel creates a new instance of MsgReopenChannel
+func NewMsgReopenChannel(owner, connectionID string, ordering channeltypes.Order) *MsgReopenChannel {
+	return &MsgReopenChannel{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		Ordering:     ordering,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgReopenChannel fields
+func (msg MsgReopenChannel) ValidateBasic() error {
+	if err := validateOwnerAndConnection(msg.Owner, msg.ConnectionId); err != nil {
+		return err
+	}
+
+	return validateOrdering(msg.Ordering)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgReopenChannel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// validateOwnerAndConnection performs a basic check of the owner address and the connection identifier
+func validateOwnerAndConnection(owner, connectionID string) error {
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(owner) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse owner address %s: %s", owner, err)
+	}
+
+	return nil
+}
+
+// validateOrdering checks that the channel ordering is ORDERED, UNORDERED or unspecified
+func validateOrdering(ordering channeltypes.Order) error {
+	switch ordering {
+	case channeltypes.NONE, channeltypes.ORDERED, channeltypes.UNORDERED:
+		return nil
+	default:
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "unsupported channel ordering %s", ordering)
+	}
+}
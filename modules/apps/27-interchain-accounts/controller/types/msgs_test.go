@@ -0,0 +1,311 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// TestOwnerAddress defines a reusable bech32 address for testing purposes
+const TestOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterInterchainAccount
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with custom version",
+			func() {
+				msg.Version = icatypes.Version
+			},
+			true,
+		},
+		{
+			"success: unordered channel",
+			func() {
+				msg.Ordering = channeltypes.UNORDERED
+			},
+			true,
+		},
+		{
+			"success: unspecified ordering",
+			func() {
+				msg.Ordering = channeltypes.NONE
+			},
+			true,
+		},
+		{
+			"invalid ordering",
+			func() {
+				msg.Ordering = channeltypes.Order(3)
+			},
+			false,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-address"
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, TestOwnerAddress, "", channeltypes.ORDERED)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterInterchainAccountGetSigners(t *testing.T) {
+	msg := types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, TestOwnerAddress, "", channeltypes.ORDERED)
+	require.Equal(t, TestOwnerAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgRegisterInterchainAccountGetOrdering(t *testing.T) {
+	msg := types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, TestOwnerAddress, "", channeltypes.NONE)
+	require.Equal(t, channeltypes.ORDERED, msg.GetOrdering())
+
+	msg.Ordering = channeltypes.UNORDERED
+	require.Equal(t, channeltypes.UNORDERED, msg.GetOrdering())
+}
+
+func TestMsgSendTxValidateBasic(t *testing.T) {
+	var msg *types.MsgSendTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-address"
+			},
+			false,
+		},
+		{
+			"relative timeout is zero",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			false,
+		},
+		{
+			"unspecified packet data type",
+			func() {
+				msg.PacketData.Type = icatypes.UNSPECIFIED
+			},
+			false,
+		},
+		{
+			"empty packet data",
+			func() {
+				msg.PacketData.Data = nil
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: []byte("data"),
+		}
+
+		msg = types.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, 100000, packetData)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgSendTxGetSigners(t *testing.T) {
+	msg := types.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, 100000, icatypes.InterchainAccountPacketData{})
+	require.Equal(t, TestOwnerAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgCloseChannelValidateBasic(t *testing.T) {
+	var msg *types.MsgCloseChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-address"
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgCloseChannel(TestOwnerAddress, ibctesting.FirstConnectionID)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgCloseChannelGetSigners(t *testing.T) {
+	msg := types.NewMsgCloseChannel(TestOwnerAddress, ibctesting.FirstConnectionID)
+	require.Equal(t, TestOwnerAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgReopenChannelValidateBasic(t *testing.T) {
+	var msg *types.MsgReopenChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-address"
+			},
+			false,
+		},
+		{
+			"success: unordered channel",
+			func() {
+				msg.Ordering = channeltypes.UNORDERED
+			},
+			true,
+		},
+		{
+			"invalid ordering",
+			func() {
+				msg.Ordering = channeltypes.Order(3)
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgReopenChannel(TestOwnerAddress, ibctesting.FirstConnectionID, channeltypes.NONE)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgReopenChannelGetSigners(t *testing.T) {
+	msg := types.NewMsgReopenChannel(TestOwnerAddress, ibctesting.FirstConnectionID, channeltypes.NONE)
+	require.Equal(t, TestOwnerAddress, msg.GetSigners()[0].String())
+}
@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: ibc/applications/interchain_accounts/v1/genesis.proto
+// source: ibc/applications/interchain_accounts/genesis/v1/genesis.proto
 
 package types
 
@@ -35,7 +35,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{0}
+	return fileDescriptor_d4aa48c8e29a1947, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,7 +90,7 @@ func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{}
 func (m *ControllerGenesisState) String() string { return proto.CompactTextString(m) }
 func (*ControllerGenesisState) ProtoMessage()    {}
 func (*ControllerGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{1}
+	return fileDescriptor_d4aa48c8e29a1947, []int{1}
 }
 func (m *ControllerGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +159,7 @@ func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
 func (m *HostGenesisState) String() string { return proto.CompactTextString(m) }
 func (*HostGenesisState) ProtoMessage()    {}
 func (*HostGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{2}
+	return fileDescriptor_d4aa48c8e29a1947, []int{2}
 }
 func (m *HostGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,18 +216,21 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
-// ActiveChannel contains a connection ID, port ID and associated active channel ID
+// ActiveChannel contains a connection ID, port ID and associated active channel ID, as well as a boolean flag to
+// indicate if the channel was registered through the controller Msg service, in which case the callbacks of the
+// underlying application are not executed
 type ActiveChannel struct {
-	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
-	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	ConnectionId         string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId               string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId            string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	IsMiddlewareDisabled bool   `protobuf:"varint,4,opt,name=is_middleware_disabled,json=isMiddlewareDisabled,proto3" json:"is_middleware_disabled,omitempty" yaml:"is_middleware_disabled"`
 }
 
 func (m *ActiveChannel) Reset()         { *m = ActiveChannel{} }
 func (m *ActiveChannel) String() string { return proto.CompactTextString(m) }
 func (*ActiveChannel) ProtoMessage()    {}
 func (*ActiveChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{3}
+	return fileDescriptor_d4aa48c8e29a1947, []int{3}
 }
 func (m *ActiveChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,6 +280,13 @@ func (m *ActiveChannel) GetChannelId() string {
 	return ""
 }
 
+func (m *ActiveChannel) GetIsMiddlewareDisabled() bool {
+	if m != nil {
+		return m.IsMiddlewareDisabled
+	}
+	return false
+}
+
 // RegisteredInterchainAccount contains a connection ID, port ID and associated interchain account address
 type RegisteredInterchainAccount struct {
 	ConnectionId   string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
@@ -288,7 +298,7 @@ func (m *RegisteredInterchainAccount) Reset()         { *m = RegisteredInterchai
 func (m *RegisteredInterchainAccount) String() string { return proto.CompactTextString(m) }
 func (*RegisteredInterchainAccount) ProtoMessage()    {}
 func (*RegisteredInterchainAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{4}
+	return fileDescriptor_d4aa48c8e29a1947, []int{4}
 }
 func (m *RegisteredInterchainAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,60 +349,63 @@ func (m *RegisteredInterchainAccount) GetAccountAddress() string {
 }
 
 func init() {
-	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.v1.GenesisState")
-	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.v1.ControllerGenesisState")
-	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.v1.HostGenesisState")
-	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.v1.ActiveChannel")
-	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.GenesisState")
+	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.ControllerGenesisState")
+	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.HostGenesisState")
+	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.genesis.v1.ActiveChannel")
+	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.genesis.v1.RegisteredInterchainAccount")
 }
 
 func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/v1/genesis.proto", fileDescriptor_629b3ced0911516b)
-}
-
-var fileDescriptor_629b3ced0911516b = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x3f, 0x6f, 0x13, 0x3f,
-	0x18, 0xc7, 0xe3, 0xa4, 0xbf, 0xfe, 0x14, 0xf7, 0x0f, 0xc5, 0x94, 0xea, 0x08, 0xd2, 0x25, 0x78,
-	0x69, 0x24, 0xd4, 0x3b, 0xf5, 0x0f, 0x54, 0x54, 0x42, 0xa8, 0x17, 0x10, 0x64, 0x43, 0x66, 0x41,
-	0x2c, 0x27, 0xc7, 0x67, 0x25, 0x96, 0x92, 0x73, 0x74, 0x76, 0x23, 0x75, 0x62, 0x67, 0x62, 0x43,
-	0xac, 0x48, 0xbc, 0x00, 0xde, 0x01, 0x63, 0x27, 0xd4, 0x91, 0x29, 0x42, 0xed, 0x3b, 0xc8, 0x2b,
-	0x40, 0xf6, 0x9d, 0x92, 0xf4, 0x48, 0xab, 0x9b, 0x98, 0x98, 0x62, 0xdf, 0xe3, 0xef, 0xf7, 0xf9,
-	0x3c, 0x7e, 0x1c, 0x1b, 0x3e, 0x12, 0x1d, 0xe6, 0xd3, 0xe1, 0xb0, 0x2f, 0x18, 0xd5, 0x42, 0xc6,
-	0xca, 0x17, 0xb1, 0xe6, 0x09, 0xeb, 0x51, 0x11, 0x87, 0x94, 0x31, 0x79, 0x12, 0x6b, 0xe5, 0x8f,
-	0x76, 0xfd, 0x2e, 0x8f, 0xb9, 0x12, 0xca, 0x1b, 0x26, 0x52, 0x4b, 0xb4, 0x2d, 0x3a, 0xcc, 0x9b,
-	0x97, 0x79, 0x0b, 0x64, 0xde, 0x68, 0xb7, 0xb6, 0xd9, 0x95, 0x5d, 0x69, 0x35, 0xbe, 0x19, 0xa5,
-	0xf2, 0x5a, 0xab, 0x50, 0x56, 0x26, 0x63, 0x9d, 0xc8, 0x7e, 0x9f, 0x27, 0x06, 0x60, 0x36, 0xcb,
-	0x4c, 0x0e, 0x0b, 0x99, 0xf4, 0xa4, 0xd2, 0x46, 0x6e, 0x7e, 0x53, 0x21, 0xfe, 0x5e, 0x86, 0xab,
-	0x2f, 0xd3, 0x72, 0xde, 0x68, 0xaa, 0x39, 0xfa, 0x02, 0xa0, 0x33, 0xb3, 0x0f, 0xb3, 0x52, 0x43,
-	0x65, 0x82, 0x0e, 0x68, 0x80, 0xe6, 0xca, 0xde, 0x33, 0xaf, 0x60, 0xc5, 0x5e, 0x6b, 0x6a, 0x34,
-	0x9f, 0x23, 0xd8, 0x3e, 0x1b, 0xd7, 0x4b, 0x93, 0x71, 0xbd, 0x7e, 0x4a, 0x07, 0xfd, 0x23, 0x7c,
-	0x5d, 0x3a, 0x4c, 0xb6, 0xd8, 0x42, 0x03, 0xf4, 0x01, 0x40, 0x64, 0x8a, 0xc8, 0xe1, 0x95, 0x2d,
-	0xde, 0x93, 0xc2, 0x78, 0xaf, 0xa4, 0xd2, 0x57, 0xc0, 0x1e, 0x64, 0x60, 0xf7, 0x52, 0xb0, 0x3f,
-	0x53, 0x60, 0xb2, 0xd1, 0xcb, 0x89, 0xf0, 0xd7, 0x0a, 0xdc, 0x5a, 0x5c, 0x28, 0x7a, 0x0f, 0x6f,
-	0x51, 0xa6, 0xc5, 0x88, 0x87, 0xac, 0x47, 0xe3, 0x98, 0xf7, 0x95, 0x03, 0x1a, 0x95, 0xe6, 0xca,
-	0xde, 0xe3, 0xc2, 0x8c, 0xc7, 0x56, 0xdf, 0x4a, 0xe5, 0x81, 0x9b, 0x01, 0x6e, 0xa5, 0x80, 0x39,
-	0x73, 0x4c, 0xd6, 0xe9, 0xfc, 0x72, 0x85, 0x3e, 0x03, 0x78, 0x67, 0x81, 0xb1, 0x53, 0xb6, 0x14,
-	0xcf, 0x0b, 0x53, 0x10, 0xde, 0x15, 0x4a, 0xf3, 0x84, 0x47, 0xed, 0xe9, 0x82, 0xe3, 0x34, 0x1e,
-	0xe0, 0x8c, 0xa9, 0x96, 0x32, 0x2d, 0x70, 0xc0, 0x04, 0x89, 0xbc, 0x4c, 0xa1, 0x4d, 0xf8, 0xdf,
-	0x50, 0x26, 0x5a, 0x39, 0x95, 0x46, 0xa5, 0x59, 0x25, 0xe9, 0x04, 0xbd, 0x85, 0xcb, 0x43, 0x9a,
-	0xd0, 0x81, 0x72, 0x96, 0x6c, 0x37, 0x8f, 0x8a, 0x31, 0xce, 0xfd, 0x23, 0x46, 0xbb, 0xde, 0x6b,
-	0xeb, 0x10, 0x2c, 0x19, 0x32, 0x92, 0xf9, 0xe1, 0x4f, 0x15, 0xb8, 0x91, 0xef, 0xf8, 0xbf, 0x0e,
-	0xdd, 0xd4, 0x21, 0x04, 0x97, 0x4c, 0x53, 0x9c, 0x4a, 0x03, 0x34, 0xab, 0xc4, 0x8e, 0x11, 0xc9,
-	0xf5, 0xe7, 0xa0, 0x18, 0xa1, 0xbd, 0x72, 0xae, 0xeb, 0xcc, 0x37, 0x00, 0xd7, 0xae, 0xec, 0x22,
-	0x7a, 0x0a, 0xd7, 0x98, 0x8c, 0x63, 0xce, 0x8c, 0x63, 0x28, 0x22, 0x7b, 0xf3, 0x54, 0x03, 0x67,
-	0x32, 0xae, 0x6f, 0x4e, 0x2f, 0x8d, 0x59, 0x18, 0x93, 0xd5, 0xd9, 0xbc, 0x1d, 0xa1, 0x87, 0xf0,
-	0x7f, 0x03, 0x6b, 0x84, 0x65, 0x2b, 0x44, 0x93, 0x71, 0x7d, 0x3d, 0x15, 0x66, 0x01, 0x4c, 0x96,
-	0xcd, 0xa8, 0x1d, 0xa1, 0x03, 0x08, 0xb3, 0xf6, 0x98, 0xf5, 0xb6, 0xd6, 0xe0, 0xee, 0x64, 0x5c,
-	0xbf, 0x9d, 0x25, 0x9a, 0xc6, 0x30, 0xa9, 0x66, 0x93, 0x76, 0x84, 0x7f, 0x00, 0x78, 0xff, 0x86,
-	0x3d, 0xff, 0xab, 0x15, 0xb4, 0xcc, 0x21, 0xb6, 0x69, 0x43, 0x1a, 0x45, 0x09, 0x57, 0x2a, 0x2b,
-	0xa3, 0x36, 0x7f, 0x10, 0xaf, 0x2c, 0xb0, 0x07, 0xd1, 0x7e, 0x39, 0x4e, 0x3f, 0x04, 0xe1, 0xd9,
-	0x85, 0x0b, 0xce, 0x2f, 0x5c, 0xf0, 0xeb, 0xc2, 0x05, 0x1f, 0x2f, 0xdd, 0xd2, 0xf9, 0xa5, 0x5b,
-	0xfa, 0x79, 0xe9, 0x96, 0xde, 0xbd, 0xe8, 0x0a, 0xdd, 0x3b, 0xe9, 0x78, 0x4c, 0x0e, 0x7c, 0x26,
-	0xd5, 0x40, 0x2a, 0x5f, 0x74, 0xd8, 0x4e, 0x57, 0xfa, 0xa3, 0x7d, 0x7f, 0x20, 0xa3, 0x93, 0x3e,
-	0x57, 0xe6, 0xf1, 0x51, 0xfe, 0xde, 0xe1, 0xce, 0xac, 0xf9, 0x3b, 0xd3, 0x77, 0x47, 0x9f, 0x0e,
-	0xb9, 0xea, 0x2c, 0xdb, 0x17, 0x67, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x08, 0x8f,
-	0x1f, 0x67, 0x07, 0x00, 0x00,
+	proto.RegisterFile("ibc/applications/interchain_accounts/genesis/v1/genesis.proto", fileDescriptor_d4aa48c8e29a1947)
+}
+
+var fileDescriptor_d4aa48c8e29a1947 = []byte{
+	// 691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x4d, 0x6b, 0x13, 0x4f,
+	0x1c, 0xc7, 0xb3, 0x49, 0xff, 0xfd, 0x9b, 0xe9, 0x83, 0x75, 0x8c, 0x61, 0x8d, 0x98, 0x4d, 0xf7,
+	0x62, 0x40, 0xba, 0x4b, 0x1f, 0xa0, 0x50, 0xa8, 0x90, 0x8d, 0x50, 0x03, 0x16, 0x64, 0x3c, 0x28,
+	0x5e, 0x96, 0xc9, 0xec, 0xb0, 0x19, 0xd8, 0xec, 0x84, 0x9d, 0x69, 0xa4, 0xaf, 0x40, 0xf0, 0x24,
+	0xbe, 0x03, 0x6f, 0xe2, 0x0b, 0xf0, 0x35, 0xf4, 0x24, 0x3d, 0x7a, 0x0a, 0xd2, 0xbe, 0x83, 0xbc,
+	0x02, 0x99, 0xd9, 0x6d, 0x92, 0xa6, 0xa9, 0x24, 0x17, 0x4f, 0x9e, 0x76, 0x1e, 0x7e, 0xdf, 0xef,
+	0xef, 0x33, 0xf3, 0x9b, 0x9d, 0x01, 0x87, 0xac, 0x4d, 0x5c, 0xdc, 0xeb, 0x45, 0x8c, 0x60, 0xc9,
+	0x78, 0x2c, 0x5c, 0x16, 0x4b, 0x9a, 0x90, 0x0e, 0x66, 0xb1, 0x8f, 0x09, 0xe1, 0x27, 0xb1, 0x14,
+	0x6e, 0x48, 0x63, 0x2a, 0x98, 0x70, 0xfb, 0xdb, 0x57, 0x4d, 0xa7, 0x97, 0x70, 0xc9, 0xa1, 0xcb,
+	0xda, 0xc4, 0x99, 0x94, 0x3b, 0x33, 0xe4, 0xce, 0x95, 0xa6, 0xbf, 0x5d, 0x29, 0x85, 0x3c, 0xe4,
+	0x5a, 0xeb, 0xaa, 0x56, 0x6a, 0x53, 0x69, 0xce, 0x45, 0x41, 0x78, 0x2c, 0x13, 0x1e, 0x45, 0x34,
+	0x51, 0x20, 0xe3, 0x5e, 0x66, 0xb2, 0x3f, 0x97, 0x49, 0x87, 0x0b, 0xa9, 0xe4, 0xea, 0x9b, 0x0a,
+	0xed, 0xf3, 0x3c, 0x58, 0x3d, 0x4a, 0x11, 0x5f, 0x4b, 0x2c, 0x29, 0xfc, 0x66, 0x00, 0x73, 0x6c,
+	0xef, 0x67, 0xf8, 0xbe, 0x50, 0x93, 0xa6, 0x51, 0x33, 0xea, 0x2b, 0x3b, 0x47, 0xce, 0x82, 0x2b,
+	0x77, 0x9a, 0x23, 0xc3, 0xc9, 0x5c, 0xde, 0x93, 0xb3, 0x81, 0x95, 0x1b, 0x0e, 0x2c, 0xeb, 0x14,
+	0x77, 0xa3, 0x03, 0xfb, 0xb6, 0xb4, 0x36, 0x2a, 0x93, 0x99, 0x06, 0xf0, 0xb3, 0x01, 0xa0, 0x5a,
+	0xcc, 0x14, 0x66, 0x5e, 0x63, 0x36, 0x16, 0xc6, 0x7c, 0xc1, 0x85, 0xbc, 0x06, 0xb8, 0x99, 0x01,
+	0x3e, 0x4c, 0x01, 0x6f, 0xa6, 0xb2, 0xd1, 0x46, 0x67, 0x4a, 0x64, 0x7f, 0x2f, 0x80, 0xf2, 0xec,
+	0x05, 0xc3, 0x0f, 0x06, 0xb8, 0x8b, 0x89, 0x64, 0x7d, 0xea, 0x93, 0x0e, 0x8e, 0x63, 0x1a, 0x09,
+	0xd3, 0xa8, 0x15, 0xea, 0x2b, 0x3b, 0xcf, 0x16, 0x86, 0x6d, 0x68, 0x9f, 0x66, 0x6a, 0xe3, 0x55,
+	0x33, 0xd2, 0x72, 0x4a, 0x3a, 0x95, 0xc4, 0x46, 0xeb, 0x78, 0x32, 0x5c, 0xc0, 0x2f, 0x06, 0xb8,
+	0x3f, 0x23, 0x81, 0x99, 0xd7, 0x34, 0x2f, 0x17, 0xa6, 0x41, 0x34, 0x64, 0x42, 0xd2, 0x84, 0x06,
+	0xad, 0x51, 0x60, 0x23, 0x8d, 0xf3, 0xec, 0x8c, 0xad, 0x92, 0xb2, 0xcd, 0x70, 0xb2, 0x11, 0x64,
+	0xd3, 0x32, 0x01, 0x4b, 0xe0, 0xbf, 0x1e, 0x4f, 0xa4, 0x30, 0x0b, 0xb5, 0x42, 0xbd, 0x88, 0xd2,
+	0x0e, 0x7c, 0x0b, 0x96, 0x7b, 0x38, 0xc1, 0x5d, 0x61, 0x2e, 0xe9, 0x32, 0x1f, 0xcc, 0xc7, 0x3a,
+	0xf1, 0xcb, 0xf4, 0xb7, 0x9d, 0x57, 0xda, 0xc1, 0x5b, 0x52, 0x64, 0x28, 0xf3, 0xb3, 0xbf, 0x16,
+	0xc0, 0xc6, 0xf4, 0x11, 0xf8, 0x57, 0xb2, 0x85, 0x4a, 0x06, 0xc1, 0x92, 0xaa, 0x92, 0x59, 0xa8,
+	0x19, 0xf5, 0x22, 0xd2, 0x6d, 0x88, 0xa6, 0x0a, 0xb6, 0x37, 0x1f, 0xa9, 0xbe, 0xa4, 0x6e, 0x2b,
+	0xd5, 0xc7, 0x3c, 0x58, 0xbb, 0xb6, 0x9b, 0xf0, 0x10, 0xac, 0x11, 0x1e, 0xc7, 0x94, 0x28, 0x47,
+	0x9f, 0x05, 0xfa, 0xae, 0x2a, 0x7a, 0xe6, 0x70, 0x60, 0x95, 0x46, 0xd7, 0xcb, 0x78, 0xda, 0x46,
+	0xab, 0xe3, 0x7e, 0x2b, 0x80, 0x4f, 0xc1, 0xff, 0x0a, 0x56, 0x09, 0xf3, 0x5a, 0x08, 0x87, 0x03,
+	0x6b, 0x3d, 0x15, 0x66, 0x13, 0x36, 0x5a, 0x56, 0xad, 0x56, 0x00, 0xf7, 0x00, 0xc8, 0xca, 0xa4,
+	0xe2, 0xf5, 0x5a, 0xbd, 0x07, 0xc3, 0x81, 0x75, 0x2f, 0x4b, 0x34, 0x9a, 0xb3, 0x51, 0x31, 0xeb,
+	0xb4, 0x02, 0xf8, 0x06, 0x94, 0x99, 0xf0, 0xbb, 0x2c, 0x08, 0x22, 0xfa, 0x1e, 0x27, 0xd4, 0x0f,
+	0x98, 0xc0, 0xed, 0x88, 0x06, 0x7a, 0x5f, 0xee, 0x78, 0x9b, 0xc3, 0x81, 0xf5, 0x38, 0xdb, 0xef,
+	0x99, 0x71, 0x36, 0x2a, 0x31, 0x71, 0x3c, 0x1a, 0x7f, 0x7e, 0x35, 0xfc, 0xc3, 0x00, 0x8f, 0xfe,
+	0x50, 0xcc, 0xbf, 0xba, 0x35, 0x4d, 0xf5, 0xb7, 0xe8, 0xb4, 0x3e, 0x0e, 0x82, 0x84, 0x0a, 0x91,
+	0xed, 0x4f, 0x65, 0xf2, 0xa4, 0x5f, 0x0b, 0xd0, 0x27, 0x5d, 0x8f, 0x34, 0xd2, 0x01, 0x2f, 0x3c,
+	0xbb, 0xa8, 0x1a, 0xe7, 0x17, 0x55, 0xe3, 0xd7, 0x45, 0xd5, 0xf8, 0x74, 0x59, 0xcd, 0x9d, 0x5f,
+	0x56, 0x73, 0x3f, 0x2f, 0xab, 0xb9, 0x77, 0xc7, 0x21, 0x93, 0x9d, 0x93, 0xb6, 0x43, 0x78, 0xd7,
+	0x25, 0x5c, 0x74, 0xb9, 0x50, 0xaf, 0xf0, 0x56, 0xc8, 0xdd, 0xfe, 0xae, 0xdb, 0xe5, 0xc1, 0x49,
+	0x44, 0x85, 0x7a, 0x07, 0x85, 0xbb, 0xb3, 0xbf, 0x35, 0x3e, 0x55, 0x5b, 0x37, 0x5e, 0x73, 0x79,
+	0xda, 0xa3, 0xa2, 0xbd, 0xac, 0x1f, 0xc1, 0xdd, 0xdf, 0x03, 0x00, 0x1c, 0x7a, 0x95, 0x2f, 0x0a,
+	0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -596,6 +609,16 @@ func (m *ActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsMiddlewareDisabled {
+		i--
+		if m.IsMiddlewareDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -762,6 +785,9 @@ func (m *ActiveChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.IsMiddlewareDisabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1399,6 +1425,26 @@ func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMiddlewareDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMiddlewareDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
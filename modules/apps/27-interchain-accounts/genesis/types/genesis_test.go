@@ -1,13 +1,34 @@
 package types_test
 
 import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
 	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
-	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
-func (suite *TypesTestSuite) TestValidateGenesisState() {
+var (
+	// TestOwnerAddress defines a reusable bech32 address for testing purposes
+	TestOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+	// TestPortID defines a resuable port identifier for testing purposes
+	TestPortID, _ = icatypes.NewControllerPortID(TestOwnerAddress)
+)
+
+type GenesisTypesTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTypesTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTypesTestSuite))
+}
+
+func (suite *GenesisTypesTestSuite) TestValidateGenesisState() {
 	var (
 		genesisState types.GenesisState
 	)
@@ -62,7 +83,7 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 	}
 }
 
-func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
+func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 	var (
 		genesisState types.ControllerGenesisState
 	)
@@ -187,7 +208,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 	}
 }
 
-func (suite *TypesTestSuite) TestValidateHostGenesisState() {
+func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 	var (
 		genesisState types.HostGenesisState
 	)
@@ -212,7 +233,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, icatypes.PortID, hosttypes.DefaultParams())
 			},
 			false,
 		},
@@ -226,7 +247,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, icatypes.PortID, hosttypes.DefaultParams())
 			},
 			false,
 		},
@@ -247,7 +268,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.PortID, hosttypes.DefaultParams())
 			},
 			false,
 		},
@@ -268,7 +289,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.PortID, hosttypes.DefaultParams())
 			},
 			false,
 		},
@@ -293,6 +314,22 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 			},
 			false,
 		},
+		{
+			"success: params with wildcard allow list, deny list and connection overrides",
+			func() {
+				genesisState.Params = hosttypes.NewParams(true, []string{hosttypes.AllowAllMessages}, []string{"/cosmos.gov.*"}, []hosttypes.ConnectionOverride{
+					hosttypes.NewConnectionOverride(ibctesting.FirstConnectionID, []string{"/cosmos.staking.*"}, nil),
+				}, nil, hosttypes.DefaultMaxGasPerPacket)
+			},
+			true,
+		},
+		{
+			"failed to validate params - invalid message type pattern",
+			func() {
+				genesisState.Params = hosttypes.NewParams(true, []string{"/cosmos.*.MsgSend"}, nil, nil, nil, hosttypes.DefaultMaxGasPerPacket)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
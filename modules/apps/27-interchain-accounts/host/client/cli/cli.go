@@ -15,6 +15,9 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdInterchainAccount(),
+		GetCmdInterchainAccounts(),
+		GetCmdActiveChannel(),
 		GetCmdPacketEvents(),
 	)
 
@@ -105,6 +105,21 @@ func (im IBCModule) OnRecvPacket(
 	packet channeltypes.Packet,
 	_ sdk.AccAddress,
 ) ibcexported.Acknowledgement {
+	// channels negotiating the structured acknowledgement version are acknowledged with the result of each message
+	if im.keeper.GetAckVersion(ctx, packet.DestinationPort, packet.DestinationChannel) == icatypes.AckVersion1 {
+		if !im.keeper.IsHostEnabled(ctx) {
+			return icatypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled, nil, ctx.BlockHeight())
+		}
+
+		ack, err := im.keeper.OnRecvPacketWithResults(ctx, packet)
+		if err != nil {
+			// Emit an event including the error msg
+			keeper.EmitWriteErrorAcknowledgementEvent(ctx, packet, err)
+		}
+
+		return ack
+	}
+
 	if !im.keeper.IsHostEnabled(ctx) {
 		return types.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
 	}
@@ -139,3 +154,14 @@ func (im IBCModule) OnTimeoutPacket(
 ) error {
 	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host channel end, a host chain does not send a packet over the channel")
 }
+
+// UnmarshalPacketData decodes the interchain account packet data. It implements the PacketDataUnmarshaler
+// interface of the callbacks middleware.
+func (im IBCModule) UnmarshalPacketData(_ sdk.Context, _, _ string, bz []byte) (interface{}, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal interchain account packet data: %s", err.Error())
+	}
+
+	return &data, nil
+}
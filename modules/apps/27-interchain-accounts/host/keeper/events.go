@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	
-	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 // EmitWriteErrorAcknowledgementEvent emits an event signalling an error acknowledgement and including the error details
@@ -18,3 +20,36 @@ func EmitWriteErrorAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI
 		),
 	)
 }
+
+// EmitMsgResultEvents emits an event for the result of each executed message or query of a packet, including
+// the deterministic ABCI code and codespace of a failed message or query
+func EmitMsgResultEvents(ctx sdk.Context, packet exported.PacketI, results []icatypes.MsgResult) {
+	for i, result := range results {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				icatypes.EventTypeMsgResult,
+				sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+				sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+				sdk.NewAttribute(icatypes.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+				sdk.NewAttribute(icatypes.AttributeKeyMsgIndex, strconv.Itoa(i)),
+				sdk.NewAttribute(icatypes.AttributeKeyTypeURL, result.TypeUrl),
+				sdk.NewAttribute(icatypes.AttributeKeyCode, strconv.FormatUint(uint64(result.Code), 10)),
+				sdk.NewAttribute(icatypes.AttributeKeyCodespace, result.Codespace),
+			),
+		)
+	}
+}
+
+// EmitMigrateInterchainAccountEvent emits an event signalling the migration of an interchain account to a new connection
+func EmitMigrateInterchainAccountEvent(ctx sdk.Context, connectionID, portID, newConnectionID, address string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeMigrateInterchainAccount,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyNewConnectionID, newConnectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyAddress, address),
+		),
+	)
+}
@@ -2,13 +2,247 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
-	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
 	expParams := types.DefaultParams()
-	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
+	res, _ := suite.chainB.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var req *types.QueryInterchainAccountRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"empty owner address", func() {
+				req.Owner = ""
+			}, false,
+		},
+		{
+			"invalid connection id", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+		{
+			"interchain account not found", func() {
+				req.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(ctx, req)
+
+			if tc.expPass {
+				expAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+				suite.Require().True(found)
+
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAddr, res.Address)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req         *types.QueryInterchainAccountsRequest
+		expAccounts []icatypes.IdentifiedInterchainAccount
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: multiple interchain accounts", func() {
+				portID, err := icatypes.NewControllerPortID(TestAccAddress.String())
+				suite.Require().NoError(err)
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, portID, TestAccAddress.String())
+				expAccounts = append(expAccounts, icatypes.NewIdentifiedInterchainAccount(ibctesting.FirstConnectionID, portID, TestAccAddress.String()))
+			}, true,
+		},
+		{
+			"success: with pagination", func() {
+				portID, err := icatypes.NewControllerPortID(TestAccAddress.String())
+				suite.Require().NoError(err)
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, portID, TestAccAddress.String())
+
+				req.Pagination = &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				}
+			}, true,
+		},
+		{
+			"success: no interchain accounts on the connection", func() {
+				req.ConnectionId = "connection-100"
+				expAccounts = nil
+			}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid connection id", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			addr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+			suite.Require().True(found)
+
+			// register an interchain account on a different connection, which must not be returned
+			suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), "connection-1", TestPortID, TestAccAddress.String())
+
+			expAccounts = []icatypes.IdentifiedInterchainAccount{icatypes.NewIdentifiedInterchainAccount(ibctesting.FirstConnectionID, TestPortID, addr)}
+			req = &types.QueryInterchainAccountsRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expAccounts, res.InterchainAccounts)
+				if req.Pagination != nil {
+					suite.Require().Equal(uint64(2), res.Pagination.Total)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannel() {
+	var req *types.QueryActiveChannelRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"invalid port id", func() {
+				req.PortId = ""
+			}, false,
+		},
+		{
+			"invalid connection id", func() {
+				req.ConnectionId = ""
+			}, false,
+		},
+		{
+			"active channel not found", func() {
+				req.ConnectionId = "connection-100"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryActiveChannelRequest{
+				PortId:       TestPortID,
+				ConnectionId: ibctesting.FirstConnectionID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannel(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointB.ChannelID, res.ChannelId)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
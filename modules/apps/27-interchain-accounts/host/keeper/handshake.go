@@ -15,6 +15,10 @@ import (
 
 // OnChanOpenTry performs basic validation of the ICA channel
 // and registers a new interchain account (if it doesn't exist).
+// An interchain account migrated to the connection is reused.
+// Both ORDERED and UNORDERED channels are accepted, a previously
+// active channel may only be reopened with the same ordering, or as an
+// UNORDERED channel if it was ORDERED.
 // The version returned will include the registered interchain
 // account address.
 func (k Keeper) OnChanOpenTry(
@@ -27,8 +31,8 @@ func (k Keeper) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.UNORDERED, order)
 	}
 
 	if portID != icatypes.PortID {
@@ -59,7 +63,13 @@ func (k Keeper) OnChanOpenTry(
 			return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is already OPEN", activeChannelID, portID)
 		}
 
-		if !icatypes.IsPreviousMetadataEqual(channel.Version, metadata) {
+		if !icatypes.IsPreviousOrderingCompatible(channel.Ordering, order) {
+			return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "channel ordering cannot change from %s to %s when reopening a channel", channel.Ordering, order)
+		}
+
+		// the version of a fee enabled channel is wrapped in the fee middleware metadata
+		appVersion, _ := k.ics4Wrapper.GetAppVersion(ctx, portID, activeChannelID)
+		if !icatypes.IsPreviousMetadataEqual(appVersion, metadata) {
 			return "", sdkerrors.Wrap(icatypes.ErrInvalidVersion, "previous active channel metadata does not match provided version")
 		}
 	}
@@ -70,12 +80,23 @@ func (k Keeper) OnChanOpenTry(
 		return "", sdkerrors.Wrapf(err, "failed to claim capability for channel %s on port %s", channelID, portID)
 	}
 
-	accAddress := icatypes.GenerateAddress(k.accountKeeper.GetModuleAddress(icatypes.ModuleName), metadata.HostConnectionId, counterparty.PortId)
+	// An interchain account migrated to this connection is bound to its existing address
+	address, found := k.GetInterchainAccountAddress(ctx, metadata.HostConnectionId, counterparty.PortId)
+	if !found {
+		accAddress := icatypes.GenerateAddress(k.accountKeeper.GetModuleAddress(icatypes.ModuleName), metadata.HostConnectionId, counterparty.PortId)
 
-	// Register interchain account if it does not already exist
-	k.RegisterInterchainAccount(ctx, metadata.HostConnectionId, counterparty.PortId, accAddress)
+		// An interchain account generated for this connection which is no longer bound to it has been migrated to another connection
+		if _, ok := k.accountKeeper.GetAccount(ctx, accAddress).(*icatypes.InterchainAccount); ok {
+			return "", sdkerrors.Wrapf(icatypes.ErrAccountAlreadyExist, "interchain account %s has been migrated from connection %s", accAddress, metadata.HostConnectionId)
+		}
+
+		// Register interchain account if it does not already exist
+		k.RegisterInterchainAccount(ctx, metadata.HostConnectionId, counterparty.PortId, accAddress)
+
+		address = accAddress.String()
+	}
 
-	metadata.Address = accAddress.String()
+	metadata.Address = address
 	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
 	if err != nil {
 		return "", err
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -41,6 +42,18 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointB.ChannelID)
 			}, true,
 		},
+		{
+			"interchain account has been migrated from the connection",
+			func() {
+				path.EndpointB.SetChannel(*channel)
+
+				// the interchain account generated for the connection is no longer bound to it
+				accAddress := icatypes.GenerateAddress(suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(icatypes.ModuleName), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				interchainAccount := icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(accAddress), path.EndpointA.ChannelConfig.PortID)
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), suite.chainB.GetSimApp().AccountKeeper.NewAccount(suite.chainB.GetContext(), interchainAccount))
+			},
+			false,
+		},
 		{
 			"invalid metadata - previous metadata is different",
 			func() {
@@ -61,9 +74,41 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			}, false,
 		},
 		{
-			"invalid order - UNORDERED",
+			"success - UNORDERED channel",
 			func() {
 				channel.Ordering = channeltypes.UNORDERED
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
+		{
+			"success - previous ORDERED active channel reopened as UNORDERED",
+			func() {
+				// create a new channel and set it in state
+				ch := channeltypes.NewChannel(channeltypes.CLOSED, channeltypes.ORDERED, channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), []string{path.EndpointA.ConnectionID}, TestVersion)
+				suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, ch)
+
+				// set the active channelID in state
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+				channel.Ordering = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"invalid order - previous UNORDERED active channel reopened as ORDERED",
+			func() {
+				// create a new channel and set it in state
+				ch := channeltypes.NewChannel(channeltypes.CLOSED, channeltypes.UNORDERED, channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), []string{path.EndpointA.ConnectionID}, TestVersion)
+				suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, ch)
+
+				// set the active channelID in state
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			}, false,
+		},
+		{
+			"invalid order - NONE",
+			func() {
+				channel.Ordering = channeltypes.NONE
 			},
 			false,
 		},
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+)
+
+// MigrateInterchainAccount re-binds the interchain account registered on the provided connection for the provided
+// controller port to the new connection, for instance when the client of the connection has expired. The interchain
+// account must be owned by the controller port, which is kept, so that the owner of the interchain account is the same
+// on the new connection. The clients of both connections must be of the same type and track the same chain ID, so that
+// the new connection is to the same controller chain. The interchain account is unbound from the previous connection,
+// whose channels can no longer be used to control it. The controller chain may then open a channel on the new connection
+// with the controller port, which is bound to the existing interchain account address.
+func (k Keeper) MigrateInterchainAccount(ctx sdk.Context, connectionID, portID, newConnectionID string) error {
+	if connectionID == newConnectionID {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidOutgoingData, "interchain account cannot be migrated to its current connection %s", connectionID)
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on connection %s for port %s", connectionID, portID)
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	interchainAccount, ok := k.accountKeeper.GetAccount(ctx, accAddress).(*icatypes.InterchainAccount)
+	if !ok {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "account %s is not an interchain account", address)
+	}
+
+	if interchainAccount.AccountOwner != portID {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "interchain account %s is owned by %s, not %s", address, interchainAccount.AccountOwner, portID)
+	}
+
+	if err := k.validateSameCounterpartyChain(ctx, connectionID, newConnectionID); err != nil {
+		return err
+	}
+
+	if existingAddress, found := k.GetInterchainAccountAddress(ctx, newConnectionID, portID); found {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountAlreadySet, "interchain account %s is already registered on connection %s for port %s", existingAddress, newConnectionID, portID)
+	}
+
+	k.SetInterchainAccountAddress(ctx, newConnectionID, portID, address)
+	k.deleteInterchainAccountAddress(ctx, connectionID, portID)
+
+	k.Logger(ctx).Info("interchain account migrated", "address", address, "port-id", portID, "connection-id", connectionID, "new-connection-id", newConnectionID)
+
+	EmitMigrateInterchainAccountEvent(ctx, connectionID, portID, newConnectionID, address)
+
+	return nil
+}
+
+// HandleMigrateInterchainAccountProposal migrates the interchain account contained in the given governance proposal.
+func (k Keeper) HandleMigrateInterchainAccountProposal(ctx sdk.Context, p *types.MigrateInterchainAccountProposal) error {
+	return k.MigrateInterchainAccount(ctx, p.ConnectionId, p.PortId, p.NewConnectionId)
+}
+
+// chainIDClientState is implemented by the client states which track a chain identified by a chain ID
+type chainIDClientState interface {
+	GetChainID() string
+}
+
+// validateSameCounterpartyChain returns an error if the clients of the provided connections are not of the same type
+// or do not track the same chain ID. Clients which do not track a chain ID are rejected.
+func (k Keeper) validateSameCounterpartyChain(ctx sdk.Context, connectionID, newConnectionID string) error {
+	clientID, clientState, err := k.channelKeeper.GetConnectionClientState(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+
+	newClientID, newClientState, err := k.channelKeeper.GetConnectionClientState(ctx, newConnectionID)
+	if err != nil {
+		return err
+	}
+
+	if clientState.ClientType() != newClientState.ClientType() {
+		return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "client %s of connection %s is of type %s, but client %s of connection %s is of type %s",
+			newClientID, newConnectionID, newClientState.ClientType(), clientID, connectionID, clientState.ClientType())
+	}
+
+	chainIDState, ok := clientState.(chainIDClientState)
+	if !ok {
+		return sdkerrors.Wrapf(icatypes.ErrUnsupported, "client %s of type %s does not track a chain ID", clientID, clientState.ClientType())
+	}
+
+	newChainIDState, ok := newClientState.(chainIDClientState)
+	if !ok {
+		return sdkerrors.Wrapf(icatypes.ErrUnsupported, "client %s of type %s does not track a chain ID", newClientID, newClientState.ClientType())
+	}
+
+	if chainIDState.GetChainID() != newChainIDState.GetChainID() {
+		return sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "connection %s is to chain %s, but connection %s is to chain %s",
+			newConnectionID, newChainIDState.GetChainID(), connectionID, chainIDState.GetChainID())
+	}
+
+	return nil
+}
+
+// deleteInterchainAccountAddress removes the InterchainAccount address keyed by the associated connectionID and portID
+func (k Keeper) deleteInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(icatypes.KeyOwnerAccount(portID, connectionID))
+	store.Delete(icatypes.KeyConnectionOwnerAccount(connectionID, portID))
+}
@@ -0,0 +1,329 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// NewICAPathOnConnection creates an interchain accounts path using a channel version with the provided connection
+// identifiers. The connection must be created separately, for instance with SetupConnections.
+func NewICAPathOnConnection(chainA, chainB *ibctesting.TestChain, connectionID string) *ibctesting.Path {
+	path := NewICAPath(chainA, chainB)
+
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: connectionID,
+		HostConnectionId:       connectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	return path
+}
+
+func (suite *KeeperTestSuite) TestMigrateInterchainAccount() {
+	var (
+		path            *ibctesting.Path
+		newPath         *ibctesting.Path
+		connectionID    string
+		portID          string
+		newConnectionID string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"interchain account not found for connection",
+			func() {
+				connectionID = "connection-100"
+			},
+			false,
+		},
+		{
+			"interchain account not found for port",
+			func() {
+				portID = "icacontroller-invalid-owner"
+			},
+			false,
+		},
+		{
+			"new connection not found",
+			func() {
+				newConnectionID = "connection-100"
+			},
+			false,
+		},
+		{
+			"new connection is to a different chain",
+			func() {
+				otherPath := ibctesting.NewPath(suite.chainC, suite.chainB)
+				suite.coordinator.SetupConnections(otherPath)
+
+				newConnectionID = otherPath.EndpointB.ConnectionID
+			},
+			false,
+		},
+		{
+			"new connection is the current connection",
+			func() {
+				newConnectionID = connectionID
+			},
+			false,
+		},
+		{
+			"interchain account already registered on the new connection",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), newConnectionID, portID, TestAccAddress.String())
+			},
+			false,
+		},
+		{
+			"interchain account is not owned by the port",
+			func() {
+				address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), connectionID, portID)
+				suite.Require().True(found)
+
+				portID = "icacontroller-other-owner"
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), connectionID, portID, address)
+			},
+			false,
+		},
+		{
+			"account is not an interchain account",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), connectionID, portID, suite.chainB.SenderAccount.GetAddress().String())
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			newPath = NewICAPathOnConnection(suite.chainA, suite.chainB, "connection-1")
+			suite.coordinator.SetupConnections(newPath)
+
+			connectionID = path.EndpointB.ConnectionID
+			portID = path.EndpointA.ChannelConfig.PortID
+			newConnectionID = newPath.EndpointB.ConnectionID
+
+			tc.malleate() // malleate mutates test data
+
+			address, _ := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), connectionID, portID)
+
+			err = suite.chainB.GetSimApp().ICAHostKeeper.MigrateInterchainAccount(suite.chainB.GetContext(), connectionID, portID, newConnectionID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), connectionID, portID)
+				suite.Require().False(found)
+
+				migratedAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), newConnectionID, portID)
+				suite.Require().True(found)
+				suite.Require().Equal(address, migratedAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateInterchainAccountReuseOnNewConnection() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	newPath := NewICAPathOnConnection(suite.chainA, suite.chainB, "connection-1")
+	suite.coordinator.SetupConnections(newPath)
+
+	portID := path.EndpointA.ChannelConfig.PortID
+	address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), portID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+	proposal := types.NewMigrateInterchainAccountProposal(ibctesting.Title, ibctesting.Description, path.EndpointB.ConnectionID, portID, newPath.EndpointB.ConnectionID).(*types.MigrateInterchainAccountProposal)
+	err = suite.chainB.GetSimApp().ICAHostKeeper.HandleMigrateInterchainAccountProposal(suite.chainB.GetContext(), proposal)
+	suite.Require().NoError(err)
+
+	// the controller chain opens a channel on the new connection with the same owner
+	err = SetupICAPath(newPath, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	migratedAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), newPath.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+	suite.Require().Equal(address, migratedAddress)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: address,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// packets on the previous connection can no longer control the interchain account
+	packet := channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		1,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+	suite.Require().Error(err)
+
+	packet = channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		1,
+		newPath.EndpointA.ChannelConfig.PortID,
+		newPath.EndpointA.ChannelID,
+		newPath.EndpointB.ChannelConfig.PortID,
+		newPath.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgMigrateInterchainAccount() {
+	var (
+		path    *ibctesting.Path
+		newPath *ibctesting.Path
+		signer  string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"signer is not an interchain account",
+			func() {
+				signer = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"signer is not the interchain account registered on the connection",
+			func() {
+				otherAddress := icatypes.GenerateAddress(suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(icatypes.ModuleName), path.EndpointB.ConnectionID, "icacontroller-other-owner")
+				account := icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(otherAddress), "icacontroller-other-owner")
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), suite.chainB.GetSimApp().AccountKeeper.NewAccount(suite.chainB.GetContext(), account))
+
+				signer = otherAddress.String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			newPath = NewICAPathOnConnection(suite.chainA, suite.chainB, "connection-1")
+			suite.coordinator.SetupConnections(newPath)
+
+			var found bool
+			signer, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			tc.malleate() // malleate mutates test data
+
+			msg := types.NewMsgMigrateInterchainAccount(signer, path.EndpointB.ConnectionID, newPath.EndpointB.ConnectionID)
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			_, foundOnNewConnection := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), newPath.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(foundOnNewConnection)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(foundOnNewConnection)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the interchain accounts host MsgServer interface
+// for the provided Keeper
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// MigrateInterchainAccount defines a rpc handler for MsgMigrateInterchainAccount. The signer must be the interchain
+// account registered on the provided connection, thus the migration is initiated by the owner of the interchain
+// account, through an interchain accounts packet sent by the controller chain.
+func (s msgServer) MigrateInterchainAccount(goCtx context.Context, msg *types.MsgMigrateInterchainAccount) (*types.MsgMigrateInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	interchainAccount, ok := s.accountKeeper.GetAccount(ctx, accAddress).(*icatypes.InterchainAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "signer %s is not an interchain account", msg.Signer)
+	}
+
+	address, found := s.GetInterchainAccountAddress(ctx, msg.ConnectionId, interchainAccount.AccountOwner)
+	if !found || address != msg.Signer {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the interchain account registered on connection %s", msg.Signer, msg.ConnectionId)
+	}
+
+	if err := s.Keeper.MigrateInterchainAccount(ctx, msg.ConnectionId, interchainAccount.AccountOwner, msg.NewConnectionId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateInterchainAccountResponse{}, nil
+}
@@ -21,9 +21,38 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetDenyMessages retrieves the host denied msg types from the paramstore
+func (k Keeper) GetDenyMessages(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyDenyMessages, &res)
+	return res
+}
+
+// GetConnectionOverrides retrieves the per-connection allowed and denied msg types from the paramstore
+func (k Keeper) GetConnectionOverrides(ctx sdk.Context) []types.ConnectionOverride {
+	var res []types.ConnectionOverride
+	k.paramSpace.GetIfExists(ctx, types.KeyConnectionOverrides, &res)
+	return res
+}
+
+// GetAllowQueries retrieves the host allowed gRPC query method paths from the paramstore
+func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowQueries, &res)
+	return res
+}
+
+// GetMaxGasPerPacket retrieves the maximum amount of gas consumed by the execution of a packet from the paramstore.
+// The default is returned if the param is not set.
+func (k Keeper) GetMaxGasPerPacket(ctx sdk.Context) uint64 {
+	res := types.DefaultMaxGasPerPacket
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxGasPerPacket, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx), k.GetDenyMessages(ctx), k.GetConnectionOverrides(ctx), k.GetAllowQueries(ctx), k.GetMaxGasPerPacket(ctx))
 }
 
 // SetParams sets the total set of the host submodule parameters.
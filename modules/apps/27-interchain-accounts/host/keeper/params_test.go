@@ -10,6 +10,12 @@ func (suite *KeeperTestSuite) TestParams() {
 
 	expParams.HostEnabled = false
 	expParams.AllowMessages = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+	expParams.DenyMessages = []string{"/cosmos.gov.*"}
+	expParams.ConnectionOverrides = []types.ConnectionOverride{
+		types.NewConnectionOverride("connection-0", []string{types.AllowAllMessages}, nil),
+	}
+	expParams.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/Balance"}
+	expParams.MaxGasPerPacket = 500000
 	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
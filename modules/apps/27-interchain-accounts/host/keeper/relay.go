@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -12,100 +14,288 @@ import (
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
+// If the queries are successfully executed, the query response bytes will be returned.
+// The packet is executed with a gas meter limited to the MaxGasPerPacket host param.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
-	var data icatypes.InterchainAccountPacketData
+	data, metadata, err := k.unmarshalPacket(ctx, packet)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
-		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
+	results, err := k.executePacket(ctx, packet, data, metadata.Encoding)
+	if err != nil {
+		return nil, err
 	}
 
 	switch data.Type {
-	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
+	case icatypes.EXECUTE_QUERY:
+		queryResponse := &icatypes.CosmosQueryResponse{
+			Responses: make([][]byte, len(results)),
+			Height:    ctx.BlockHeight(),
+		}
+
+		for i, result := range results {
+			queryResponse.Responses[i] = result.Data
+		}
+
+		response, err := k.marshalResponse(queryResponse, metadata.Encoding)
 		if err != nil {
-			return nil, err
+			return nil, sdkerrors.Wrap(err, "failed to marshal query response")
+		}
+
+		return response, nil
+	default:
+		txMsgData := &sdk.TxMsgData{
+			Data: make([]*sdk.MsgData, len(results)),
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		for i, result := range results {
+			txMsgData.Data[i] = &sdk.MsgData{
+				MsgType: result.TypeUrl,
+				Data:    result.Data,
+			}
+		}
+
+		txResponse, err := k.marshalResponse(txMsgData, metadata.Encoding)
 		if err != nil {
-			return nil, err
+			return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
 		}
 
 		return txResponse, nil
-	default:
-		return nil, icatypes.ErrUnknownDataType
 	}
 }
 
-// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
-// If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
-// into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
-// execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+// OnRecvPacketWithResults handles a given interchain accounts packet on a destination host chain in the same way
+// as OnRecvPacket, and returns the structured acknowledgement of the packet, containing the result of each executed
+// message or query. If the execution of the packet failed, the returned error is the cause of the failure, while
+// the acknowledgement only contains its deterministic ABCI code and codespace. An event is emitted for the result of
+// each message or query, including those of a failed packet.
+func (k Keeper) OnRecvPacketWithResults(ctx sdk.Context, packet channeltypes.Packet) (icatypes.InterchainAccountAcknowledgement, error) {
+	data, metadata, err := k.unmarshalPacket(ctx, packet)
+	if err != nil {
+		return icatypes.NewErrorAcknowledgement(err, nil, ctx.BlockHeight()), err
+	}
+
+	results, err := k.executePacket(ctx, packet, data, metadata.Encoding)
+	EmitMsgResultEvents(ctx, packet, results)
+	if err != nil {
+		return icatypes.NewErrorAcknowledgement(err, results, ctx.BlockHeight()), err
+	}
+
+	return icatypes.NewResultAcknowledgement(results, ctx.BlockHeight()), nil
+}
+
+// GetAckVersion returns the acknowledgement version negotiated in the metadata of the provided host channel.
+// An empty string is returned if the channel metadata cannot be retrieved.
+func (k Keeper) GetAckVersion(ctx sdk.Context, portID, channelID string) string {
+	metadata, err := k.getAppMetadata(ctx, portID, channelID)
+	if err != nil {
+		return ""
+	}
+
+	return metadata.AckVersion
+}
+
+// unmarshalPacket unmarshals the interchain accounts packet data and retrieves the metadata of the channel
+// the packet was received on
+func (k Keeper) unmarshalPacket(ctx sdk.Context, packet channeltypes.Packet) (icatypes.InterchainAccountPacketData, icatypes.Metadata, error) {
+	var data icatypes.InterchainAccountPacketData
+
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return icatypes.InterchainAccountPacketData{}, icatypes.Metadata{}, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+
+	metadata, err := k.getAppMetadata(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, icatypes.Metadata{}, err
+	}
+
+	return data, metadata, nil
+}
+
+// executePacket executes the transaction or the queries of the packet data, according to its type, with a gas meter
+// limited to the MaxGasPerPacket host param. The results of the executed messages or queries are returned. If a
+// message or query fails, the returned results end with its failed result.
+func (k Keeper) executePacket(ctx sdk.Context, packet channeltypes.Packet, data icatypes.InterchainAccountPacketData, encoding string) ([]icatypes.MsgResult, error) {
+	return k.executeWithGasLimit(ctx, k.GetMaxGasPerPacket(ctx), func(ctx sdk.Context) ([]icatypes.MsgResult, error) {
+		switch data.Type {
+		case icatypes.EXECUTE_TX:
+			msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, encoding)
+			if err != nil {
+				return nil, err
+			}
+
+			return k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		case icatypes.EXECUTE_QUERY:
+			requests, err := icatypes.DeserializeCosmosQuery(k.cdc, data.Data, encoding)
+			if err != nil {
+				return nil, err
+			}
+
+			return k.executeQuery(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, requests)
+		default:
+			return nil, icatypes.ErrUnknownDataType
+		}
+	})
+}
+
+// executeWithGasLimit runs the execution of a packet with a gas meter limited to the given gas limit. Running out of
+// gas returns an out of gas error, which only depends on the gas limit and not on the gas provided by the relayer, so
+// that the error acknowledgement is deterministic. The gas consumed by the execution is then charged to the given
+// context, failing the transaction of a relayer which did not provide enough gas, which may be retried with more gas.
+func (k Keeper) executeWithGasLimit(ctx sdk.Context, gasLimit uint64, execute func(sdk.Context) ([]icatypes.MsgResult, error)) (results []icatypes.MsgResult, err error) {
+	gasMeter := sdk.NewGasMeter(gasLimit)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+
+			results, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "interchain account packet execution out of gas; gas limit: %d", gasLimit)
+		}
+
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain account packet execution")
+	}()
+
+	return execute(ctx.WithGasMeter(gasMeter))
+}
+
+// executeTx attempts to execute the provided transaction. It begins by retrieving the interchain account of the
+// controller port. Each message is then authenticated and basically validated before being delivered into state.
+// The state changes will only be committed if all messages in the transaction succeed. Thus the execution of the
+// transaction is atomic, all state changes are reverted if a single message fails. The results of the executed
+// messages are returned, ending with the failed result of the failing message if any.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]icatypes.MsgResult, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
-		return nil, err
+	connectionID := channel.ConnectionHops[0]
+	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, sourcePort)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", sourcePort)
 	}
 
-	txMsgData := &sdk.TxMsgData{
-		Data: make([]*sdk.MsgData, len(msgs)),
-	}
+	params := k.GetParams(ctx)
+	results := make([]icatypes.MsgResult, 0, len(msgs))
 
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
-	for i, msg := range msgs {
-		if err := msg.ValidateBasic(); err != nil {
-			return nil, err
-		}
-
-		msgResponse, err := k.executeMsg(cacheCtx, msg)
+	for _, msg := range msgs {
+		msgResponse, err := k.executeAuthenticatedMsg(cacheCtx, params, connectionID, interchainAccountAddr, msg)
 		if err != nil {
-			return nil, err
-		}
-
-		txMsgData.Data[i] = &sdk.MsgData{
-			MsgType: sdk.MsgTypeURL(msg),
-			Data:    msgResponse,
+			return append(results, icatypes.NewFailedMsgResult(sdk.MsgTypeURL(msg), err)), err
 		}
 
+		results = append(results, icatypes.NewMsgResult(sdk.MsgTypeURL(msg), msgResponse))
 	}
 
 	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	writeCache()
 
-	txResponse, err := proto.Marshal(txMsgData)
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+	return results, nil
+}
+
+// executeAuthenticatedMsg authenticates the provided msg, ensuring its type is allowed on the connection and its
+// signers are the interchain account, performs its basic validation and executes it
+func (k Keeper) executeAuthenticatedMsg(ctx sdk.Context, params types.Params, connectionID, interchainAccountAddr string, msg sdk.Msg) ([]byte, error) {
+	if err := authenticateMsg(params, connectionID, interchainAccountAddr, msg); err != nil {
+		return nil, err
 	}
 
-	return txResponse, nil
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return k.executeMsg(ctx, msg)
 }
 
-// authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
-func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
-	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+// executeQuery executes the provided gRPC query requests on behalf of the interchain account registered on the
+// controller port. Each query method path must be allowed by the host params. The queries are executed against a
+// cached context which is discarded, so queries cannot modify state. The results of the executed queries are
+// returned, ending with the failed result of the failing query if any.
+func (k Keeper) executeQuery(ctx sdk.Context, sourcePort, destPort, destChannel string, requests []icatypes.QueryRequest) ([]icatypes.MsgResult, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
-		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
+		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	allowMsgs := k.GetAllowMessages(ctx)
-	for _, msg := range msgs {
-		if !types.ContainsMsgType(allowMsgs, msg) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+	if _, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], sourcePort); !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", sourcePort)
+	}
+
+	params := k.GetParams(ctx)
+	results := make([]icatypes.MsgResult, 0, len(requests))
+
+	cacheCtx, _ := ctx.CacheContext()
+	for _, request := range requests {
+		res, err := k.executeAllowedQuery(cacheCtx, params, request)
+		if err != nil {
+			return append(results, icatypes.NewFailedMsgResult(request.Path, err)), err
 		}
 
-		for _, signer := range msg.GetSigners() {
-			if interchainAccountAddr != signer.String() {
-				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String())
-			}
+		results = append(results, icatypes.NewMsgResult(request.Path, res))
+	}
+
+	return results, nil
+}
+
+// executeAllowedQuery ensures the query method path is allowed by the host params and executes the query
+// through the gRPC query router, returning the proto3 encoded query response
+func (k Keeper) executeAllowedQuery(ctx sdk.Context, params types.Params, request icatypes.QueryRequest) ([]byte, error) {
+	if !params.IsAllowedQuery(request.Path) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", request.Path)
+	}
+
+	handler := k.queryRouter.Route(request.Path)
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidRoute, "no route to query path %s", request.Path)
+	}
+
+	res, err := handler(ctx, abci.RequestQuery{
+		Path: request.Path,
+		Data: request.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Value, nil
+}
+
+// marshalResponse marshals the transaction or query response using the provided encoding, so that acknowledgements
+// are returned in the same encoding as the packets of the channel
+func (k Keeper) marshalResponse(response proto.Message, encoding string) ([]byte, error) {
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		return proto.Marshal(response)
+	case icatypes.EncodingProto3JSON:
+		protoCdc, ok := k.cdc.(*codec.ProtoCodec)
+		if !ok {
+			return nil, sdkerrors.Wrap(icatypes.ErrInvalidCodec, "only ProtoCodec is supported for proto3json encoding")
+		}
+
+		return protoCdc.MarshalJSON(response)
+	default:
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
+
+// authenticateMsg ensures the type of the provided msg is allowed on the connection by the host params and that
+// its signers are the provided interchain account address
+func authenticateMsg(params types.Params, connectionID, interchainAccountAddr string, msg sdk.Msg) error {
+	if !params.IsAllowedMsg(connectionID, msg) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+	}
+
+	for _, signer := range msg.GetSigners() {
+		if interchainAccountAddr != signer.String() {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String())
 		}
 	}
 
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -41,7 +43,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -51,7 +53,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -69,7 +71,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -79,7 +81,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -103,7 +105,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgDelegate, msgUndelegate})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgDelegate, msgUndelegate}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -113,7 +115,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -138,7 +140,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Proposer:       interchainAccountAddr,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -148,7 +150,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -177,7 +179,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Option:     govtypes.OptionYes,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -187,7 +189,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -203,7 +205,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Depositor: interchainAccountAddr,
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -213,7 +215,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -229,7 +231,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					WithdrawAddress:  suite.chainB.SenderAccount.GetAddress().String(),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -239,7 +241,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -268,7 +270,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					TimeoutTimestamp: uint64(0),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -278,7 +280,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -307,7 +309,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{
 			"invalid packet type - UNSPECIFIED",
 			func() {
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -324,7 +326,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			func() {
 				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{&banktypes.MsgSend{}}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -345,7 +347,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -357,6 +359,201 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			false,
 		},
+		{
+			"interchain account successfully executes banktypes.MsgSend allowed by the wildcard",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllMessages}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend allowed by a package prefix",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"/cosmos.bank.*"}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend allowed by a connection override",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, nil, nil, []types.ConnectionOverride{
+					types.NewConnectionOverride(ibctesting.FirstConnectionID, []string{sdk.MsgTypeURL(msg)}, nil),
+				}, nil, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"unauthorised: message type is not allowed",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"/cosmos.staking.*"}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"unauthorised: message type is denied",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllMessages}, []string{sdk.MsgTypeURL(msg)}, nil, nil, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"unauthorised: message type is denied by a connection override",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllMessages}, nil, []types.ConnectionOverride{
+					types.NewConnectionOverride(ibctesting.FirstConnectionID, []string{types.AllowAllMessages}, []string{"/cosmos.bank.*"}),
+				}, nil, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"unauthorised: message type is only allowed on another connection",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, nil, nil, []types.ConnectionOverride{
+					types.NewConnectionOverride("connection-1", []string{sdk.MsgTypeURL(msg)}, nil),
+				}, nil, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
 		{
 			"unauthorised: signer address is not the interchain account associated with the controller portID",
 			func() {
@@ -366,7 +563,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
 				}
 
-				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
 				suite.Require().NoError(err)
 
 				icaPacketData := icatypes.InterchainAccountPacketData{
@@ -376,7 +573,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, types.DefaultMaxGasPerPacket)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			false,
@@ -437,6 +634,574 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketGasLimit() {
+	testCases := []struct {
+		msg             string
+		maxGasPerPacket uint64
+		relayerGas      uint64
+		expPass         bool
+		expPanic        bool
+	}{
+		{"execution within the gas limit", types.DefaultMaxGasPerPacket, 10 * types.DefaultMaxGasPerPacket, true, false},
+		{"execution exceeding the max gas per packet", 10000, 10 * types.DefaultMaxGasPerPacket, false, false},
+		{"relayer provides less gas than the execution consumes", types.DefaultMaxGasPerPacket, 10000, false, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, tc.maxGasPerPacket)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext().WithGasMeter(sdk.NewGasMeter(tc.relayerGas))
+
+			if tc.expPanic {
+				suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: "interchain account packet execution"}, func() {
+					suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+				})
+				return
+			}
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().Equal(sdk.NewInt(9900), balance.Amount)
+				suite.Require().NotZero(ctx.GasMeter().GasConsumed())
+			} else {
+				suite.Require().ErrorIs(err, sdkerrors.ErrOutOfGas)
+				suite.Require().Nil(txResponse)
+				suite.Require().Equal(sdk.NewInt(10000), balance.Amount)
+				// the gas consumed up to the max gas per packet is charged to the relayer
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), tc.maxGasPerPacket)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketProto3JSON() {
+	var (
+		path       *ibctesting.Path
+		packetData []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(interchainAccountAddr string)
+		expPass  bool
+	}{
+		{
+			"interchain account successfully executes a proto3json encoded banktypes.MsgSend",
+			func(interchainAccountAddr string) {
+				// the CosmosTx is produced without a protobuf library, as by a smart contract on the controller chain
+				data := []byte(`{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"` + interchainAccountAddr +
+					`","to_address":"` + suite.chainB.SenderAccount.GetAddress().String() + `","amount":[{"denom":"stake","amount":"100"}]}]}`)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			true,
+		},
+		{
+			"interchain account successfully executes a banktypes.MsgSend serialized as proto3json",
+			func(interchainAccountAddr string) {
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProto3JSON)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			true,
+		},
+		{
+			"cannot deserialize proto3 encoded data on a proto3json channel",
+			func(interchainAccountAddr string) {
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			false,
+		},
+		{
+			"cannot resolve unknown message type",
+			func(interchainAccountAddr string) {
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte(`{"messages":[{"@type":"/unknown.v1.MsgUnknown"}]}`),
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			path.EndpointA.ChannelConfig.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+				Version:                icatypes.Version,
+				ControllerConnectionId: ibctesting.FirstConnectionID,
+				HostConnectionId:       ibctesting.FirstConnectionID,
+				Encoding:               icatypes.EncodingProto3JSON,
+				TxType:                 icatypes.TxTypeSDKMultiMsg,
+			}))
+
+			err := SetupICAPathWithVersion(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			params := types.NewParams(true, []string{types.AllowAllMessages}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate(interchainAccountAddr)
+
+			packet := channeltypes.NewPacket(
+				packetData,
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// the tx response is encoded as proto3json
+				var txMsgData sdk.TxMsgData
+				err = suite.chainB.GetSimApp().AppCodec().UnmarshalJSON(txResponse, &txMsgData)
+				suite.Require().NoError(err)
+				suite.Require().Len(txMsgData.Data, 1)
+				suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), txMsgData.Data[0].MsgType)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketWithResults() {
+	var (
+		path       *ibctesting.Path
+		packetData []byte
+	)
+
+	testCases := []struct {
+		msg            string
+		malleate       func(interchainAccountAddr string)
+		expResults     int
+		expFailedIndex int64
+		expErr         *sdkerrors.Error
+	}{
+		{
+			"interchain account successfully executes two banktypes.MsgSend",
+			func(interchainAccountAddr string) {
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg, msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			2, -1, nil,
+		},
+		{
+			"second message fails with insufficient funds",
+			func(interchainAccountAddr string) {
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(6000))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg, msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			2, 1, sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"first message is not allowed",
+			func(interchainAccountAddr string) {
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: suite.chainB.Vals.Validators[0].Address.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			1, 0, sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure not caused by a message - unknown packet data type",
+			func(interchainAccountAddr string) {
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.UNSPECIFIED,
+					Data: []byte("data"),
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			0, -1, icatypes.ErrUnknownDataType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			path.EndpointA.ChannelConfig.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+				Version:                icatypes.Version,
+				ControllerConnectionId: ibctesting.FirstConnectionID,
+				HostConnectionId:       ibctesting.FirstConnectionID,
+				Encoding:               icatypes.EncodingProtobuf,
+				TxType:                 icatypes.TxTypeSDKMultiMsg,
+				AckVersion:             icatypes.AckVersion1,
+			}))
+
+			err := SetupICAPathWithVersion(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			ackVersion := suite.chainB.GetSimApp().ICAHostKeeper.GetAckVersion(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().Equal(icatypes.AckVersion1, ackVersion)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate(interchainAccountAddr)
+
+			packet := channeltypes.NewPacket(
+				packetData,
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			ack, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacketWithResults(ctx, packet)
+
+			suite.Require().Equal(icatypes.AckVersion1, ack.Version)
+			suite.Require().Equal(ctx.BlockHeight(), ack.Height)
+			suite.Require().Len(ack.Results, tc.expResults)
+			suite.Require().Equal(tc.expFailedIndex, ack.FailedIndex)
+
+			var msgResultEvents int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == icatypes.EventTypeMsgResult {
+					msgResultEvents++
+				}
+			}
+			suite.Require().Equal(tc.expResults, msgResultEvents)
+
+			decodedAck, decodeErr := icatypes.UnmarshalAcknowledgement(ack.Acknowledgement())
+			suite.Require().NoError(decodeErr)
+			suite.Require().Equal(ack.Acknowledgement(), decodedAck.Acknowledgement())
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(ack.Success())
+
+				for _, result := range ack.Results {
+					suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), result.TypeUrl)
+
+					var msgResponse banktypes.MsgSendResponse
+					suite.Require().NoError(msgResponse.Unmarshal(result.Data))
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().False(ack.Success())
+				suite.Require().Equal(tc.expErr.ABCICode(), ack.Code)
+				suite.Require().Equal(tc.expErr.Codespace(), ack.Codespace)
+				if tc.expFailedIndex >= 0 {
+					suite.Require().Equal(tc.expErr.ABCICode(), ack.Results[tc.expFailedIndex].Code)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketQuery() {
+	var (
+		path       *ibctesting.Path
+		requests   []icatypes.QueryRequest
+		expBalance sdk.Coin
+	)
+
+	balancePath := "/cosmos.bank.v1beta1.Query/Balance"
+
+	testCases := []struct {
+		msg      string
+		malleate func(interchainAccountAddr string)
+		expPass  bool
+	}{
+		{
+			"interchain account successfully queries its balance",
+			func(interchainAccountAddr string) {},
+			true,
+		},
+		{
+			"interchain account successfully queries the balance of another account",
+			func(interchainAccountAddr string) {
+				expBalance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+				data, err := (&banktypes.QueryBalanceRequest{Address: suite.chainB.SenderAccount.GetAddress().String(), Denom: sdk.DefaultBondDenom}).Marshal()
+				suite.Require().NoError(err)
+
+				requests = []icatypes.QueryRequest{icatypes.NewQueryRequest(balancePath, data)}
+			},
+			true,
+		},
+		{
+			"query path not allowed",
+			func(interchainAccountAddr string) {
+				params := types.NewParams(true, nil, nil, nil, []string{"/cosmos.staking.*"}, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"allowed messages do not allow queries",
+			func(interchainAccountAddr string) {
+				params := types.NewParams(true, []string{types.AllowAllMessages}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"one of multiple queries not allowed",
+			func(interchainAccountAddr string) {
+				requests = append(requests, icatypes.NewQueryRequest("/cosmos.staking.v1beta1.Query/Params", nil))
+			},
+			false,
+		},
+		{
+			"no route to query path",
+			func(interchainAccountAddr string) {
+				requests = []icatypes.QueryRequest{icatypes.NewQueryRequest("/cosmos.bank.v1beta1.Query/Unknown", nil)}
+			},
+			false,
+		},
+		{
+			"query fails with invalid request",
+			func(interchainAccountAddr string) {
+				data, err := (&banktypes.QueryBalanceRequest{Address: "invalid-address", Denom: sdk.DefaultBondDenom}).Marshal()
+				suite.Require().NoError(err)
+
+				requests = []icatypes.QueryRequest{icatypes.NewQueryRequest(balancePath, data)}
+			},
+			false,
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(fmt.Sprintf("%s: %s", encoding, tc.msg), func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				path.EndpointA.ChannelConfig.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+					Version:                icatypes.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Encoding:               encoding,
+					TxType:                 icatypes.TxTypeSDKMultiMsg,
+				}))
+
+				err := SetupICAPathWithVersion(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				expBalance = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))
+				suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(expBalance))
+
+				params := types.NewParams(true, nil, nil, nil, []string{balancePath}, types.DefaultMaxGasPerPacket)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				data, err := (&banktypes.QueryBalanceRequest{Address: interchainAccountAddr, Denom: sdk.DefaultBondDenom}).Marshal()
+				suite.Require().NoError(err)
+
+				requests = []icatypes.QueryRequest{icatypes.NewQueryRequest(balancePath, data)}
+
+				tc.malleate(interchainAccountAddr)
+
+				queryData, err := icatypes.SerializeCosmosQuery(suite.chainA.GetSimApp().AppCodec(), requests, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_QUERY,
+					Data: queryData,
+				}
+
+				packet := channeltypes.NewPacket(
+					icaPacketData.GetBytes(),
+					suite.chainA.SenderAccount.GetSequence(),
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.NewHeight(0, 100),
+					0,
+				)
+
+				queryResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+
+					// the query response is encoded using the encoding of the channel
+					var cosmosQueryResponse icatypes.CosmosQueryResponse
+					if encoding == icatypes.EncodingProto3JSON {
+						err = suite.chainB.GetSimApp().AppCodec().UnmarshalJSON(queryResponse, &cosmosQueryResponse)
+					} else {
+						err = suite.chainB.GetSimApp().AppCodec().Unmarshal(queryResponse, &cosmosQueryResponse)
+					}
+					suite.Require().NoError(err)
+					suite.Require().Equal(suite.chainB.GetContext().BlockHeight(), cosmosQueryResponse.Height)
+					suite.Require().Len(cosmosQueryResponse.Responses, 1)
+
+					var balanceResponse banktypes.QueryBalanceResponse
+					err = suite.chainB.GetSimApp().AppCodec().Unmarshal(cosmosQueryResponse.Responses[0], &balanceResponse)
+					suite.Require().NoError(err)
+					suite.Require().Equal(expBalance, *balanceResponse.Balance)
+				} else {
+					suite.Require().Error(err)
+					suite.Require().Nil(queryResponse)
+				}
+			})
+		}
+	}
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
@@ -29,7 +29,21 @@ type Params struct {
 	// host_enabled enables or disables the host submodule.
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
+	// The wildcard "*" allows all messages, and a typeURL prefix followed by ".*", such as "/cosmos.staking.*",
+	// allows all messages whose typeURL starts with the prefix.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// deny_messages defines a list of sdk message typeURLs denied execution on a host chain, taking precedence over
+	// allow_messages. The same patterns as allow_messages are supported.
+	DenyMessages []string `protobuf:"bytes,3,rep,name=deny_messages,json=denyMessages,proto3" json:"deny_messages,omitempty" yaml:"deny_messages"`
+	// connection_overrides defines the allow and deny lists used instead of allow_messages and deny_messages for the
+	// interchain accounts registered on a given connection.
+	ConnectionOverrides []ConnectionOverride `protobuf:"bytes,4,rep,name=connection_overrides,json=connectionOverrides,proto3" json:"connection_overrides" yaml:"connection_overrides"`
+	// allow_queries defines a list of gRPC query method paths, such as "/cosmos.bank.v1beta1.Query/Balance", allowed to be
+	// executed on a host chain. The same patterns as allow_messages are supported.
+	AllowQueries []string `protobuf:"bytes,5,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// max_gas_per_packet defines the maximum amount of gas which may be consumed by the execution of an interchain
+	// accounts packet. Packets running out of gas are answered with an error acknowledgement.
+	MaxGasPerPacket uint64 `protobuf:"varint,6,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty" yaml:"max_gas_per_packet"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +93,152 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetDenyMessages() []string {
+	if m != nil {
+		return m.DenyMessages
+	}
+	return nil
+}
+
+func (m *Params) GetConnectionOverrides() []ConnectionOverride {
+	if m != nil {
+		return m.ConnectionOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+// ConnectionOverride defines the allow and deny lists of sdk message typeURLs for the interchain accounts registered
+// on a connection.
+type ConnectionOverride struct {
+	// the connection identifier on the host chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// the list of sdk message typeURLs allowed to be executed
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// the list of sdk message typeURLs denied execution, taking precedence over allow_messages
+	DenyMessages []string `protobuf:"bytes,3,rep,name=deny_messages,json=denyMessages,proto3" json:"deny_messages,omitempty" yaml:"deny_messages"`
+}
+
+func (m *ConnectionOverride) Reset()         { *m = ConnectionOverride{} }
+func (m *ConnectionOverride) String() string { return proto.CompactTextString(m) }
+func (*ConnectionOverride) ProtoMessage()    {}
+func (*ConnectionOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *ConnectionOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionOverride.Merge(m, src)
+}
+func (m *ConnectionOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionOverride proto.InternalMessageInfo
+
+func (m *ConnectionOverride) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionOverride) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+func (m *ConnectionOverride) GetDenyMessages() []string {
+	if m != nil {
+		return m.DenyMessages
+	}
+	return nil
+}
+
+// MigrateInterchainAccountProposal is a governance proposal which re-binds the interchain account registered on a
+// connection for a controller port to a new connection, for instance when the client of the connection has expired.
+// The controller port, and thus the owner of the interchain account, is unchanged.
+type MigrateInterchainAccountProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the host connection on which the interchain account is currently registered
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// the controller port of the interchain account
+	PortId string `protobuf:"bytes,4,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// the host connection to which the interchain account is migrated
+	NewConnectionId string `protobuf:"bytes,5,opt,name=new_connection_id,json=newConnectionId,proto3" json:"new_connection_id,omitempty" yaml:"new_connection_id"`
+}
+
+func (m *MigrateInterchainAccountProposal) Reset()         { *m = MigrateInterchainAccountProposal{} }
+func (m *MigrateInterchainAccountProposal) String() string { return proto.CompactTextString(m) }
+func (*MigrateInterchainAccountProposal) ProtoMessage()    {}
+func (*MigrateInterchainAccountProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *MigrateInterchainAccountProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateInterchainAccountProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateInterchainAccountProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateInterchainAccountProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateInterchainAccountProposal.Merge(m, src)
+}
+func (m *MigrateInterchainAccountProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateInterchainAccountProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateInterchainAccountProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateInterchainAccountProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*ConnectionOverride)(nil), "ibc.applications.interchain_accounts.host.v1.ConnectionOverride")
+	proto.RegisterType((*MigrateInterchainAccountProposal)(nil), "ibc.applications.interchain_accounts.host.v1.MigrateInterchainAccountProposal")
 }
 
 func init() {
@@ -88,26 +246,44 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd6, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xc9, 0x05, 0x61, 0x74, 0x01,
-	0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8e, 0x9b, 0x34, 0xbf, 0x1f, 0xd7, 0xb4, 0x15, 0x6e, 0x10, 0x2e, 0x7f, 0x6c, 0xeb, 0x58,
+	0x22, 0x41, 0x6d, 0xb5, 0x1d, 0x2a, 0x55, 0x42, 0x2a, 0xa9, 0x10, 0xb4, 0x52, 0x45, 0xf0, 0xc8,
+	0x62, 0x9d, 0xcf, 0x27, 0xf7, 0x84, 0xed, 0x33, 0x77, 0x97, 0xb4, 0xfd, 0x06, 0x8c, 0x4c, 0x88,
+	0x91, 0x8f, 0xd3, 0xb1, 0x03, 0x03, 0x93, 0x85, 0x92, 0x91, 0xcd, 0x9f, 0x00, 0xd9, 0x17, 0x25,
+	0x31, 0xe9, 0xd2, 0x89, 0xc9, 0xf7, 0xdc, 0x73, 0xcf, 0x73, 0xf7, 0xbc, 0xaf, 0xfc, 0x82, 0x03,
+	0x1a, 0x60, 0x17, 0x65, 0x59, 0x4c, 0x31, 0x92, 0x94, 0xa5, 0xc2, 0xa5, 0xa9, 0x24, 0x1c, 0x9f,
+	0x23, 0x9a, 0xfa, 0x08, 0x63, 0x36, 0x4c, 0xa5, 0x70, 0xcf, 0x99, 0x90, 0xee, 0x68, 0xb7, 0xfa,
+	0x3a, 0x19, 0x67, 0x92, 0xe9, 0x2f, 0x68, 0x80, 0x9d, 0x45, 0xa1, 0x73, 0x8b, 0xd0, 0xa9, 0x04,
+	0xa3, 0xdd, 0x47, 0xdd, 0x88, 0x45, 0xac, 0x12, 0xba, 0xe5, 0x4a, 0x79, 0xc0, 0xdf, 0x4d, 0xd0,
+	0x1e, 0x20, 0x8e, 0x12, 0xa1, 0x1f, 0x82, 0x4e, 0x79, 0xd6, 0x27, 0x29, 0x0a, 0x62, 0x12, 0x1a,
+	0x9a, 0xad, 0xf5, 0xfe, 0xef, 0x3f, 0x2c, 0x72, 0x6b, 0xeb, 0x0a, 0x25, 0xf1, 0x21, 0x5c, 0x64,
+	0xa1, 0xb7, 0x56, 0xc2, 0xd7, 0x0a, 0xe9, 0x47, 0x60, 0x03, 0xc5, 0x31, 0xbb, 0xf0, 0x13, 0x22,
+	0x04, 0x8a, 0x88, 0x30, 0x56, 0xec, 0x66, 0xef, 0x5e, 0x7f, 0xbb, 0xc8, 0xad, 0x07, 0x4a, 0x5d,
+	0xe7, 0xa1, 0xb7, 0x5e, 0x6d, 0x9c, 0x4d, 0xb1, 0xfe, 0x12, 0xac, 0x87, 0x24, 0xbd, 0x9a, 0x1b,
+	0x34, 0x2b, 0x03, 0xa3, 0xc8, 0xad, 0xae, 0x32, 0xa8, 0xd1, 0xd0, 0xeb, 0x94, 0x78, 0x26, 0xff,
+	0xa6, 0x81, 0x2e, 0x66, 0x69, 0x4a, 0x70, 0x59, 0x09, 0x9f, 0x8d, 0x08, 0xe7, 0x34, 0x24, 0xc2,
+	0x68, 0xd9, 0xcd, 0xde, 0xda, 0xde, 0x91, 0x73, 0x97, 0x5a, 0x39, 0xc7, 0x33, 0xa7, 0x77, 0x53,
+	0xa3, 0xfe, 0xb3, 0xeb, 0xdc, 0x6a, 0x14, 0xb9, 0xf5, 0x58, 0x3d, 0xe6, 0xb6, 0xbb, 0xa0, 0xb7,
+	0x85, 0x97, 0x84, 0x55, 0x32, 0x95, 0xfd, 0xd3, 0x90, 0x70, 0x4a, 0x84, 0xb1, 0xfa, 0x77, 0xb2,
+	0x1a, 0x0d, 0xbd, 0x4e, 0x85, 0xdf, 0x2b, 0xa8, 0x9f, 0x02, 0x3d, 0x41, 0x97, 0x7e, 0x84, 0x84,
+	0x9f, 0x11, 0xee, 0x67, 0x08, 0x7f, 0x24, 0xd2, 0x68, 0xdb, 0x5a, 0xaf, 0xd5, 0x7f, 0x5a, 0xe4,
+	0xd6, 0xb6, 0xf2, 0x58, 0x3e, 0x03, 0xbd, 0xcd, 0x04, 0x5d, 0xbe, 0x41, 0x62, 0x40, 0xf8, 0x40,
+	0xed, 0xfc, 0xd0, 0x80, 0xbe, 0x9c, 0xad, 0x7c, 0xe1, 0x42, 0x1e, 0xaa, 0x5a, 0x5f, 0x7b, 0x61,
+	0x8d, 0x86, 0x5e, 0x67, 0x8e, 0x4f, 0xfe, 0x7d, 0xf3, 0xe1, 0xd7, 0x15, 0x60, 0x9f, 0xd1, 0x88,
+	0x23, 0x49, 0x4e, 0x66, 0x5d, 0x7d, 0xa5, 0x9a, 0x3a, 0xe0, 0x2c, 0x63, 0x02, 0xc5, 0x7a, 0x17,
+	0xac, 0x4a, 0x2a, 0x63, 0xa2, 0xc2, 0x79, 0x0a, 0xe8, 0x36, 0x58, 0x0b, 0x89, 0xc0, 0x9c, 0x66,
+	0x65, 0x18, 0x63, 0xa5, 0xe2, 0x16, 0xb7, 0x96, 0x8b, 0xd3, 0xbc, 0x53, 0x71, 0x9e, 0x83, 0xff,
+	0x32, 0xc6, 0x65, 0x29, 0x6c, 0x55, 0x42, 0xbd, 0xc8, 0xad, 0x0d, 0x25, 0x9c, 0x12, 0xd0, 0x6b,
+	0x97, 0xab, 0x93, 0x50, 0x7f, 0x0b, 0xee, 0xa7, 0xe4, 0xc2, 0xaf, 0xdf, 0xb7, 0x5a, 0xc9, 0x9e,
+	0x14, 0xb9, 0x65, 0x28, 0xd9, 0xd2, 0x11, 0xe8, 0x6d, 0xa6, 0xe4, 0xe2, 0x78, 0xe1, 0xda, 0xc3,
+	0xd6, 0xe7, 0xef, 0x56, 0xa3, 0x1f, 0x5e, 0x8f, 0x4d, 0xed, 0x66, 0x6c, 0x6a, 0xbf, 0xc6, 0xa6,
+	0xf6, 0x65, 0x62, 0x36, 0x6e, 0x26, 0x66, 0xe3, 0xe7, 0xc4, 0x6c, 0x7c, 0x38, 0x8d, 0xa8, 0x3c,
+	0x1f, 0x06, 0x0e, 0x66, 0x89, 0x8b, 0x99, 0x48, 0x98, 0x70, 0x69, 0x80, 0x77, 0x22, 0xe6, 0x8e,
+	0xf6, 0xdd, 0x84, 0x85, 0xc3, 0x98, 0x88, 0x72, 0x28, 0x09, 0x77, 0xef, 0x60, 0x67, 0xfe, 0xab,
+	0xec, 0xd4, 0xe7, 0x91, 0xbc, 0xca, 0x88, 0x08, 0xda, 0xd5, 0x28, 0xd9, 0xff, 0x33, 0x00, 0x6d,
+	0x06, 0xed, 0x0e, 0xc9, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +306,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ConnectionOverrides) > 0 {
+		for iNdEx := len(m.ConnectionOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenyMessages) > 0 {
+		for iNdEx := len(m.DenyMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenyMessages[iNdEx])
+			copy(dAtA[i:], m.DenyMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.DenyMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -152,6 +365,112 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConnectionOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenyMessages) > 0 {
+		for iNdEx := len(m.DenyMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenyMessages[iNdEx])
+			copy(dAtA[i:], m.DenyMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.DenyMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateInterchainAccountProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateInterchainAccountProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateInterchainAccountProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewConnectionId) > 0 {
+		i -= len(m.NewConnectionId)
+		copy(dAtA[i:], m.NewConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.NewConnectionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHost(v)
 	base := offset
@@ -178,6 +497,81 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.DenyMessages) > 0 {
+		for _, s := range m.DenyMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.ConnectionOverrides) > 0 {
+		for _, e := range m.ConnectionOverrides {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovHost(uint64(m.MaxGasPerPacket))
+	}
+	return n
+}
+
+func (m *ConnectionOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.DenyMessages) > 0 {
+		for _, s := range m.DenyMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MigrateInterchainAccountProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.NewConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
 	return n
 }
 
@@ -268,6 +662,479 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyMessages = append(m.DenyMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionOverrides = append(m.ConnectionOverrides, ConnectionOverride{})
+			if err := m.ConnectionOverrides[len(m.ConnectionOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyMessages = append(m.DenyMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigrateInterchainAccountProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrateInterchainAccountProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrateInterchainAccountProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,12 +12,33 @@ const (
 
 	// StoreKey is the store key string for the interchain accounts host module
 	StoreKey = SubModuleName
+
+	// RouterKey is the governance proposal route for the interchain accounts host module
+	RouterKey = SubModuleName
 )
 
-// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
-func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
-	for _, v := range allowMsgs {
-		if v == sdk.MsgTypeURL(msg) {
+// ContainsMsgType returns true if the sdk.Msg TypeURL matches any of the message type patterns in msgTypes, otherwise false.
+// A pattern matches if it is equal to the TypeURL, if it is the wildcard "*", or if it ends with ".*" and the TypeURL
+// starts with the pattern without its trailing "*"
+func ContainsMsgType(msgTypes []string, msg sdk.Msg) bool {
+	return matchesPattern(msgTypes, sdk.MsgTypeURL(msg))
+}
+
+// ContainsQueryPath returns true if the gRPC query method path matches any of the patterns in queryPaths, otherwise false.
+// The same patterns as ContainsMsgType are supported, for example "/cosmos.bank.*" matches "/cosmos.bank.v1beta1.Query/Balance"
+func ContainsQueryPath(queryPaths []string, path string) bool {
+	return matchesPattern(queryPaths, path)
+}
+
+// matchesPattern returns true if the value is equal to any of the patterns, if any pattern is the wildcard "*", or
+// if a pattern ends with ".*" and the value starts with the pattern without its trailing "*"
+func matchesPattern(patterns []string, value string) bool {
+	for _, v := range patterns {
+		if v == value || v == AllowAllMessages {
+			return true
+		}
+
+		if strings.HasSuffix(v, packageWildcardSuffix) && strings.HasPrefix(value, strings.TrimSuffix(v, "*")) {
 			return true
 		}
 	}
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestContainsMsgType(t *testing.T) {
+	msg := &banktypes.MsgSend{}
+
+	testCases := []struct {
+		name     string
+		msgTypes []string
+		expMatch bool
+	}{
+		{"exact type url", []string{sdk.MsgTypeURL(msg)}, true},
+		{"wildcard", []string{types.AllowAllMessages}, true},
+		{"package prefix", []string{"/cosmos.bank.*"}, true},
+		{"versioned package prefix", []string{"/cosmos.bank.v1beta1.*"}, true},
+		{"empty list", nil, false},
+		{"other type url", []string{"/cosmos.bank.v1beta1.MsgMultiSend"}, false},
+		{"other package prefix", []string{"/cosmos.staking.*"}, false},
+		{"partial package name", []string{"/cosmos.ban.*"}, false},
+		{"type url prefix without wildcard", []string{"/cosmos.bank."}, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMatch, types.ContainsMsgType(tc.msgTypes, msg), tc.name)
+	}
+}
+
+func TestContainsQueryPath(t *testing.T) {
+	path := "/cosmos.bank.v1beta1.Query/Balance"
+
+	testCases := []struct {
+		name       string
+		queryPaths []string
+		expMatch   bool
+	}{
+		{"exact query path", []string{path}, true},
+		{"wildcard", []string{types.AllowAllMessages}, true},
+		{"package prefix", []string{"/cosmos.bank.*"}, true},
+		{"empty list", nil, false},
+		{"other query path", []string{"/cosmos.bank.v1beta1.Query/AllBalances"}, false},
+		{"other package prefix", []string{"/cosmos.staking.*"}, false},
+		{"message type url of the same package", []string{"/cosmos.bank.v1beta1.MsgSend"}, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMatch, types.ContainsQueryPath(tc.queryPaths, path), tc.name)
+	}
+}
+
+func TestIsAllowedQuery(t *testing.T) {
+	params := types.NewParams(true, []string{types.AllowAllMessages}, nil, nil, []string{"/cosmos.bank.*"}, types.DefaultMaxGasPerPacket)
+
+	require.True(t, params.IsAllowedQuery("/cosmos.bank.v1beta1.Query/Balance"))
+	require.False(t, params.IsAllowedQuery("/cosmos.staking.v1beta1.Query/Delegation"))
+
+	// allowed messages do not allow queries
+	params = types.NewParams(true, []string{types.AllowAllMessages}, nil, nil, nil, types.DefaultMaxGasPerPacket)
+	require.False(t, params.IsAllowedQuery("/cosmos.bank.v1beta1.Query/Balance"))
+}
+
+func TestIsAllowedMsg(t *testing.T) {
+	msg := &banktypes.MsgSend{}
+
+	testCases := []struct {
+		name         string
+		params       types.Params
+		connectionID string
+		expAllowed   bool
+	}{
+		{"allowed", types.NewParams(true, []string{"/cosmos.bank.*"}, nil, nil, nil, types.DefaultMaxGasPerPacket), "connection-0", true},
+		{"not allowed", types.NewParams(true, []string{"/cosmos.staking.*"}, nil, nil, nil, types.DefaultMaxGasPerPacket), "connection-0", false},
+		{"deny list takes precedence", types.NewParams(true, []string{types.AllowAllMessages}, []string{sdk.MsgTypeURL(msg)}, nil, nil, types.DefaultMaxGasPerPacket), "connection-0", false},
+		{
+			"connection override allows",
+			types.NewParams(true, nil, nil, []types.ConnectionOverride{types.NewConnectionOverride("connection-0", []string{types.AllowAllMessages}, nil)}, nil, types.DefaultMaxGasPerPacket),
+			"connection-0",
+			true,
+		},
+		{
+			"connection override replaces the deny list",
+			types.NewParams(true, nil, []string{types.AllowAllMessages}, []types.ConnectionOverride{types.NewConnectionOverride("connection-0", []string{types.AllowAllMessages}, nil)}, nil, types.DefaultMaxGasPerPacket),
+			"connection-0",
+			true,
+		},
+		{
+			"connection override denies",
+			types.NewParams(true, []string{types.AllowAllMessages}, nil, []types.ConnectionOverride{types.NewConnectionOverride("connection-0", []string{types.AllowAllMessages}, []string{"/cosmos.bank.*"})}, nil, types.DefaultMaxGasPerPacket),
+			"connection-0",
+			false,
+		},
+		{
+			"connection override of another connection",
+			types.NewParams(true, nil, nil, []types.ConnectionOverride{types.NewConnectionOverride("connection-1", []string{types.AllowAllMessages}, nil)}, nil, types.DefaultMaxGasPerPacket),
+			"connection-0",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expAllowed, tc.params.IsAllowedMsg(tc.connectionID, msg), tc.name)
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgMigrateInterchainAccount{}
+
+// NewMsgMigrateInterchainAccount creates a new instance of MsgMigrateInterchainAccount
+func NewMsgMigrateInterchainAccount(signer, connectionID, newConnectionID string) *MsgMigrateInterchainAccount {
+	return &MsgMigrateInterchainAccount{
+		Signer:          signer,
+		ConnectionId:    connectionID,
+		NewConnectionId: newConnectionID,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgMigrateInterchainAccount fields
+func (msg MsgMigrateInterchainAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse signer address %s: %s", msg.Signer, err)
+	}
+
+	return validateMigration(msg.ConnectionId, msg.NewConnectionId)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgMigrateInterchainAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// validateMigration validates the connection identifiers of an interchain account migration, which must differ
+func validateMigration(connectionID, newConnectionID string) error {
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if err := host.ConnectionIdentifierValidator(newConnectionID); err != nil {
+		return sdkerrors.Wrap(err, "invalid new connection ID")
+	}
+
+	if connectionID == newConnectionID {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidOutgoingData, "interchain account cannot be migrated to its current connection %s", connectionID)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// TestAccAddress defines a reusable bech32 address for testing purposes
+const TestAccAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+func TestMsgMigrateInterchainAccountValidateBasic(t *testing.T) {
+	var msg *types.MsgMigrateInterchainAccount
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"empty connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"empty new connection id",
+			func() {
+				msg.NewConnectionId = ""
+			},
+			false,
+		},
+		{
+			"new connection id equal to the connection id",
+			func() {
+				msg.NewConnectionId = msg.ConnectionId
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgMigrateInterchainAccount(TestAccAddress, ibctesting.FirstConnectionID, "connection-1")
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgMigrateInterchainAccountGetSigners(t *testing.T) {
+	msg := types.NewMsgMigrateInterchainAccount(TestAccAddress, ibctesting.FirstConnectionID, "connection-1")
+	require.Equal(t, TestAccAddress, msg.GetSigners()[0].String())
+}
@@ -4,12 +4,25 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+
+	// DefaultMaxGasPerPacket is the default maximum amount of gas consumed by the execution of a packet
+	DefaultMaxGasPerPacket = uint64(1000000)
+
+	// AllowAllMessages is the wildcard message type pattern matching all sdk message typeURLs
+	AllowAllMessages = "*"
+
+	// packageWildcardSuffix is the suffix of a message type pattern matching all sdk message typeURLs
+	// starting with the preceding prefix
+	packageWildcardSuffix = ".*"
 )
 
 var (
@@ -17,6 +30,14 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyDenyMessages is the store key for the DenyMessages Params
+	KeyDenyMessages = []byte("DenyMessages")
+	// KeyConnectionOverrides is the store key for the ConnectionOverrides Params
+	KeyConnectionOverrides = []byte("ConnectionOverrides")
+	// KeyAllowQueries is the store key for the AllowQueries Params
+	KeyAllowQueries = []byte("AllowQueries")
+	// KeyMaxGasPerPacket is the store key for the MaxGasPerPacket Params
+	KeyMaxGasPerPacket = []byte("MaxGasPerPacket")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -25,16 +46,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the host submodule
-func NewParams(enableHost bool, allowMsgs []string) Params {
+func NewParams(enableHost bool, allowMsgs, denyMsgs []string, connectionOverrides []ConnectionOverride, allowQueries []string, maxGasPerPacket uint64) Params {
 	return Params{
-		HostEnabled:   enableHost,
-		AllowMessages: allowMsgs,
+		HostEnabled:         enableHost,
+		AllowMessages:       allowMsgs,
+		DenyMessages:        denyMsgs,
+		ConnectionOverrides: connectionOverrides,
+		AllowQueries:        allowQueries,
+		MaxGasPerPacket:     maxGasPerPacket,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	return NewParams(DefaultHostEnabled, nil, nil, nil, nil, DefaultMaxGasPerPacket)
 }
 
 // Validate validates all host submodule parameters
@@ -47,6 +72,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateDenylist(p.DenyMessages); err != nil {
+		return err
+	}
+
+	if err := validateConnectionOverrides(p.ConnectionOverrides); err != nil {
+		return err
+	}
+
+	if err := validateQueryAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+
+	if err := validateMaxGasPerPacket(p.MaxGasPerPacket); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -55,9 +96,60 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyDenyMessages, p.DenyMessages, validateDenylist),
+		paramtypes.NewParamSetPair(KeyConnectionOverrides, p.ConnectionOverrides, validateConnectionOverrides),
+		paramtypes.NewParamSetPair(KeyAllowQueries, p.AllowQueries, validateQueryAllowlist),
+		paramtypes.NewParamSetPair(KeyMaxGasPerPacket, p.MaxGasPerPacket, validateMaxGasPerPacket),
 	}
 }
 
+// IsAllowedMsg returns true if the sdk.Msg may be executed by an interchain account registered on the provided connection.
+// The allow and deny lists of the connection override are used if the connection has one, otherwise the
+// allow and deny lists of the params are used. A message matching the deny list is never allowed.
+func (p Params) IsAllowedMsg(connectionID string, msg sdk.Msg) bool {
+	allowMsgs, denyMsgs := p.AllowMessages, p.DenyMessages
+	for _, override := range p.ConnectionOverrides {
+		if override.ConnectionId == connectionID {
+			allowMsgs, denyMsgs = override.AllowMessages, override.DenyMessages
+			break
+		}
+	}
+
+	if ContainsMsgType(denyMsgs, msg) {
+		return false
+	}
+
+	return ContainsMsgType(allowMsgs, msg)
+}
+
+// IsAllowedQuery returns true if the gRPC query method path may be executed on behalf of a controller chain.
+// The same patterns as the message allow list are supported.
+func (p Params) IsAllowedQuery(path string) bool {
+	return ContainsQueryPath(p.AllowQueries, path)
+}
+
+// NewConnectionOverride creates a new ConnectionOverride instance
+func NewConnectionOverride(connectionID string, allowMsgs, denyMsgs []string) ConnectionOverride {
+	return ConnectionOverride{
+		ConnectionId:  connectionID,
+		AllowMessages: allowMsgs,
+		DenyMessages:  denyMsgs,
+	}
+}
+
+// Validate performs basic validation of the ConnectionOverride
+func (co ConnectionOverride) Validate() error {
+	if err := host.ConnectionIdentifierValidator(co.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := validateMsgTypePatterns(co.AllowMessages); err != nil {
+		return err
+	}
+
+	return validateMsgTypePatterns(co.DenyMessages)
+}
+
 func validateEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -73,9 +165,76 @@ func validateAllowlist(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	for _, typeURL := range allowMsgs {
-		if strings.TrimSpace(typeURL) == "" {
-			return fmt.Errorf("parameter must not contain empty strings: %s", allowMsgs)
+	return validateMsgTypePatterns(allowMsgs)
+}
+
+func validateDenylist(i interface{}) error {
+	denyMsgs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateMsgTypePatterns(denyMsgs)
+}
+
+func validateQueryAllowlist(i interface{}) error {
+	allowQueries, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateMsgTypePatterns(allowQueries)
+}
+
+func validateMaxGasPerPacket(i interface{}) error {
+	maxGasPerPacket, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxGasPerPacket == 0 {
+		return fmt.Errorf("max gas per packet cannot be zero")
+	}
+
+	return nil
+}
+
+func validateConnectionOverrides(i interface{}) error {
+	overrides, ok := i.([]ConnectionOverride)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenConnections := make(map[string]bool)
+	for _, override := range overrides {
+		if seenConnections[override.ConnectionId] {
+			return fmt.Errorf("duplicate connection override for connection %s", override.ConnectionId)
+		}
+
+		if err := override.Validate(); err != nil {
+			return fmt.Errorf("invalid connection override for connection %s: %w", override.ConnectionId, err)
+		}
+
+		seenConnections[override.ConnectionId] = true
+	}
+
+	return nil
+}
+
+// validateMsgTypePatterns ensures each message type pattern is either a typeURL, the wildcard "*",
+// or a typeURL prefix followed by ".*". Query path patterns follow the same rules.
+func validateMsgTypePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			return fmt.Errorf("parameter must not contain empty strings: %s", patterns)
+		}
+
+		if pattern == AllowAllMessages {
+			continue
+		}
+
+		if strings.Contains(strings.TrimSuffix(pattern, packageWildcardSuffix), "*") {
+			return fmt.Errorf("invalid message type pattern %s: the wildcard may only be used alone or as a %s suffix", pattern, packageWildcardSuffix)
 		}
 	}
 
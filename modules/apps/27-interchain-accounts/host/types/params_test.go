@@ -9,6 +9,65 @@ import (
 )
 
 func TestValidateParams(t *testing.T) {
-	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"host disabled", types.NewParams(false, []string{}, nil, nil, nil, types.DefaultMaxGasPerPacket), true},
+		{"allow list with type url", types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil, nil, types.DefaultMaxGasPerPacket), true},
+		{"allow list with wildcard", types.NewParams(true, []string{types.AllowAllMessages}, nil, nil, nil, types.DefaultMaxGasPerPacket), true},
+		{"allow list with package prefix", types.NewParams(true, []string{"/cosmos.staking.*"}, nil, nil, nil, types.DefaultMaxGasPerPacket), true},
+		{"deny list with package prefix", types.NewParams(true, []string{types.AllowAllMessages}, []string{"/cosmos.gov.*"}, nil, nil, types.DefaultMaxGasPerPacket), true},
+		{
+			"connection overrides",
+			types.NewParams(true, nil, nil, []types.ConnectionOverride{
+				types.NewConnectionOverride("connection-0", []string{types.AllowAllMessages}, []string{"/cosmos.gov.*"}),
+				types.NewConnectionOverride("connection-1", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil),
+			}, nil, types.DefaultMaxGasPerPacket),
+			true,
+		},
+		{"empty allow list entry", types.NewParams(true, []string{" "}, nil, nil, nil, types.DefaultMaxGasPerPacket), false},
+		{"empty deny list entry", types.NewParams(true, nil, []string{""}, nil, nil, types.DefaultMaxGasPerPacket), false},
+		{"wildcard in the middle of a type url", types.NewParams(true, []string{"/cosmos.*.v1beta1.MsgSend"}, nil, nil, nil, types.DefaultMaxGasPerPacket), false},
+		{"wildcard without package separator", types.NewParams(true, []string{"/cosmos.bank*"}, nil, nil, nil, types.DefaultMaxGasPerPacket), false},
+		{"invalid deny list pattern", types.NewParams(true, nil, []string{"**"}, nil, nil, types.DefaultMaxGasPerPacket), false},
+		{"query allow list with query path", types.NewParams(true, nil, nil, nil, []string{"/cosmos.bank.v1beta1.Query/Balance"}, types.DefaultMaxGasPerPacket), true},
+		{"query allow list with package prefix", types.NewParams(true, nil, nil, nil, []string{"/cosmos.bank.*"}, types.DefaultMaxGasPerPacket), true},
+		{"empty query allow list entry", types.NewParams(true, nil, nil, nil, []string{""}, types.DefaultMaxGasPerPacket), false},
+		{"invalid query allow list pattern", types.NewParams(true, nil, nil, nil, []string{"/cosmos.*.Query/Balance"}, types.DefaultMaxGasPerPacket), false},
+		{"zero max gas per packet", types.NewParams(true, nil, nil, nil, nil, 0), false},
+		{
+			"invalid connection override connection id",
+			types.NewParams(true, nil, nil, []types.ConnectionOverride{
+				types.NewConnectionOverride("", []string{types.AllowAllMessages}, nil),
+			}, nil, types.DefaultMaxGasPerPacket),
+			false,
+		},
+		{
+			"invalid connection override pattern",
+			types.NewParams(true, nil, nil, []types.ConnectionOverride{
+				types.NewConnectionOverride("connection-0", []string{"/cosmos.*.MsgSend"}, nil),
+			}, nil, types.DefaultMaxGasPerPacket),
+			false,
+		},
+		{
+			"duplicate connection overrides",
+			types.NewParams(true, nil, nil, []types.ConnectionOverride{
+				types.NewConnectionOverride("connection-0", []string{types.AllowAllMessages}, nil),
+				types.NewConnectionOverride("connection-0", nil, nil),
+			}, nil, types.DefaultMaxGasPerPacket),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
 }
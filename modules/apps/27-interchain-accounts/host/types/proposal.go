@@ -0,0 +1,64 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeMigrateInterchainAccount defines the type for a MigrateInterchainAccountProposal
+	ProposalTypeMigrateInterchainAccount = "MigrateInterchainAccount"
+)
+
+var _ govtypes.Content = &MigrateInterchainAccountProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeMigrateInterchainAccount)
+}
+
+// NewMigrateInterchainAccountProposal creates a new interchain account migration proposal.
+func NewMigrateInterchainAccountProposal(title, description, connectionID, portID, newConnectionID string) govtypes.Content {
+	return &MigrateInterchainAccountProposal{
+		Title:           title,
+		Description:     description,
+		ConnectionId:    connectionID,
+		PortId:          portID,
+		NewConnectionId: newConnectionID,
+	}
+}
+
+// GetTitle returns the title of an interchain account migration proposal.
+func (miap *MigrateInterchainAccountProposal) GetTitle() string { return miap.Title }
+
+// GetDescription returns the description of an interchain account migration proposal.
+func (miap *MigrateInterchainAccountProposal) GetDescription() string { return miap.Description }
+
+// ProposalRoute returns the routing key of an interchain account migration proposal.
+func (miap *MigrateInterchainAccountProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an interchain account migration proposal.
+func (miap *MigrateInterchainAccountProposal) ProposalType() string {
+	return ProposalTypeMigrateInterchainAccount
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (miap *MigrateInterchainAccountProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(miap); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(miap.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+
+	if !strings.HasPrefix(miap.PortId, icatypes.PortPrefix) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", icatypes.PortPrefix, miap.PortId)
+	}
+
+	return validateMigration(miap.ConnectionId, miap.NewConnectionId)
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestMigrateInterchainAccountProposalValidateBasic(t *testing.T) {
+	portID, err := icatypes.NewControllerPortID(TestAccAddress)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		proposal *types.MigrateInterchainAccountProposal
+		expPass  bool
+	}{
+		{
+			"success",
+			types.NewMigrateInterchainAccountProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstConnectionID, portID, "connection-1").(*types.MigrateInterchainAccountProposal),
+			true,
+		},
+		{
+			"empty title",
+			types.NewMigrateInterchainAccountProposal("", ibctesting.Description, ibctesting.FirstConnectionID, portID, "connection-1").(*types.MigrateInterchainAccountProposal),
+			false,
+		},
+		{
+			"invalid port id",
+			types.NewMigrateInterchainAccountProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstConnectionID, "", "connection-1").(*types.MigrateInterchainAccountProposal),
+			false,
+		},
+		{
+			"port id is not a controller port",
+			types.NewMigrateInterchainAccountProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstConnectionID, icatypes.PortID, "connection-1").(*types.MigrateInterchainAccountProposal),
+			false,
+		},
+		{
+			"empty connection id",
+			types.NewMigrateInterchainAccountProposal(ibctesting.Title, ibctesting.Description, "", portID, "connection-1").(*types.MigrateInterchainAccountProposal),
+			false,
+		},
+		{
+			"new connection id equal to the connection id",
+			types.NewMigrateInterchainAccountProposal(ibctesting.Title, ibctesting.Description, ibctesting.FirstConnectionID, portID, ibctesting.FirstConnectionID).(*types.MigrateInterchainAccountProposal),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+
+		require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute())
+		require.Equal(t, types.ProposalTypeMigrateInterchainAccount, tc.proposal.ProposalType())
+	}
+}
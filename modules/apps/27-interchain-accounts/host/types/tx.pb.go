@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/host/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgMigrateInterchainAccount defines the payload for Msg/MigrateInterchainAccount. It is signed by the interchain
+// account itself, and is thus executed through an interchain accounts packet sent by the controller chain over
+// the currently active channel of the interchain account.
+type MsgMigrateInterchainAccount struct {
+	// the interchain account address, which signs the message
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the host connection on which the interchain account is currently registered
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// the host connection to which the interchain account is migrated
+	NewConnectionId string `protobuf:"bytes,3,opt,name=new_connection_id,json=newConnectionId,proto3" json:"new_connection_id,omitempty" yaml:"new_connection_id"`
+}
+
+func (m *MsgMigrateInterchainAccount) Reset()         { *m = MsgMigrateInterchainAccount{} }
+func (m *MsgMigrateInterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateInterchainAccount) ProtoMessage()    {}
+func (*MsgMigrateInterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{0}
+}
+func (m *MsgMigrateInterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateInterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateInterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateInterchainAccount.Merge(m, src)
+}
+func (m *MsgMigrateInterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateInterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateInterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateInterchainAccount proto.InternalMessageInfo
+
+// MsgMigrateInterchainAccountResponse defines the response for Msg/MigrateInterchainAccount
+type MsgMigrateInterchainAccountResponse struct {
+}
+
+func (m *MsgMigrateInterchainAccountResponse) Reset()         { *m = MsgMigrateInterchainAccountResponse{} }
+func (m *MsgMigrateInterchainAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateInterchainAccountResponse) ProtoMessage()    {}
+func (*MsgMigrateInterchainAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{1}
+}
+func (m *MsgMigrateInterchainAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateInterchainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateInterchainAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateInterchainAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateInterchainAccountResponse.Merge(m, src)
+}
+func (m *MsgMigrateInterchainAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateInterchainAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateInterchainAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateInterchainAccountResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgMigrateInterchainAccount)(nil), "ibc.applications.interchain_accounts.host.v1.MsgMigrateInterchainAccount")
+	proto.RegisterType((*MsgMigrateInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgMigrateInterchainAccountResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/tx.proto", fileDescriptor_fa437afde7f1e7ae)
+}
+
+var fileDescriptor_fa437afde7f1e7ae = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x4b, 0x32, 0x41,
+	0x18, 0xc7, 0x77, 0x5e, 0x41, 0xde, 0x86, 0x22, 0x5a, 0x24, 0x16, 0x8b, 0xdd, 0xd8, 0x08, 0x3a,
+	0xe4, 0x0c, 0x2a, 0x11, 0x08, 0x1d, 0xb2, 0x4b, 0x06, 0x1e, 0xda, 0x63, 0x17, 0xd9, 0x9d, 0x1d,
+	0xc6, 0x01, 0x77, 0x66, 0xd9, 0x19, 0x35, 0xbf, 0x41, 0xc7, 0x3e, 0x82, 0x1f, 0xa3, 0x63, 0x47,
+	0x8f, 0x1e, 0x3b, 0x49, 0xe8, 0xa5, 0xb3, 0x9f, 0x20, 0x56, 0x2b, 0x95, 0x4a, 0x08, 0xba, 0xcd,
+	0xc3, 0x33, 0xbf, 0xdf, 0x33, 0xcf, 0xf0, 0x87, 0xa7, 0x3c, 0x20, 0xd8, 0x8f, 0xe3, 0x16, 0x27,
+	0xbe, 0xe6, 0x52, 0x28, 0xcc, 0x85, 0xa6, 0x09, 0x69, 0xfa, 0x5c, 0x34, 0x7c, 0x42, 0x64, 0x5b,
+	0x68, 0x85, 0x9b, 0x52, 0x69, 0xdc, 0x29, 0x62, 0x7d, 0x87, 0xe2, 0x44, 0x6a, 0x69, 0x9e, 0xf0,
+	0x80, 0xa0, 0x65, 0x0c, 0x7d, 0x83, 0xa1, 0x14, 0x43, 0x9d, 0x62, 0x3e, 0xc7, 0x24, 0x93, 0x33,
+	0x10, 0xa7, 0xa7, 0xb9, 0xc3, 0x1d, 0x00, 0xb8, 0x57, 0x57, 0xac, 0xce, 0x59, 0xe2, 0x6b, 0x5a,
+	0xfb, 0xe4, 0x2f, 0xe6, 0xb8, 0xb9, 0x0b, 0xb3, 0x8a, 0x33, 0x41, 0x13, 0x0b, 0x1c, 0x80, 0xe3,
+	0x0d, 0xef, 0xbd, 0x32, 0xcf, 0xe1, 0x16, 0x91, 0x42, 0x50, 0x92, 0x0e, 0x6e, 0xf0, 0xd0, 0xfa,
+	0x97, 0xb6, 0xab, 0xd6, 0x74, 0xe4, 0xe4, 0x7a, 0x7e, 0xd4, 0xaa, 0xb8, 0x2b, 0x6d, 0xd7, 0xdb,
+	0x5c, 0xd4, 0xb5, 0xd0, 0xbc, 0x82, 0x3b, 0x82, 0x76, 0x1b, 0xab, 0x8a, 0xcc, 0x4c, 0xb1, 0x3f,
+	0x1d, 0x39, 0xd6, 0x5c, 0xf1, 0xe5, 0x8a, 0xeb, 0x6d, 0x0b, 0xda, 0xbd, 0x5c, 0x32, 0x55, 0xfe,
+	0xdf, 0xf7, 0x1d, 0xe3, 0xb5, 0xef, 0x18, 0xee, 0x11, 0x3c, 0x5c, 0xb3, 0x89, 0x47, 0x55, 0x2c,
+	0x85, 0xa2, 0xa5, 0x27, 0x00, 0x33, 0x75, 0xc5, 0xcc, 0x47, 0x00, 0xad, 0x1f, 0xd7, 0xae, 0xa1,
+	0xdf, 0xfc, 0x2d, 0x5a, 0x33, 0x37, 0x7f, 0xf3, 0x67, 0xaa, 0x8f, 0x15, 0xaa, 0xe1, 0x60, 0x6c,
+	0x83, 0xe1, 0xd8, 0x06, 0x2f, 0x63, 0x1b, 0x3c, 0x4c, 0x6c, 0x63, 0x38, 0xb1, 0x8d, 0xe7, 0x89,
+	0x6d, 0xdc, 0x5e, 0x33, 0xae, 0x9b, 0xed, 0x00, 0x11, 0x19, 0x61, 0x22, 0x55, 0x24, 0x15, 0xe6,
+	0x01, 0x29, 0x30, 0x89, 0x3b, 0x65, 0x1c, 0xc9, 0xb0, 0xdd, 0xa2, 0x2a, 0x4d, 0x9a, 0xc2, 0xa5,
+	0xb3, 0xc2, 0xe2, 0x19, 0x85, 0xd5, 0x90, 0xe9, 0x5e, 0x4c, 0x55, 0x90, 0x9d, 0x25, 0xa4, 0xfc,
+	0x36, 0x00, 0x66, 0xdd, 0x6e, 0x89, 0x9e, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// MigrateInterchainAccount defines a rpc handler for MsgMigrateInterchainAccount.
+	MigrateInterchainAccount(ctx context.Context, in *MsgMigrateInterchainAccount, opts ...grpc.CallOption) (*MsgMigrateInterchainAccountResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) MigrateInterchainAccount(ctx context.Context, in *MsgMigrateInterchainAccount, opts ...grpc.CallOption) (*MsgMigrateInterchainAccountResponse, error) {
+	out := new(MsgMigrateInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/MigrateInterchainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// MigrateInterchainAccount defines a rpc handler for MsgMigrateInterchainAccount.
+	MigrateInterchainAccount(context.Context, *MsgMigrateInterchainAccount) (*MsgMigrateInterchainAccountResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) MigrateInterchainAccount(ctx context.Context, req *MsgMigrateInterchainAccount) (*MsgMigrateInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateInterchainAccount not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_MigrateInterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateInterchainAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateInterchainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/MigrateInterchainAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateInterchainAccount(ctx, req.(*MsgMigrateInterchainAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.host.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MigrateInterchainAccount",
+			Handler:    _Msg_MigrateInterchainAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/host/v1/tx.proto",
+}
+
+func (m *MsgMigrateInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewConnectionId) > 0 {
+		i -= len(m.NewConnectionId)
+		copy(dAtA[i:], m.NewConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMigrateInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMigrateInterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateInterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
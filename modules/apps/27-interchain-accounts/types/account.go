@@ -6,9 +6,11 @@ import (
 	"strings"
 
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	yaml "gopkg.in/yaml.v2"
 )
@@ -67,6 +69,32 @@ func NewInterchainAccount(ba *authtypes.BaseAccount, accountOwner string) *Inter
 	}
 }
 
+// NewIdentifiedInterchainAccount creates and returns a new IdentifiedInterchainAccount type
+func NewIdentifiedInterchainAccount(connectionID, portID, accountAddress string) IdentifiedInterchainAccount {
+	return IdentifiedInterchainAccount{
+		ConnectionId:   connectionID,
+		PortId:         portID,
+		AccountAddress: accountAddress,
+	}
+}
+
+// PaginateInterchainAccounts returns the interchain accounts registered on the provided connection, paginated over the
+// connection index of the provided interchain accounts store. Only the interchain accounts of the connection are iterated.
+func PaginateInterchainAccounts(store sdk.KVStore, connectionID string, pagination *query.PageRequest) ([]IdentifiedInterchainAccount, *query.PageResponse, error) {
+	var interchainAccounts []IdentifiedInterchainAccount
+	connectionStore := prefix.NewStore(store, KeyConnectionOwnerPrefix(connectionID))
+	pageRes, err := query.Paginate(connectionStore, pagination, func(key, value []byte) error {
+		// keys are formatted as {portID}
+		interchainAccounts = append(interchainAccounts, NewIdentifiedInterchainAccount(connectionID, string(key), string(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return interchainAccounts, pageRes, nil
+}
+
 // SetPubKey implements the authtypes.AccountI interface
 func (ia InterchainAccount) SetPubKey(pubKey crypto.PubKey) error {
 	return sdkerrors.Wrap(ErrUnsupported, "cannot set public key for interchain account")
@@ -63,8 +63,71 @@ func (m *InterchainAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterchainAccount proto.InternalMessageInfo
 
+// IdentifiedInterchainAccount defines an interchain account address with its associated connection and controller
+// port identifiers
+type IdentifiedInterchainAccount struct {
+	ConnectionId   string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId         string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	AccountAddress string `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty" yaml:"account_address"`
+}
+
+func (m *IdentifiedInterchainAccount) Reset()         { *m = IdentifiedInterchainAccount{} }
+func (m *IdentifiedInterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedInterchainAccount) ProtoMessage()    {}
+func (*IdentifiedInterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5561bd92625bf7da, []int{1}
+}
+func (m *IdentifiedInterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedInterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedInterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedInterchainAccount.Merge(m, src)
+}
+func (m *IdentifiedInterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedInterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedInterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedInterchainAccount proto.InternalMessageInfo
+
+func (m *IdentifiedInterchainAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *IdentifiedInterchainAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IdentifiedInterchainAccount) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccount")
+	proto.RegisterType((*IdentifiedInterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.IdentifiedInterchainAccount")
 }
 
 func init() {
@@ -72,29 +135,35 @@ func init() {
 }
 
 var fileDescriptor_5561bd92625bf7da = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcd, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0x2f, 0x33, 0xd4, 0x87, 0xb2, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xd4, 0x33, 0x93,
-	0x92, 0xf5, 0x90, 0xb5, 0xe9, 0x61, 0xd1, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x99, 0x9c, 0x5f, 0x9c,
-	0x9b, 0x5f, 0x1c, 0x0f, 0xd6, 0xa6, 0x0f, 0xe1, 0x40, 0xcc, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x87, 0x88, 0x83, 0x58, 0x50, 0x51, 0x39, 0x88, 0x1a, 0xfd, 0xc4, 0xd2, 0x92, 0x0c, 0xfd, 0x32,
-	0xc3, 0xa4, 0xd4, 0x92, 0x44, 0x43, 0x30, 0x07, 0x22, 0xaf, 0x74, 0x85, 0x91, 0x4b, 0xd0, 0x13,
-	0x6e, 0x97, 0x23, 0xc4, 0x2a, 0xa1, 0x04, 0x2e, 0x9e, 0xa4, 0xc4, 0xe2, 0x54, 0x98, 0xd5, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x0a, 0x7a, 0x50, 0x0b, 0xc1, 0xfa, 0xa1, 0x86, 0xe9, 0x39,
-	0x25, 0x16, 0xa7, 0x42, 0xf5, 0x39, 0x49, 0x5f, 0xb8, 0x27, 0xcf, 0xf8, 0xe9, 0x9e, 0xbc, 0x70,
-	0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0xb2, 0x19, 0x4a, 0x41, 0xdc, 0x49, 0x08, 0x95, 0x42, 0xb6,
-	0x5c, 0xbc, 0x50, 0x89, 0xf8, 0xfc, 0xf2, 0xbc, 0xd4, 0x22, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x4e,
-	0x27, 0x89, 0x4f, 0xf7, 0xe4, 0x45, 0x20, 0x9a, 0x51, 0xa4, 0x95, 0x82, 0x78, 0xa0, 0x7c, 0x7f,
-	0x10, 0xd7, 0x4a, 0xae, 0x63, 0x81, 0x3c, 0xc3, 0x8c, 0x05, 0xf2, 0x0c, 0x97, 0xb6, 0xe8, 0x0a,
-	0x61, 0xb8, 0xdf, 0xd3, 0x29, 0xfe, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x5c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0xe1, 0xa7, 0x9f, 0x99,
-	0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f, 0x66, 0xac, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c,
-	0x8a, 0xc1, 0x62, 0x7d, 0x23, 0x73, 0x5d, 0x44, 0x2c, 0xe8, 0xc2, 0x23, 0xaf, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x7c, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x74, 0x07, 0x08,
-	0x0e, 0xf1, 0x01, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0xde, 0x51, 0xa8, 0xb8, 0x4d, 0x2b, 0xae, 0x45, 0xd6, 0x14, 0x66, 0xc3, 0x5c, 0x2c, 0x48,
+	0x76, 0x48, 0x8b, 0x08, 0x85, 0x1e, 0x1a, 0xf1, 0xb0, 0x27, 0x61, 0x8f, 0x5e, 0xd6, 0xf9, 0x32,
+	0x19, 0x48, 0x66, 0x96, 0x9d, 0x49, 0xa4, 0xff, 0xc0, 0xa3, 0x47, 0x8f, 0xfd, 0x11, 0xfe, 0x08,
+	0x4f, 0x12, 0xc4, 0x83, 0xa7, 0x45, 0x92, 0x7f, 0x90, 0x5f, 0x20, 0xbb, 0x33, 0x6d, 0x1a, 0xd3,
+	0xdb, 0xfb, 0xbc, 0xcf, 0xfb, 0xbc, 0xcf, 0xbc, 0xc3, 0x13, 0xbe, 0x96, 0x94, 0x61, 0x52, 0x96,
+	0x13, 0xc9, 0x88, 0x95, 0x5a, 0x19, 0x2c, 0x95, 0x15, 0x15, 0x1b, 0x13, 0xa9, 0x0a, 0xc2, 0x98,
+	0x9e, 0x29, 0x6b, 0xf0, 0x7c, 0x80, 0x7d, 0x9d, 0x96, 0x95, 0xb6, 0x3a, 0x7a, 0x29, 0x29, 0x4b,
+	0xef, 0xca, 0xd2, 0x7b, 0x64, 0xe9, 0x7c, 0xd0, 0x7d, 0xc1, 0xb4, 0x99, 0x6a, 0x53, 0xb4, 0x32,
+	0xec, 0x80, 0xdb, 0xd1, 0x3d, 0x1a, 0xe9, 0x91, 0x76, 0xfd, 0xa6, 0xf2, 0x5d, 0xe8, 0x66, 0x30,
+	0x99, 0xd9, 0x31, 0x9e, 0x0f, 0xa8, 0xb0, 0x64, 0xd0, 0x02, 0xc7, 0xa3, 0xdf, 0x20, 0x7c, 0x9a,
+	0xdd, 0x7a, 0x5d, 0x3a, 0xab, 0xe8, 0x63, 0xd8, 0xa1, 0xc4, 0x88, 0x1b, 0xeb, 0x18, 0xf4, 0xc0,
+	0xc9, 0xfe, 0x69, 0x2f, 0xf5, 0x86, 0xad, 0xde, 0x2f, 0x4b, 0x87, 0xc4, 0x08, 0xaf, 0x1b, 0x1e,
+	0x2f, 0xea, 0x04, 0xac, 0xeb, 0xe4, 0xd9, 0x15, 0x99, 0x4e, 0xce, 0xd1, 0xdd, 0x1d, 0x28, 0xdf,
+	0xa7, 0x9b, 0xc9, 0xe8, 0x22, 0x3c, 0xf0, 0x44, 0xa1, 0x3f, 0x2b, 0x51, 0xc5, 0x0f, 0x7a, 0xe0,
+	0xe4, 0xf1, 0x30, 0x5e, 0xd7, 0xc9, 0x91, 0x13, 0x6f, 0xd1, 0x28, 0xef, 0x78, 0xfc, 0xbe, 0x81,
+	0xe7, 0xf0, 0xcb, 0x75, 0x12, 0x7c, 0xbb, 0x4e, 0x82, 0x5f, 0xdf, 0xfb, 0xd1, 0xce, 0xfb, 0x33,
+	0xf4, 0x13, 0x84, 0xc7, 0x19, 0x17, 0xca, 0xca, 0x4f, 0x52, 0xf0, 0xdd, 0x03, 0x2f, 0xc2, 0x03,
+	0xa6, 0x95, 0x12, 0xac, 0xf9, 0xed, 0x42, 0xf2, 0x18, 0xfc, 0x6f, 0xbf, 0x45, 0xa3, 0xbc, 0xb3,
+	0xc1, 0x19, 0x8f, 0x5e, 0x85, 0x8f, 0x4a, 0x5d, 0xd9, 0x46, 0xe8, 0xde, 0x1d, 0xad, 0xeb, 0xe4,
+	0xd0, 0x09, 0x3d, 0x81, 0xf2, 0xbd, 0xa6, 0xca, 0x78, 0xf4, 0x36, 0x7c, 0x72, 0x73, 0x0b, 0xe1,
+	0xbc, 0x12, 0xc6, 0xc4, 0x0f, 0x5b, 0x51, 0x77, 0x5d, 0x27, 0xcf, 0xb7, 0x8f, 0xf5, 0x03, 0x28,
+	0x3f, 0xf4, 0x9d, 0x4b, 0xd7, 0x18, 0x16, 0x3f, 0x96, 0x10, 0x2c, 0x96, 0x10, 0xfc, 0x5d, 0x42,
+	0xf0, 0x75, 0x05, 0x83, 0xc5, 0x0a, 0x06, 0x7f, 0x56, 0x30, 0xf8, 0xf0, 0x6e, 0x24, 0xed, 0x78,
+	0x46, 0x53, 0xa6, 0xa7, 0x3e, 0x10, 0x58, 0x52, 0xd6, 0x1f, 0x69, 0x3c, 0x3f, 0xc3, 0x53, 0xcd,
+	0x67, 0x13, 0x61, 0x9a, 0x48, 0x1a, 0x7c, 0xfa, 0xa6, 0xbf, 0x89, 0x55, 0xff, 0x36, 0x8d, 0xf6,
+	0xaa, 0x14, 0x86, 0xee, 0xb5, 0x79, 0x38, 0xfb, 0x37, 0x00, 0x8a, 0xb5, 0xcc, 0x14, 0xc2, 0x02,
+	0x00, 0x00,
 }
 
 func (m *InterchainAccount) Marshal() (dAtA []byte, err error) {
@@ -139,6 +208,50 @@ func (m *InterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IdentifiedInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAccount(v)
 	base := offset
@@ -167,6 +280,27 @@ func (m *InterchainAccount) Size() (n int) {
 	return n
 }
 
+func (m *IdentifiedInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
 func sovAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -291,6 +425,152 @@ func (m *InterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IdentifiedInterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedInterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,86 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Acknowledgement = InterchainAccountAcknowledgement{}
+
+// NewMsgResult creates a new MsgResult instance for a message or query which was executed successfully
+func NewMsgResult(typeURL string, data []byte) MsgResult {
+	return MsgResult{
+		TypeUrl: typeURL,
+		Data:    data,
+	}
+}
+
+// NewFailedMsgResult creates a new MsgResult instance for a message or query which failed to execute. Only the
+// deterministic ABCI code and codespace of the error are included.
+func NewFailedMsgResult(typeURL string, err error) MsgResult {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false) // discard non-deterministic log value
+
+	return MsgResult{
+		TypeUrl:   typeURL,
+		Code:      code,
+		Codespace: codespace,
+	}
+}
+
+// NewResultAcknowledgement returns a structured acknowledgement for a packet which was executed successfully
+func NewResultAcknowledgement(results []MsgResult, height int64) InterchainAccountAcknowledgement {
+	return InterchainAccountAcknowledgement{
+		Version:     AckVersion1,
+		FailedIndex: -1,
+		Results:     results,
+		Height:      height,
+	}
+}
+
+// NewErrorAcknowledgement returns a structured acknowledgement for a packet which failed to execute. Only the
+// deterministic ABCI code and codespace of the error are included. If the last of the provided results has
+// failed, its index is set as the failed index.
+func NewErrorAcknowledgement(err error, results []MsgResult, height int64) InterchainAccountAcknowledgement {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false) // discard non-deterministic log value
+
+	failedIndex := int64(-1)
+	if len(results) > 0 && results[len(results)-1].Code != 0 {
+		failedIndex = int64(len(results) - 1)
+	}
+
+	return InterchainAccountAcknowledgement{
+		Version:     AckVersion1,
+		Code:        code,
+		Codespace:   codespace,
+		FailedIndex: failedIndex,
+		Results:     results,
+		Height:      height,
+	}
+}
+
+// Success implements the Acknowledgement interface. The acknowledgement is successful if its code is zero.
+func (ack InterchainAccountAcknowledgement) Success() bool {
+	return ack.Code == 0
+}
+
+// Acknowledgement implements the Acknowledgement interface. It returns the acknowledgement serialised as sorted JSON.
+func (ack InterchainAccountAcknowledgement) Acknowledgement() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ack))
+}
+
+// UnmarshalAcknowledgement decodes the bytes of a structured acknowledgement, returned by a host chain on a channel
+// using the ics27-ack-1 acknowledgement version, and validates its version.
+func UnmarshalAcknowledgement(bz []byte) (InterchainAccountAcknowledgement, error) {
+	var ack InterchainAccountAcknowledgement
+	if err := ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return InterchainAccountAcknowledgement{}, sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal interchain account acknowledgement: %s", err.Error())
+	}
+
+	if ack.Version != AckVersion1 {
+		return InterchainAccountAcknowledgement{}, sdkerrors.Wrapf(ErrInvalidVersion, "expected acknowledgement version %s, got %s", AckVersion1, ack.Version)
+	}
+
+	return ack, nil
+}
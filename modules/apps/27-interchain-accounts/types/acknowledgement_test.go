@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+func (suite *TypesTestSuite) TestResultAcknowledgement() {
+	results := []types.MsgResult{
+		types.NewMsgResult("/cosmos.bank.v1beta1.MsgSend", []byte("response")),
+	}
+
+	ack := types.NewResultAcknowledgement(results, 10)
+	suite.Require().True(ack.Success())
+	suite.Require().Equal(types.AckVersion1, ack.Version)
+	suite.Require().Equal(int64(-1), ack.FailedIndex)
+
+	decoded, err := types.UnmarshalAcknowledgement(ack.Acknowledgement())
+	suite.Require().NoError(err)
+	suite.Require().Equal(ack, decoded)
+}
+
+func (suite *TypesTestSuite) TestErrorAcknowledgement() {
+	testCases := []struct {
+		name           string
+		results        []types.MsgResult
+		expFailedIndex int64
+	}{
+		{
+			"failed message",
+			[]types.MsgResult{
+				types.NewMsgResult("/cosmos.bank.v1beta1.MsgSend", []byte("response")),
+				types.NewFailedMsgResult("/cosmos.bank.v1beta1.MsgSend", sdkerrors.ErrInsufficientFunds),
+			},
+			1,
+		},
+		{
+			"failure not caused by a message",
+			nil,
+			-1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ack := types.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "non-deterministic error string"), tc.results, 10)
+			suite.Require().False(ack.Success())
+			suite.Require().Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), ack.Code)
+			suite.Require().Equal(sdkerrors.ErrInsufficientFunds.Codespace(), ack.Codespace)
+			suite.Require().Equal(tc.expFailedIndex, ack.FailedIndex)
+
+			// the error string must not be included in the acknowledgement
+			ackSameABCICode := types.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "other error string"), tc.results, 10)
+			suite.Require().Equal(ack.Acknowledgement(), ackSameABCICode.Acknowledgement())
+
+			decoded, err := types.UnmarshalAcknowledgement(ack.Acknowledgement())
+			suite.Require().NoError(err)
+			suite.Require().Equal(ack.Acknowledgement(), decoded.Acknowledgement())
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestUnmarshalAcknowledgement() {
+	_, err := types.UnmarshalAcknowledgement([]byte(`{"result":"AQ=="}`))
+	suite.Require().Error(err)
+
+	_, err = types.UnmarshalAcknowledgement([]byte("invalid acknowledgement"))
+	suite.Require().Error(err)
+
+	ack := types.NewResultAcknowledgement(nil, 10)
+	ack.Version = "invalid-ack-version"
+	_, err = types.UnmarshalAcknowledgement(ack.Acknowledgement())
+	suite.Require().Error(err)
+}
@@ -25,11 +25,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
-// packed into Any's and inserted into the Messages field of a CosmosTx. The proto marshaled CosmosTx
-// bytes are returned. Only the ProtoCodec is supported for serializing messages.
-func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err error) {
+// packed into Any's and inserted into the Messages field of a CosmosTx. The CosmosTx is marshaled
+// using the provided encoding, either proto3 or proto3json, and the bytes are returned. Only the
+// ProtoCodec is supported for serializing messages.
+func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg, encoding string) (bz []byte, err error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
@@ -46,25 +48,22 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err er
 		Messages: msgAnys,
 	}
 
-	bz, err = cdc.Marshal(cosmosTx)
-	if err != nil {
-		return nil, err
-	}
-
-	return bz, nil
+	return marshalWithEncoding(protoCdc, cosmosTx, encoding)
 }
 
-// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes
-// into a slice of sdk.Msg's. Only the ProtoCodec is supported for message
-// deserialization.
-func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error) {
+// DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes encoded using the
+// provided encoding, either proto3 or proto3json, into a slice of sdk.Msg's. The Any's of the
+// CosmosTx are resolved using the interface registry of the codec. Only the ProtoCodec is
+// supported for message deserialization.
+func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte, encoding string) ([]sdk.Msg, error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
 	var cosmosTx CosmosTx
-	if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
+	if err := unmarshalWithEncoding(protoCdc, data, &cosmosTx, encoding); err != nil {
 		return nil, err
 	}
 
@@ -73,7 +72,7 @@ func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error)
 	for i, any := range cosmosTx.Messages {
 		var msg sdk.Msg
 
-		err := cdc.UnpackAny(any, &msg)
+		err := protoCdc.UnpackAny(any, &msg)
 		if err != nil {
 			return nil, err
 		}
@@ -83,3 +82,72 @@ func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error)
 
 	return msgs, nil
 }
+
+// SerializeCosmosQuery serializes a slice of gRPC query requests using the CosmosQuery type. The
+// CosmosQuery is marshaled using the provided encoding, either proto3 or proto3json, and the bytes
+// are returned. Only the ProtoCodec is supported for serializing queries.
+func SerializeCosmosQuery(cdc codec.BinaryCodec, requests []QueryRequest, encoding string) ([]byte, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving queries on the host chain")
+	}
+
+	cosmosQuery := &CosmosQuery{
+		Requests: requests,
+	}
+
+	return marshalWithEncoding(protoCdc, cosmosQuery, encoding)
+}
+
+// DeserializeCosmosQuery unmarshals a slice of query bytes encoded using the provided encoding,
+// either proto3 or proto3json, into a slice of gRPC query requests. Each request is validated.
+// Only the ProtoCodec is supported for query deserialization.
+func DeserializeCosmosQuery(cdc codec.BinaryCodec, data []byte, encoding string) ([]QueryRequest, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving queries on the host chain")
+	}
+
+	var cosmosQuery CosmosQuery
+	if err := unmarshalWithEncoding(protoCdc, data, &cosmosQuery, encoding); err != nil {
+		return nil, err
+	}
+
+	if len(cosmosQuery.Requests) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidOutgoingData, "cosmos query must contain at least one request")
+	}
+
+	for _, request := range cosmosQuery.Requests {
+		if err := request.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	return cosmosQuery.Requests, nil
+}
+
+// marshalWithEncoding marshals the provided message using the provided encoding, either proto3 or proto3json
+func marshalWithEncoding(protoCdc *codec.ProtoCodec, msg codec.ProtoMarshaler, encoding string) ([]byte, error) {
+	switch encoding {
+	case EncodingProtobuf:
+		return protoCdc.Marshal(msg)
+	case EncodingProto3JSON:
+		return protoCdc.MarshalJSON(msg)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
+
+// unmarshalWithEncoding unmarshals the provided bytes into the message using the provided encoding, either proto3 or proto3json
+func unmarshalWithEncoding(protoCdc *codec.ProtoCodec, bz []byte, msg codec.ProtoMarshaler, encoding string) error {
+	switch encoding {
+	case EncodingProtobuf:
+		return protoCdc.Unmarshal(bz, msg)
+	case EncodingProto3JSON:
+		return protoCdc.UnmarshalJSON(bz, msg)
+	default:
+		return sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
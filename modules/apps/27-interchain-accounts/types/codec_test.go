@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -109,31 +111,153 @@ func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTx() {
 		},
 	}
 
-	testCasesAny := []caseRawBytes{}
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		testCasesAny := []caseRawBytes{}
 
-	for _, tc := range testCases {
-		bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs)
-		suite.Require().NoError(err, tc.name)
+		for _, tc := range testCases {
+			bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs, encoding)
+			if !tc.expPass && encoding == types.EncodingProto3JSON {
+				// unregistered msg types cannot be resolved when marshaling to JSON
+				suite.Require().Error(err, tc.name)
+				continue
+			}
+			suite.Require().NoError(err, tc.name)
 
-		testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
-	}
+			testCasesAny = append(testCasesAny, caseRawBytes{tc.name, bz, tc.expPass})
+		}
 
-	for i, tc := range testCasesAny {
-		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz)
-		if tc.expPass {
-			suite.Require().NoError(err, tc.name)
-			suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
-		} else {
-			suite.Require().Error(err, tc.name)
+		for i, tc := range testCasesAny {
+			msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, tc.bz, encoding)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(testCases[i].msgs, msgs, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
 		}
+
+		// test deserializing unknown bytes
+		msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"), encoding)
+		suite.Require().Error(err)
+		suite.Require().Empty(msgs)
 	}
 
-	// test deserializing unknown bytes
-	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"))
+	// test unsupported encoding
+	bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, testCases[0].msgs, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(bz)
+
+	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, []byte{}, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(msgs)
+}
+
+// TestDeserializeProto3JSONCosmosTx ensures a proto3json CosmosTx produced without a protobuf library,
+// such as by a smart contract, is deserialized using the interface registry to resolve the Any's.
+func (suite *TypesTestSuite) TestDeserializeProto3JSONCosmosTx() {
+	data := []byte(`{
+		"messages": [
+			{
+				"@type": "/cosmos.bank.v1beta1.MsgSend",
+				"from_address": "` + TestOwnerAddress + `",
+				"to_address": "` + TestOwnerAddress + `",
+				"amount": [{"denom": "bananas", "amount": "100"}]
+			}
+		]
+	}`)
+
+	msgs, err := types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, data, types.EncodingProto3JSON)
+	suite.Require().NoError(err)
+
+	expMsg := &banktypes.MsgSend{
+		FromAddress: TestOwnerAddress,
+		ToAddress:   TestOwnerAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+	}
+	suite.Require().Equal([]sdk.Msg{expMsg}, msgs)
+
+	// unknown message types cannot be resolved
+	data = []byte(`{"messages": [{"@type": "/unknown.v1.MsgUnknown"}]}`)
+	msgs, err = types.DeserializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, data, types.EncodingProto3JSON)
 	suite.Require().Error(err)
 	suite.Require().Empty(msgs)
 }
 
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	balanceRequest, err := (&banktypes.QueryBalanceRequest{Address: TestOwnerAddress, Denom: "bananas"}).Marshal()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		requests []types.QueryRequest
+		expPass  bool
+	}{
+		{
+			"single request",
+			[]types.QueryRequest{types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", balanceRequest)},
+			true,
+		},
+		{
+			"multiple requests",
+			[]types.QueryRequest{
+				types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", balanceRequest),
+				types.NewQueryRequest("/cosmos.bank.v1beta1.Query/TotalSupply", nil),
+			},
+			true,
+		},
+		{
+			"no requests",
+			[]types.QueryRequest{},
+			false,
+		},
+		{
+			"invalid query path",
+			[]types.QueryRequest{types.NewQueryRequest("cosmos.bank.v1beta1.Query.Balance", balanceRequest)},
+			false,
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(fmt.Sprintf("%s: %s", encoding, tc.name), func() {
+				bz, err := types.SerializeCosmosQuery(simapp.MakeTestEncodingConfig().Marshaler, tc.requests, encoding)
+				suite.Require().NoError(err)
+
+				requests, err := types.DeserializeCosmosQuery(simapp.MakeTestEncodingConfig().Marshaler, bz, encoding)
+				if tc.expPass {
+					suite.Require().NoError(err)
+					suite.Require().Len(requests, len(tc.requests))
+
+					for i, request := range requests {
+						suite.Require().Equal(tc.requests[i].Path, request.Path)
+						suite.Require().Equal(tc.requests[i].Data, request.Data)
+					}
+				} else {
+					suite.Require().Error(err)
+					suite.Require().Empty(requests)
+				}
+			})
+		}
+	}
+
+	// unsupported encodings are rejected
+	bz, err := types.SerializeCosmosQuery(simapp.MakeTestEncodingConfig().Marshaler, []types.QueryRequest{types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", balanceRequest)}, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(bz)
+
+	requests, err := types.DeserializeCosmosQuery(simapp.MakeTestEncodingConfig().Marshaler, balanceRequest, "invalid-encoding")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(requests)
+
+	// only the ProtoCodec is supported
+	marshaler := codec.NewAminoCodec(codec.NewLegacyAmino())
+	bz, err = types.SerializeCosmosQuery(marshaler, []types.QueryRequest{types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", balanceRequest)}, types.EncodingProtobuf)
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(bz)
+}
+
 // unregistered bytes causes amino to panic.
 // test that DeserializeCosmosTx gracefully returns an error on
 // unsupported amino codec.
@@ -141,11 +265,11 @@ func (suite *TypesTestSuite) TestDeserializeAndSerializeCosmosTxWithAmino() {
 	cdc := codec.NewLegacyAmino()
 	marshaler := codec.NewAminoCodec(cdc)
 
-	msgs, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}})
+	msgs, err := types.SerializeCosmosTx(marshaler, []sdk.Msg{&banktypes.MsgSend{}}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(msgs)
 
-	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0})
+	bz, err := types.DeserializeCosmosTx(marshaler, []byte{0x10, 0}, types.EncodingProtobuf)
 	suite.Require().Error(err)
 	suite.Require().Empty(bz)
 
@@ -2,8 +2,19 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket                   = "ics27_packet"
+	EventTypeMsgResult                = "ics27_msg_result"
+	EventTypeMigrateInterchainAccount = "ics27_migrate_interchain_account"
 
-	AttributeKeyAckError      = "error"
-	AttributeKeyHostChannelID = "host_channel_id"
+	AttributeKeyAckError        = "error"
+	AttributeKeyHostChannelID   = "host_channel_id"
+	AttributeKeySequence        = "sequence"
+	AttributeKeyMsgIndex        = "msg_index"
+	AttributeKeyTypeURL         = "type_url"
+	AttributeKeyCode            = "code"
+	AttributeKeyCodespace       = "codespace"
+	AttributeKeyConnectionID    = "connection_id"
+	AttributeKeyNewConnectionID = "new_connection_id"
+	AttributeKeyPortID          = "port_id"
+	AttributeKeyAddress         = "address"
 )
@@ -34,8 +34,15 @@ var (
 	// OwnerKeyPrefix defines the key prefix used to store interchain accounts
 	OwnerKeyPrefix = "owner"
 
+	// ConnectionOwnerKeyPrefix defines the key prefix used to index interchain accounts by connection
+	ConnectionOwnerKeyPrefix = "connectionOwner"
+
 	// PortKeyPrefix defines the key prefix used to store ports
 	PortKeyPrefix = "port"
+
+	// IsMiddlewareDisabledPrefix defines the key prefix used to store the flag indicating that the
+	// underlying application callbacks are disabled for a channel registered through the controller Msg service
+	IsMiddlewareDisabledPrefix = "isMiddlewareDisabled"
 )
 
 // KeyActiveChannel creates and returns a new key used for active channels store operations
@@ -48,7 +55,22 @@ func KeyOwnerAccount(portID, connectionID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", OwnerKeyPrefix, portID, connectionID))
 }
 
+// KeyConnectionOwnerAccount creates and returns a new key used to index interchain accounts by connection
+func KeyConnectionOwnerAccount(connectionID, portID string) []byte {
+	return append(KeyConnectionOwnerPrefix(connectionID), []byte(portID)...)
+}
+
+// KeyConnectionOwnerPrefix creates and returns the key prefix of the interchain accounts registered on a connection
+func KeyConnectionOwnerPrefix(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", ConnectionOwnerKeyPrefix, connectionID))
+}
+
 // KeyPort creates and returns a new key used for port store operations
 func KeyPort(portID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", PortKeyPrefix, portID))
 }
+
+// KeyIsMiddlewareDisabled creates and returns a new key used for signaling if the underlying application callbacks are disabled
+func KeyIsMiddlewareDisabled(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", IsMiddlewareDisabledPrefix, portID, connectionID))
+}
@@ -13,3 +13,8 @@ func (suite *TypesTestSuite) TestKeyOwnerAccount() {
 	key := types.KeyOwnerAccount("port-id", "connection-id")
 	suite.Require().Equal("owner/port-id/connection-id", string(key))
 }
+
+func (suite *TypesTestSuite) TestKeyConnectionOwnerAccount() {
+	key := types.KeyConnectionOwnerAccount("connection-id", "port-id")
+	suite.Require().Equal("connectionOwner/connection-id/port-id", string(key))
+}
@@ -5,14 +5,22 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 const (
 	// EncodingProtobuf defines the protocol buffers proto3 encoding format
 	EncodingProtobuf = "proto3"
 
+	// EncodingProto3JSON defines the proto3 JSON encoding format
+	EncodingProto3JSON = "proto3json"
+
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
+
+	// AckVersion1 defines the structured acknowledgement version, with which the host chain returns an
+	// InterchainAccountAcknowledgement containing the result of each message or query of a packet
+	AckVersion1 = "ics27-ack-1"
 )
 
 // NewMetadata creates and returns a new ICS27 Metadata instance
@@ -39,7 +47,15 @@ func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
 		previousMetadata.ControllerConnectionId == metadata.ControllerConnectionId &&
 		previousMetadata.HostConnectionId == metadata.HostConnectionId &&
 		previousMetadata.Encoding == metadata.Encoding &&
-		previousMetadata.TxType == metadata.TxType)
+		previousMetadata.TxType == metadata.TxType &&
+		previousMetadata.AckVersion == metadata.AckVersion)
+}
+
+// IsPreviousOrderingCompatible returns true if a channel with the provided ordering may replace a previously active
+// channel with the previous ordering. The ordering may not change, except for an ORDERED channel being reopened as
+// an UNORDERED channel.
+func IsPreviousOrderingCompatible(previousOrder, order channeltypes.Order) bool {
+	return previousOrder == order || (previousOrder == channeltypes.ORDERED && order == channeltypes.UNORDERED)
 }
 
 // ValidateControllerMetadata performs validation of the provided ICS27 controller metadata parameters
@@ -52,6 +68,10 @@ func ValidateControllerMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, co
 		return sdkerrors.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if !isSupportedAckVersion(metadata.AckVersion) {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "unsupported acknowledgement version %s", metadata.AckVersion)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -84,6 +104,10 @@ func ValidateHostMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, connecti
 		return sdkerrors.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if !isSupportedAckVersion(metadata.AckVersion) {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "unsupported acknowledgement version %s", metadata.AckVersion)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -119,7 +143,7 @@ func isSupportedEncoding(encoding string) bool {
 
 // getSupportedEncoding returns a string slice of supported encoding formats
 func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf}
+	return []string{EncodingProtobuf, EncodingProto3JSON}
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
@@ -138,6 +162,12 @@ func getSupportedTxTypes() []string {
 	return []string{TxTypeSDKMultiMsg}
 }
 
+// isSupportedAckVersion returns true if the provided acknowledgement version is supported, otherwise false.
+// The empty acknowledgement version is supported for the acknowledgements containing only the packet response.
+func isSupportedAckVersion(ackVersion string) bool {
+	return ackVersion == "" || ackVersion == AckVersion1
+}
+
 // validateConnectionParams compares the given the controller and host connection IDs to those set in the provided ICS27 Metadata
 func validateConnectionParams(metadata Metadata, controllerConnectionID, hostConnectionID string) error {
 	if metadata.ControllerConnectionId != controllerConnectionID {
@@ -39,6 +39,9 @@ type Metadata struct {
 	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
 	// tx_type defines the type of transactions the interchain account can execute
 	TxType string `protobuf:"bytes,6,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// ack_version defines the version of the acknowledgements returned by the host chain. If empty, acknowledgements
+	// only contain the response of the transaction or queries, or an error string if their execution failed
+	AckVersion string `protobuf:"bytes,7,opt,name=ack_version,json=ackVersion,proto3" json:"ack_version,omitempty" yaml:"ack_version"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -116,6 +119,13 @@ func (m *Metadata) GetTxType() string {
 	return ""
 }
 
+func (m *Metadata) GetAckVersion() string {
+	if m != nil {
+		return m.AckVersion
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "ibc.applications.interchain_accounts.v1.Metadata")
 }
@@ -125,29 +135,31 @@ func init() {
 }
 
 var fileDescriptor_c29c32e397d1f21e = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x18, 0xc5, 0x9b, 0xde, 0x6b, 0x5b, 0x67, 0x25, 0x83, 0xe8, 0x58, 0x30, 0x95, 0xb8, 0xd0, 0x4d,
-	0x33, 0xd4, 0x82, 0x82, 0xcb, 0x8a, 0x0b, 0x11, 0x37, 0xc5, 0x95, 0x20, 0x61, 0x32, 0x33, 0xa4,
-	0x03, 0xc9, 0x7c, 0x21, 0x33, 0x0d, 0xed, 0x5b, 0xf8, 0x06, 0xbe, 0x8e, 0xcb, 0x2e, 0x5d, 0x15,
-	0x69, 0xdf, 0xa0, 0x4f, 0x20, 0x49, 0x6b, 0xeb, 0xdf, 0x5d, 0x4e, 0xce, 0x39, 0xbf, 0x8f, 0xe1,
-	0xa0, 0x73, 0x15, 0x72, 0xca, 0xd2, 0x34, 0x56, 0x9c, 0x59, 0x05, 0xda, 0x50, 0xa5, 0xad, 0xcc,
-	0xf8, 0x80, 0x29, 0x1d, 0x30, 0xce, 0x61, 0xa8, 0xad, 0xa1, 0x79, 0x87, 0x26, 0xd2, 0x32, 0xc1,
-	0x2c, 0xf3, 0xd3, 0x0c, 0x2c, 0xe0, 0x13, 0x15, 0x72, 0xff, 0x73, 0xcf, 0xff, 0xa5, 0xe7, 0xe7,
-	0x9d, 0xe6, 0x6e, 0x04, 0x11, 0x94, 0x1d, 0x5a, 0x7c, 0x2d, 0xeb, 0xde, 0x73, 0x15, 0x35, 0xee,
-	0x56, 0x44, 0x4c, 0x50, 0x3d, 0x97, 0x99, 0x51, 0xa0, 0x89, 0x73, 0xe4, 0x9c, 0x6e, 0xf7, 0x3f,
-	0x24, 0x7e, 0x44, 0x84, 0x83, 0xb6, 0x19, 0xc4, 0xb1, 0xcc, 0x02, 0x0e, 0x5a, 0x4b, 0x5e, 0x5c,
-	0x0b, 0x94, 0x20, 0xd5, 0x22, 0xda, 0x3b, 0x5e, 0x4c, 0x5b, 0xad, 0x31, 0x4b, 0xe2, 0x4b, 0xef,
-	0xaf, 0xa4, 0xd7, 0xdf, 0xdb, 0x58, 0x57, 0x6b, 0xe7, 0x46, 0xe0, 0x5b, 0x84, 0x07, 0x60, 0xec,
-	0x37, 0xf0, 0xbf, 0x12, 0x7c, 0xb8, 0x98, 0xb6, 0x0e, 0x96, 0xe0, 0x9f, 0x19, 0xaf, 0xbf, 0x53,
-	0xfc, 0xfc, 0x02, 0x23, 0xa8, 0xce, 0x84, 0xc8, 0xa4, 0x31, 0xe4, 0xff, 0xf2, 0x15, 0x2b, 0x89,
-	0x9b, 0xa8, 0x21, 0x35, 0x07, 0xa1, 0x74, 0x44, 0xb6, 0x4a, 0x6b, 0xad, 0xf1, 0x3e, 0xaa, 0xdb,
-	0x51, 0x60, 0xc7, 0xa9, 0x24, 0xb5, 0xd2, 0xaa, 0xd9, 0xd1, 0xfd, 0x38, 0x95, 0xbd, 0xe0, 0x65,
-	0xe6, 0x3a, 0x93, 0x99, 0xeb, 0xbc, 0xcd, 0x5c, 0xe7, 0x69, 0xee, 0x56, 0x26, 0x73, 0xb7, 0xf2,
-	0x3a, 0x77, 0x2b, 0x0f, 0xd7, 0x91, 0xb2, 0x83, 0x61, 0xe8, 0x73, 0x48, 0x28, 0x07, 0x93, 0x80,
-	0xa1, 0x2a, 0xe4, 0xed, 0x08, 0x68, 0xde, 0xa5, 0x09, 0x88, 0x61, 0x2c, 0x4d, 0x31, 0xa9, 0xa1,
-	0x67, 0x17, 0xed, 0xcd, 0x2a, 0xed, 0xf5, 0x9a, 0xc5, 0x35, 0x13, 0xd6, 0xca, 0x25, 0xba, 0xef,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x74, 0x28, 0x89, 0x02, 0x02, 0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcb, 0xaa, 0xd3, 0x40,
+	0x18, 0x6e, 0xaa, 0x36, 0x75, 0xdc, 0xc8, 0x20, 0x75, 0x2c, 0x98, 0x48, 0x5c, 0xe8, 0xa6, 0x19,
+	0x6a, 0xc1, 0x82, 0xcb, 0x8a, 0x0b, 0x11, 0x37, 0x41, 0x5c, 0x08, 0x12, 0x26, 0x93, 0x21, 0x1d,
+	0x9a, 0xcc, 0x1f, 0x32, 0xd3, 0xd0, 0xbe, 0xc5, 0x79, 0xac, 0xb3, 0xec, 0xb2, 0xab, 0x72, 0x68,
+	0xdf, 0xa0, 0x4f, 0x70, 0x48, 0xd2, 0xdb, 0xb9, 0xed, 0xf2, 0xe5, 0xbb, 0xcc, 0x37, 0xf3, 0xff,
+	0xe8, 0xab, 0x8c, 0x38, 0x65, 0x79, 0x9e, 0x4a, 0xce, 0x8c, 0x04, 0xa5, 0xa9, 0x54, 0x46, 0x14,
+	0x7c, 0xca, 0xa4, 0x0a, 0x19, 0xe7, 0x30, 0x57, 0x46, 0xd3, 0x72, 0x48, 0x33, 0x61, 0x58, 0xcc,
+	0x0c, 0xf3, 0xf3, 0x02, 0x0c, 0xe0, 0x4f, 0x32, 0xe2, 0xfe, 0xa5, 0xcf, 0x7f, 0xc4, 0xe7, 0x97,
+	0xc3, 0xfe, 0x9b, 0x04, 0x12, 0xa8, 0x3d, 0xb4, 0xfa, 0x6a, 0xec, 0xde, 0xba, 0x8d, 0xba, 0xbf,
+	0x0f, 0x89, 0x98, 0x20, 0xbb, 0x14, 0x85, 0x96, 0xa0, 0x88, 0xf5, 0xc1, 0xfa, 0xfc, 0x32, 0x38,
+	0x42, 0xfc, 0x1f, 0x11, 0x0e, 0xca, 0x14, 0x90, 0xa6, 0xa2, 0x08, 0x39, 0x28, 0x25, 0x78, 0x75,
+	0x5a, 0x28, 0x63, 0xd2, 0xae, 0xa4, 0x93, 0x8f, 0xfb, 0x8d, 0xeb, 0x2e, 0x59, 0x96, 0x7e, 0xf3,
+	0x9e, 0x52, 0x7a, 0x41, 0xef, 0x4c, 0x7d, 0x3f, 0x31, 0x3f, 0x63, 0xfc, 0x0b, 0xe1, 0x29, 0x68,
+	0x73, 0x2f, 0xf8, 0x59, 0x1d, 0xfc, 0x7e, 0xbf, 0x71, 0xdf, 0x35, 0xc1, 0x0f, 0x35, 0x5e, 0xf0,
+	0xba, 0xfa, 0x79, 0x27, 0x8c, 0x20, 0x9b, 0xc5, 0x71, 0x21, 0xb4, 0x26, 0xcf, 0x9b, 0x5b, 0x1c,
+	0x20, 0xee, 0xa3, 0xae, 0x50, 0x1c, 0x62, 0xa9, 0x12, 0xf2, 0xa2, 0xa6, 0x4e, 0x18, 0xbf, 0x45,
+	0xb6, 0x59, 0x84, 0x66, 0x99, 0x0b, 0xd2, 0xa9, 0xa9, 0x8e, 0x59, 0xfc, 0x59, 0xe6, 0x02, 0x8f,
+	0xd1, 0x2b, 0xc6, 0x67, 0xe1, 0xf1, 0x61, 0xec, 0xba, 0x54, 0x6f, 0xbf, 0x71, 0x71, 0x53, 0xea,
+	0x82, 0xf4, 0x02, 0xc4, 0xf8, 0xec, 0x6f, 0x03, 0x26, 0xe1, 0xf5, 0xd6, 0xb1, 0x56, 0x5b, 0xc7,
+	0xba, 0xd9, 0x3a, 0xd6, 0xd5, 0xce, 0x69, 0xad, 0x76, 0x4e, 0x6b, 0xbd, 0x73, 0x5a, 0xff, 0x7e,
+	0x24, 0xd2, 0x4c, 0xe7, 0x91, 0xcf, 0x21, 0xa3, 0x1c, 0x74, 0x06, 0x9a, 0xca, 0x88, 0x0f, 0x12,
+	0xa0, 0xe5, 0x88, 0x66, 0x10, 0xcf, 0x53, 0xa1, 0xab, 0x5d, 0xd0, 0xf4, 0xcb, 0x78, 0x70, 0x1e,
+	0xe7, 0xe0, 0xb4, 0x06, 0x55, 0x4d, 0x1d, 0x75, 0xea, 0x11, 0x8e, 0x6e, 0x07, 0x00, 0xf3, 0x22,
+	0x17, 0xfc, 0x3b, 0x02, 0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -170,6 +182,13 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AckVersion) > 0 {
+		i -= len(m.AckVersion)
+		copy(dAtA[i:], m.AckVersion)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.AckVersion)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.TxType) > 0 {
 		i -= len(m.TxType)
 		copy(dAtA[i:], m.TxType)
@@ -256,6 +275,10 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	l = len(m.AckVersion)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -486,6 +509,38 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.TxType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
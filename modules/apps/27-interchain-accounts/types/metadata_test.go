@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -67,6 +68,17 @@ func (suite *TypesTestSuite) TestIsPreviousMetadataEqual() {
 			},
 			false,
 		},
+		{
+			"unequal acknowledgement version",
+			func() {
+				metadata.AckVersion = types.AckVersion1
+
+				versionBytes, err := types.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+				previousVersion = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"unequal controller connection",
 			func() {
@@ -126,6 +138,25 @@ func (suite *TypesTestSuite) TestIsPreviousMetadataEqual() {
 	}
 }
 
+func (suite *TypesTestSuite) TestIsPreviousOrderingCompatible() {
+	testCases := []struct {
+		name          string
+		previousOrder channeltypes.Order
+		order         channeltypes.Order
+		expCompatible bool
+	}{
+		{"ORDERED reopened as ORDERED", channeltypes.ORDERED, channeltypes.ORDERED, true},
+		{"UNORDERED reopened as UNORDERED", channeltypes.UNORDERED, channeltypes.UNORDERED, true},
+		{"ORDERED reopened as UNORDERED", channeltypes.ORDERED, channeltypes.UNORDERED, true},
+		{"UNORDERED reopened as ORDERED", channeltypes.UNORDERED, channeltypes.ORDERED, false},
+		{"ORDERED reopened as NONE", channeltypes.ORDERED, channeltypes.NONE, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expCompatible, types.IsPreviousOrderingCompatible(tc.previousOrder, tc.order), tc.name)
+	}
+}
+
 func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 
 	var metadata types.Metadata
@@ -154,6 +185,50 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			true,
 		},
+		{
+			"success with proto3json encoding format",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProto3JSON,
+					TxType:                 types.TxTypeSDKMultiMsg,
+				}
+			},
+			true,
+		},
+		{
+			"success with structured acknowledgement version",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckVersion:             types.AckVersion1,
+				}
+			},
+			true,
+		},
+		{
+			"unsupported acknowledgement version",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckVersion:             "invalid-ack-version",
+				}
+			},
+			false,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -296,6 +371,50 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			true,
 		},
+		{
+			"success with proto3json encoding format",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProto3JSON,
+					TxType:                 types.TxTypeSDKMultiMsg,
+				}
+			},
+			true,
+		},
+		{
+			"success with structured acknowledgement version",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckVersion:             types.AckVersion1,
+				}
+			},
+			true,
+		},
+		{
+			"unsupported acknowledgement version",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckVersion:             "invalid-ack-version",
+				}
+			},
+			false,
+		},
 		{
 			"unsupported encoding format",
 			func() {
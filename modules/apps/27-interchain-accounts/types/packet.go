@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -32,6 +34,16 @@ func (iapd InterchainAccountPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
 }
 
+// GetPacketSender returns the owner of the interchain account, which is the sender of the packet sent
+// on the given controller port. An empty string is returned if the port ID is not a controller port ID.
+func (iapd InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
+	if !strings.HasPrefix(sourcePortID, PortPrefix) {
+		return ""
+	}
+
+	return strings.TrimPrefix(sourcePortID, PortPrefix)
+}
+
 // GetBytes returns the JSON marshalled interchain account CosmosTx.
 func (ct CosmosTx) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ct))
@@ -48,3 +60,22 @@ func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 	return nil
 }
+
+// NewQueryRequest creates a new QueryRequest instance for the provided gRPC method path and proto3 encoded request
+func NewQueryRequest(path string, data []byte) QueryRequest {
+	return QueryRequest{
+		Path: path,
+		Data: data,
+	}
+}
+
+// ValidateBasic performs basic validation of the QueryRequest. The gRPC method path must be of
+// the form "/{service}/{method}".
+func (qr QueryRequest) ValidateBasic() error {
+	parts := strings.Split(qr.Path, "/")
+	if len(parts) != 3 || parts[0] != "" || strings.TrimSpace(parts[1]) == "" || strings.TrimSpace(parts[2]) == "" {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "invalid query path %s, expected /{service}/{method}", qr.Path)
+	}
+
+	return nil
+}
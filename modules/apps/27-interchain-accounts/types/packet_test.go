@@ -33,11 +33,19 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			"type unspecified",
 			types.InterchainAccountPacketData{
 				Type: types.UNSPECIFIED,
-				Data: []byte("data"), 
+				Data: []byte("data"),
 				Memo: "memo",
 			},
 			false,
 		},
+		{
+			"success, execute query",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_QUERY,
+				Data: []byte("data"),
+			},
+			true,
+		},
 		{
 			"empty data",
 			types.InterchainAccountPacketData{
@@ -73,3 +81,32 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestQueryRequestValidateBasic() {
+	testCases := []struct {
+		name    string
+		request types.QueryRequest
+		expPass bool
+	}{
+		{"success", types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", []byte("data")), true},
+		{"success, empty data", types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", nil), true},
+		{"empty path", types.NewQueryRequest("", []byte("data")), false},
+		{"missing leading slash", types.NewQueryRequest("cosmos.bank.v1beta1.Query/Balance", []byte("data")), false},
+		{"missing method", types.NewQueryRequest("/cosmos.bank.v1beta1.Query/", []byte("data")), false},
+		{"missing service", types.NewQueryRequest("//Balance", []byte("data")), false},
+		{"too many path segments", types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance/extra", []byte("data")), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.request.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -22,8 +22,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalTimeoutFees(),
 		GetCmdIncentivizedPacketsForChannel(),
 		GetCmdCounterpartyAddress(),
+		GetCmdPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdParams(),
+		GetCmdClientUpdateBounties(),
+		GetCmdHandshakeBounties(),
 	)
 
 	return queryCmd
@@ -42,6 +46,13 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewPayPacketFeeAsyncTxCmd(),
 		NewRegisterCounterpartyAddress(),
+		NewRegisterPayeeCmd(),
+		NewTopUpPacketFeeTxCmd(),
+		NewWithdrawPacketFeeTxCmd(),
+		NewPayClientUpdateBountyTxCmd(),
+		NewWithdrawClientUpdateBountyTxCmd(),
+		NewPayHandshakeBountyTxCmd(),
+		NewUnlockFeeModuleTxCmd(),
 	)
 
 	return txCmd
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
@@ -20,6 +22,10 @@ const (
 	flagRecvFee    = "recv-fee"
 	flagAckFee     = "ack-fee"
 	flagTimeoutFee = "timeout-fee"
+	flagRelayers   = "relayers"
+
+	flagTargetHeight    = "target-height"
+	flagTargetTimestamp = "target-timestamp"
 )
 
 // NewPayPacketFeeAsyncTxCmd returns the command to create a MsgPayPacketFeeAsync
@@ -36,8 +42,10 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 				return err
 			}
 
-			// NOTE: specifying non-nil relayers is currently unsupported
-			var relayers []string
+			relayers, err := cmd.Flags().GetStringSlice(flagRelayers)
+			if err != nil {
+				return err
+			}
 
 			sender := clientCtx.GetFromAddress().String()
 			seq, err := strconv.ParseUint(args[2], 10, 64)
@@ -47,64 +55,227 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 
 			packetID := channeltypes.NewPacketId(args[0], args[1], seq)
 
-			recvFeeStr, err := cmd.Flags().GetString(flagRecvFee)
+			fee, err := parseFeeFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			recvFee, err := sdk.ParseCoinsNormalized(recvFeeStr)
+			packetFee := types.NewPacketFee(fee, sender, relayers)
+			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	cmd.Flags().StringSlice(flagRelayers, []string{}, "Comma separated list of relayer addresses permitted to claim the fee. Defaults to any relayer.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterCounterpartyAddress returns the command to create a MsgRegisterCounterpartyAddress
+func NewRegisterCounterpartyAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-counterparty [address] [counterparty-address] [channel-id]",
+		Short:   "Register a counterparty relayer address on a given channel.",
+		Long:    strings.TrimSpace(`Register a counterparty relayer address on a given channel.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-counterparty cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh osmo1v5y0tz01llxzf4c2afml8s3awue0ymju22wxx2 channel-0", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterCounterpartyAddress(args[0], args[1], args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
+func NewRegisterPayeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-payee [port-id] [channel-id] [relayer] [payee]",
+		Short:   "Register a payee on a given channel.",
+		Long:    strings.TrimSpace(`Register a payee address on a given channel. Packet fees earned by the relayer on the channel are paid out to the payee address.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-payee transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			ackFeeStr, err := cmd.Flags().GetString(flagAckFee)
+			msg := types.NewMsgRegisterPayee(args[0], args[1], args[2], args[3])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTopUpPacketFeeTxCmd returns the command to create a MsgTopUpPacketFee
+func NewTopUpPacketFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top-up-packet-fee [src-port] [src-channel] [sequence]",
+		Short:   "Top up an escrowed fee of an existing IBC packet",
+		Long:    strings.TrimSpace(`Top up an escrowed fee of an existing IBC packet. The fee is added to the packet fee previously escrowed by the sender.`),
+		Example: fmt.Sprintf("%s tx ibc-fee top-up-packet-fee transfer channel-0 1 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			ackFee, err := sdk.ParseCoinsNormalized(ackFeeStr)
+			seq, err := strconv.ParseUint(args[2], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			timeoutFeeStr, err := cmd.Flags().GetString(flagTimeoutFee)
+			packetID := channeltypes.NewPacketId(args[0], args[1], seq)
+
+			fee, err := parseFeeFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			timeoutFee, err := sdk.ParseCoinsNormalized(timeoutFeeStr)
+			msg := types.NewMsgTopUpPacketFee(packetID, fee, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Fee added to the fee paid to a relayer for relaying a packet receive.")
+	cmd.Flags().String(flagAckFee, "", "Fee added to the fee paid to a relayer for relaying a packet acknowledgement.")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee added to the fee paid to a relayer for relaying a packet timeout.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawPacketFeeTxCmd returns the command to create a MsgWithdrawPacketFee
+func NewWithdrawPacketFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-packet-fee [src-port] [src-channel] [sequence]",
+		Short: "Withdraw the escrowed fees of an IBC packet",
+		Long: strings.TrimSpace(`Withdraw the fees escrowed by the sender for an IBC packet. Fees can only be withdrawn once the packet
+commitment no longer exists or the refund grace period has passed since the fee was escrowed.`),
+		Example: fmt.Sprintf("%s tx ibc-fee withdraw-packet-fee transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			fee := types.Fee{
-				RecvFee:    recvFee,
-				AckFee:     ackFee,
-				TimeoutFee: timeoutFee,
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
 			}
 
-			packetFee := types.NewPacketFee(fee, sender, relayers)
-			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+			packetID := channeltypes.NewPacketId(args[0], args[1], seq)
+			msg := types.NewMsgWithdrawPacketFee(packetID, clientCtx.GetFromAddress().String())
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
-	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
-	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// NewRegisterCounterpartyAddress returns the command to create a MsgRegisterCounterpartyAddress
-func NewRegisterCounterpartyAddress() *cobra.Command {
+// NewPayClientUpdateBountyTxCmd returns the command to create a MsgPayClientUpdateBounty
+func NewPayClientUpdateBountyTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "register-counterparty [address] [counterparty-address] [channel-id]",
-		Short:   "Register a counterparty relayer address on a given channel.",
-		Long:    strings.TrimSpace(`Register a counterparty relayer address on a given channel.`),
-		Example: fmt.Sprintf("%s tx ibc-fee register-counterparty cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh osmo1v5y0tz01llxzf4c2afml8s3awue0ymju22wxx2 channel-0", version.AppName),
+		Use:   "pay-client-update-bounty [client-id] [bounty]",
+		Short: "Escrow a bounty for updating a light client",
+		Long: strings.TrimSpace(`Escrow a bounty for updating a light client. The bounty is paid to the relayer submitting the first successful
+client update once the block height reaches the target height or the block time reaches the target timestamp (in nanoseconds).`),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-client-update-bounty 07-tendermint-0 100stake --target-height 1000", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			targetHeight, err := cmd.Flags().GetUint64(flagTargetHeight)
+			if err != nil {
+				return err
+			}
+
+			targetTimestamp, err := cmd.Flags().GetUint64(flagTargetTimestamp)
+			if err != nil {
+				return err
+			}
+
+			bounty := types.NewClientUpdateBounty(fee, clientCtx.GetFromAddress().String(), targetHeight, targetTimestamp)
+			msg := types.NewMsgPayClientUpdateBounty(args[0], bounty)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagTargetHeight, 0, "Block height after which the bounty can be claimed.")
+	cmd.Flags().Uint64(flagTargetTimestamp, 0, "Block time in nanoseconds after which the bounty can be claimed.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawClientUpdateBountyTxCmd returns the command to create a MsgWithdrawClientUpdateBounty
+func NewWithdrawClientUpdateBountyTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-client-update-bounty [client-id]",
+		Short: "Withdraw the escrowed client update bounties of a light client",
+		Long: strings.TrimSpace(`Withdraw the client update bounties escrowed by the sender for a light client. Bounties can only be withdrawn
+once the client is no longer active, for instance because it has expired or has been frozen.`),
+		Example: fmt.Sprintf("%s tx ibc-fee withdraw-client-update-bounty 07-tendermint-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawClientUpdateBounty(args[0], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPayHandshakeBountyTxCmd returns the command to create a MsgPayHandshakeBounty
+func NewPayHandshakeBountyTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-handshake-bounty [port-id] [channel-id] [bounty]",
+		Short: "Escrow a bounty for completing a channel handshake",
+		Long: strings.TrimSpace(`Escrow a bounty for completing a channel handshake. The channel must be in the TRYOPEN state and the bounty
+is paid to the relayer submitting the channel open confirm.`),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-handshake-bounty transfer channel-0 100stake", version.AppName),
 		Args:    cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -112,7 +283,40 @@ func NewRegisterCounterpartyAddress() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgRegisterCounterpartyAddress(args[0], args[1], args[2])
+			fee, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			bounty := types.NewHandshakeBounty(fee, clientCtx.GetFromAddress().String())
+			msg := types.NewMsgPayHandshakeBounty(args[0], args[1], bounty)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnlockFeeModuleTxCmd returns the command to create a MsgUnlockFeeModule
+func NewUnlockFeeModuleTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-fee-module",
+		Short: "Unlock the fee module",
+		Long: strings.TrimSpace(`Unlock the fee module after it has been locked due to an insufficient escrow account balance. The fee module is
+only unlocked if the escrow account holds sufficient funds to cover all escrowed packet fees and bounties, and the sender must be the
+fee module authority.`),
+		Example: fmt.Sprintf("%s tx ibc-fee unlock-fee-module", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnlockFeeModule(clientCtx.GetFromAddress().String())
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -122,3 +326,97 @@ func NewRegisterCounterpartyAddress() *cobra.Command {
 
 	return cmd
 }
+
+// NewCmdSubmitUnlockFeeModuleProposal implements a command handler for submitting a fee module unlock proposal transaction.
+func NewCmdSubmitUnlockFeeModuleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-fee-module",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to unlock the fee module",
+		Long: strings.TrimSpace(`Submit a proposal to unlock the fee module after it has been locked due to an insufficient escrow account
+balance, along with an initial deposit. The fee module is only unlocked if the escrow account holds sufficient funds to cover all
+escrowed packet fees and bounties when the proposal is executed.`),
+		Example: fmt.Sprintf("%s tx gov submit-proposal unlock-fee-module --title [title] --description [description] --deposit 10000stake", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUnlockFeeModuleProposal(title, description)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// parseFeeFlags parses the recv, ack and timeout fee flags into a Fee
+func parseFeeFlags(cmd *cobra.Command) (types.Fee, error) {
+	recvFeeStr, err := cmd.Flags().GetString(flagRecvFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	recvFee, err := sdk.ParseCoinsNormalized(recvFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	ackFeeStr, err := cmd.Flags().GetString(flagAckFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	ackFee, err := sdk.ParseCoinsNormalized(ackFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	timeoutFeeStr, err := cmd.Flags().GetString(flagTimeoutFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	timeoutFee, err := sdk.ParseCoinsNormalized(timeoutFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	return types.NewFee(recvFee, ackFee, timeoutFee), nil
+}
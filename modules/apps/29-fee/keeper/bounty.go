@@ -0,0 +1,324 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+)
+
+var _ ibctypes.RelayerHooks = Keeper{}
+
+// EscrowClientUpdateBounty sends the client update bounty to the 29-fee module account to hold in escrow.
+// Bounties may only be escrowed for active clients.
+func (k Keeper) EscrowClientUpdateBounty(ctx sdk.Context, clientID string, bounty types.ClientUpdateBounty) error {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "client ID %s", clientID)
+	}
+
+	if status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "cannot escrow bounty for client (%s) with status %s", clientID, status)
+	}
+
+	if err := k.escrowBounty(ctx, bounty.RefundAddress, bounty.Fee); err != nil {
+		return err
+	}
+
+	bounties := []types.ClientUpdateBounty{bounty}
+	if bountiesInEscrow, found := k.GetClientUpdateBounties(ctx, clientID); found {
+		bounties = append(bounties, bountiesInEscrow.Bounties...)
+	}
+
+	k.SetClientUpdateBounties(ctx, clientID, types.NewClientUpdateBounties(bounties))
+
+	EmitIncentivizedClientUpdate(ctx, clientID, bounty)
+
+	return nil
+}
+
+// EscrowHandshakeBounty sends the handshake bounty to the 29-fee module account to hold in escrow.
+// Bounties may only be escrowed for fee enabled channels in the TRYOPEN state, which complete their handshake
+// with a channel open confirm on this chain.
+func (k Keeper) EscrowHandshakeBounty(ctx sdk.Context, portID, channelID string, bounty types.HandshakeBounty) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != channeltypes.TRYOPEN {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "expected channel state to be %s, got %s", channeltypes.TRYOPEN, channel.State)
+	}
+
+	if !k.IsFeeEnabled(ctx, portID, channelID) {
+		return sdkerrors.Wrap(types.ErrFeeNotEnabled, "cannot escrow handshake bounty")
+	}
+
+	if err := k.escrowBounty(ctx, bounty.RefundAddress, bounty.Fee); err != nil {
+		return err
+	}
+
+	bounties := []types.HandshakeBounty{bounty}
+	if bountiesInEscrow, found := k.GetHandshakeBounties(ctx, portID, channelID); found {
+		bounties = append(bounties, bountiesInEscrow.Bounties...)
+	}
+
+	k.SetHandshakeBounties(ctx, portID, channelID, types.NewHandshakeBounties(bounties))
+
+	EmitIncentivizedHandshake(ctx, portID, channelID, bounty)
+
+	return nil
+}
+
+// escrowBounty sends the bounty from the refund address to the 29-fee module account
+func (k Keeper) escrowBounty(ctx sdk.Context, refundAddress string, fee sdk.Coins) error {
+	refundAddr, err := sdk.AccAddressFromBech32(refundAddress)
+	if err != nil {
+		return err
+	}
+
+	refundAcc := k.authKeeper.GetAccount(ctx, refundAddr)
+	if refundAcc == nil {
+		return sdkerrors.Wrapf(types.ErrRefundAccNotFound, "account with address: %s not found", refundAddress)
+	}
+
+	if err := k.validateFeeDenoms(ctx, fee); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, fee)
+}
+
+// DistributeClientUpdateBounties pays the client update bounties of the given client which have reached their target
+// height or timestamp to the relayer. As a client update with an already stored header succeeds without adding a
+// consensus state, a bounty is only paid if the update increased the latest height of the client from the provided
+// previous height, or if the timestamp of the latest consensus state of the client has reached the target timestamp
+// of the bounty. The remaining bounties stay in escrow. If the client is no longer active after the update, for
+// example because misbehaviour was detected, all bounties are refunded as the client cannot be updated.
+func (k Keeper) DistributeClientUpdateBounties(ctx sdk.Context, clientID string, previousHeight exported.Height, relayer sdk.AccAddress) {
+	bountiesInEscrow, found := k.GetClientUpdateBounties(ctx, clientID)
+	if !found {
+		return
+	}
+
+	var (
+		isActive           bool
+		heightIncreased    bool
+		consensusTimestamp uint64
+	)
+
+	if clientState, found := k.clientKeeper.GetClientState(ctx, clientID); found {
+		isActive = clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc) == exported.Active
+
+		latestHeight := clientState.GetLatestHeight()
+		heightIncreased = previousHeight != nil && latestHeight.GT(previousHeight)
+
+		if consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, latestHeight); found {
+			consensusTimestamp = consensusState.GetTimestamp()
+		}
+	}
+
+	// cache context before trying to distribute bounties
+	// if the escrow account has insufficient balance then we want to avoid partially distributing bounties
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	var remaining []types.ClientUpdateBounty
+	for _, bounty := range bountiesInEscrow.Bounties {
+		claimable := bounty.IsClaimable(ctx) && (heightIncreased || bounty.IsReachedByConsensusTimestamp(consensusTimestamp))
+		if isActive && !claimable {
+			remaining = append(remaining, bounty)
+			continue
+		}
+
+		if !k.EscrowAccountHasBalance(cacheCtx, bounty.Fee) {
+			// if the escrow account does not have sufficient funds then there must exist a severe bug
+			// the fee module should be locked until manual intervention fixes the issue
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx)
+
+			return
+		}
+
+		refundAddr, err := sdk.AccAddressFromBech32(bounty.RefundAddress)
+		if err != nil {
+			panic(fmt.Sprintf("could not parse refundAcc %s to sdk.AccAddress", bounty.RefundAddress))
+		}
+
+		receiver := relayer
+		if !isActive {
+			receiver = refundAddr
+		}
+
+		k.distributeFee(cacheCtx, receiver, refundAddr, bounty.Fee)
+	}
+
+	if len(remaining) == 0 {
+		k.DeleteClientUpdateBounties(cacheCtx, clientID)
+	} else {
+		k.SetClientUpdateBounties(cacheCtx, clientID, types.NewClientUpdateBounties(remaining))
+	}
+
+	// write the cache
+	writeFn()
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// WithdrawClientUpdateBounties refunds the client update bounties escrowed by the refund address for the given client.
+// Bounties may only be withdrawn while the client is not active, as they can no longer be claimed by a client update.
+// The refunded amount is returned.
+func (k Keeper) WithdrawClientUpdateBounties(ctx sdk.Context, clientID, refundAddress string) (sdk.Coins, error) {
+	refundAddr, err := sdk.AccAddressFromBech32(refundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	bountiesInEscrow, found := k.GetClientUpdateBounties(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrFeeNotFound, "no client update bounties escrowed for client ID %s", clientID)
+	}
+
+	if clientState, found := k.clientKeeper.GetClientState(ctx, clientID); found {
+		if status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc); status == exported.Active {
+			return nil, sdkerrors.Wrapf(types.ErrBountyNotWithdrawable, "client ID %s", clientID)
+		}
+	}
+
+	var (
+		refund    sdk.Coins
+		remaining []types.ClientUpdateBounty
+	)
+
+	for _, bounty := range bountiesInEscrow.Bounties {
+		if bounty.RefundAddress != refundAddress {
+			remaining = append(remaining, bounty)
+			continue
+		}
+
+		refund = refund.Add(bounty.Fee...)
+	}
+
+	if refund.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrFeeNotFound, "no client update bounty escrowed by %s for client ID %s", refundAddress, clientID)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAddr, refund); err != nil {
+		return nil, err
+	}
+
+	if len(remaining) == 0 {
+		k.DeleteClientUpdateBounties(ctx, clientID)
+	} else {
+		k.SetClientUpdateBounties(ctx, clientID, types.NewClientUpdateBounties(remaining))
+	}
+
+	return refund, nil
+}
+
+// RefundClientUpdateBounties refunds all client update bounties of the given client to their refund addresses.
+// It is used when a client is recovered by governance, as the recovery is not performed through a MsgUpdateClient.
+// If the escrow account does not have sufficient funds the fee module is locked and no bounties are refunded.
+func (k Keeper) RefundClientUpdateBounties(ctx sdk.Context, clientID string) {
+	bountiesInEscrow, found := k.GetClientUpdateBounties(ctx, clientID)
+	if !found {
+		return
+	}
+
+	// cache context before trying to refund bounties
+	// if the escrow account has insufficient balance then we want to avoid partially refunding bounties
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	for _, bounty := range bountiesInEscrow.Bounties {
+		if !k.EscrowAccountHasBalance(cacheCtx, bounty.Fee) {
+			// if the escrow account does not have sufficient funds then there must exist a severe bug
+			// the fee module should be locked until manual intervention fixes the issue
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx)
+
+			return
+		}
+
+		refundAddr, err := sdk.AccAddressFromBech32(bounty.RefundAddress)
+		if err != nil {
+			panic(fmt.Sprintf("could not parse refundAcc %s to sdk.AccAddress", bounty.RefundAddress))
+		}
+
+		k.distributeFee(cacheCtx, refundAddr, refundAddr, bounty.Fee)
+	}
+
+	k.DeleteClientUpdateBounties(cacheCtx, clientID)
+
+	// write the cache
+	writeFn()
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// DistributeHandshakeBounties pays all handshake bounties of the given channel to the relayer
+func (k Keeper) DistributeHandshakeBounties(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress) {
+	bountiesInEscrow, found := k.GetHandshakeBounties(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	// cache context before trying to distribute bounties
+	// if the escrow account has insufficient balance then we want to avoid partially distributing bounties
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	for _, bounty := range bountiesInEscrow.Bounties {
+		if !k.EscrowAccountHasBalance(cacheCtx, bounty.Fee) {
+			// if the escrow account does not have sufficient funds then there must exist a severe bug
+			// the fee module should be locked until manual intervention fixes the issue
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx)
+
+			return
+		}
+
+		refundAddr, err := sdk.AccAddressFromBech32(bounty.RefundAddress)
+		if err != nil {
+			panic(fmt.Sprintf("could not parse refundAcc %s to sdk.AccAddress", bounty.RefundAddress))
+		}
+
+		k.distributeFee(cacheCtx, relayer, refundAddr, bounty.Fee)
+	}
+
+	k.DeleteHandshakeBounties(cacheCtx, portID, channelID)
+
+	// write the cache
+	writeFn()
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// AfterClientUpdate implements the RelayerHooks interface. It pays the claimable client update bounties of the
+// updated client to the relayer. No bounties are distributed while the fee module is locked.
+func (k Keeper) AfterClientUpdate(ctx sdk.Context, clientID string, previousHeight exported.Height, relayer sdk.AccAddress) {
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	k.DistributeClientUpdateBounties(ctx, clientID, previousHeight, relayer)
+}
+
+// AfterChanOpenConfirm implements the RelayerHooks interface. It pays the handshake bounties of the opened
+// channel to the relayer. No bounties are distributed while the fee module is locked.
+func (k Keeper) AfterChanOpenConfirm(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress) {
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	k.DistributeHandshakeBounties(ctx, portID, channelID, relayer)
+}
@@ -0,0 +1,609 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+)
+
+var defaultBounty = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(500)}}
+
+// freezeClient freezes the client of endpoint A on chainA
+func (suite *KeeperTestSuite) freezeClient() {
+	clientState := suite.chainA.GetClientState(suite.path.EndpointA.ClientID).(*ibctmtypes.ClientState)
+	clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, clientState)
+}
+
+// setupTryOpenChannel creates a channel in the TRYOPEN state on chainA
+func (suite *KeeperTestSuite) setupTryOpenChannel() {
+	suite.coordinator.SetupConnections(suite.path)
+
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenInit())
+	suite.Require().NoError(suite.path.EndpointA.ChanOpenTry())
+}
+
+func (suite *KeeperTestSuite) TestEscrowClientUpdateBounty() {
+	var (
+		clientID string
+		bounty   types.ClientUpdateBounty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success with existing bounty in escrow", func() {
+				err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), clientID, bounty)
+				suite.Require().NoError(err)
+			}, true,
+		},
+		{
+			"client not found", func() {
+				clientID = "07-tendermint-100"
+			}, false,
+		},
+		{
+			"client not active", func() {
+				suite.freezeClient()
+			}, false,
+		},
+		{
+			"refund account does not exist", func() {
+				bounty.RefundAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+			}, false,
+		},
+		{
+			"refund account has insufficient balance", func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				bounty.Fee = sdk.NewCoins(balance.AddAmount(sdk.OneInt()))
+			}, false,
+		},
+		{
+			"bounty denom not allowed", func() {
+				params := types.NewParams(types.DefaultRefundGracePeriod, []string{"atom"}, nil, 0)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()                          // reset
+			suite.coordinator.SetupClients(suite.path) // setup clients
+
+			clientID = suite.path.EndpointA.ClientID
+			refundAcc := suite.chainA.SenderAccount.GetAddress()
+			bounty = types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 1, 0)
+
+			tc.malleate()
+
+			expBounties := []types.ClientUpdateBounty{bounty}
+			if bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID); found {
+				expBounties = append(expBounties, bountiesInEscrow.Bounties...)
+			}
+
+			originalBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), clientID, bounty)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+				suite.Require().Equal(expBounties, bountiesInEscrow.Bounties)
+
+				// check if the bounty was escrowed from the refund account
+				expectedBal := originalBal.Sub(defaultBounty[0])
+				suite.Require().Equal(expectedBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeClientUpdateBountiesOnClientUpdate() {
+	suite.coordinator.SetupClients(suite.path)
+
+	clientID := suite.path.EndpointA.ClientID
+	refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	relayer := suite.chainA.SenderAccount.GetAddress()
+
+	// the first bounty may be claimed by the next client update, the second bounty only at a far later block height
+	claimableBounty := types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), uint64(suite.chainA.GetContext().BlockHeight()), 0)
+	pendingBounty := types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 1000000, 0)
+
+	err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), clientID, claimableBounty)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), clientID, pendingBounty)
+	suite.Require().NoError(err)
+
+	originalBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+
+	// the relayer of the client update is paid the claimable bounty
+	suite.coordinator.CommitBlock(suite.chainB)
+	err = suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	expectedBal := originalBal.Add(defaultBounty[0])
+	suite.Require().Equal(expectedBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom))
+
+	bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.ClientUpdateBounty{pendingBounty}, bountiesInEscrow.Bounties)
+}
+
+func (suite *KeeperTestSuite) TestReplayedClientUpdateDoesNotPayBounty() {
+	suite.coordinator.SetupClients(suite.path)
+
+	clientID := suite.path.EndpointA.ClientID
+	refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	relayer := suite.chainA.SenderAccount.GetAddress()
+
+	// update the client with a header which is stored by the client
+	suite.coordinator.CommitBlock(suite.chainB)
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, clientID)
+	suite.Require().NoError(err)
+
+	msg, err := clienttypes.NewMsgUpdateClient(clientID, header, relayer.String())
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	bounty := types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), uint64(suite.chainA.GetContext().BlockHeight()), 0)
+	err = suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), clientID, bounty)
+	suite.Require().NoError(err)
+
+	originalBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+
+	// replaying the stored header succeeds without updating the client, the bounty is not paid
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(originalBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom))
+
+	bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.ClientUpdateBounty{bounty}, bountiesInEscrow.Bounties)
+
+	// an update with a new header pays the bounty
+	suite.coordinator.CommitBlock(suite.chainB)
+	err = suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(originalBal.Add(defaultBounty[0]), suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom))
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestDistributeClientUpdateBounties() {
+	var (
+		clientID       string
+		refundAcc      sdk.AccAddress
+		relayer        sdk.AccAddress
+		bounty         types.ClientUpdateBounty
+		previousHeight exported.Height
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult func()
+	}{
+		{
+			"success: bounty which reached its target height is paid to the relayer",
+			func() {},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultBounty[0], balance)
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+				suite.Require().False(found)
+			},
+		},
+		{
+			"success: bounty which reached its target timestamp is paid to the relayer",
+			func() {
+				bounty.TargetHeight = 0
+				bounty.TargetTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), clientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{bounty}))
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultBounty[0], balance)
+			},
+		},
+		{
+			"bounty which has not reached its target remains in escrow",
+			func() {
+				bounty.TargetHeight = 1000000
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), clientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{bounty}))
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+				suite.Require().Equal([]types.ClientUpdateBounty{bounty}, bountiesInEscrow.Bounties)
+			},
+		},
+		{
+			"success: bounty which reached its target timestamp with the consensus state of a replayed update is paid to the relayer",
+			func() {
+				previousHeight = suite.chainA.GetClientState(clientID).GetLatestHeight()
+
+				consensusState, found := suite.chainA.GetConsensusState(clientID, previousHeight)
+				suite.Require().True(found)
+
+				bounty.TargetHeight = 0
+				bounty.TargetTimestamp = consensusState.GetTimestamp()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), clientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{bounty}))
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultBounty[0], balance)
+			},
+		},
+		{
+			"bounty is not paid if the update did not increase the latest height of the client",
+			func() {
+				previousHeight = suite.chainA.GetClientState(clientID).GetLatestHeight()
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+				suite.Require().Equal([]types.ClientUpdateBounty{bounty}, bountiesInEscrow.Bounties)
+			},
+		},
+		{
+			"bounties are refunded if the client is no longer active",
+			func() {
+				bounty.TargetHeight = 1000000
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), clientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{bounty}))
+
+				suite.freezeClient()
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultBounty[0], balance)
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+				suite.Require().False(found)
+			},
+		},
+		{
+			"escrow account out of balance, fee module becomes locked",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), clientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{bounty, bounty}))
+			},
+			func() {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				// the bounties are not distributed
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()                          // reset
+			suite.coordinator.SetupClients(suite.path) // setup clients
+
+			clientID = suite.path.EndpointA.ClientID
+			relayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+			// fund a fresh refund account with the bounty
+			refundAcc = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			suite.chainA.GetSimApp().AccountKeeper.SetAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().AccountKeeper.NewAccountWithAddress(suite.chainA.GetContext(), refundAcc))
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), refundAcc, defaultBounty)
+			suite.Require().NoError(err)
+
+			bounty = types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 1, 0)
+			err = suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), clientID, bounty)
+			suite.Require().NoError(err)
+
+			previousHeight = clienttypes.ZeroHeight()
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributeClientUpdateBounties(suite.chainA.GetContext(), clientID, previousHeight, relayer)
+
+			tc.expResult()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawClientUpdateBounties() {
+	var (
+		clientID      string
+		refundAcc     sdk.AccAddress
+		refundAddress string
+		bounty        types.ClientUpdateBounty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: the bounties of other refund addresses remain in escrow",
+			func() {
+				otherBounty := types.NewClientUpdateBounty(defaultBounty, suite.chainA.SenderAccount.GetAddress().String(), 1000000, 0)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), clientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{bounty, otherBounty}))
+			},
+			true,
+		},
+		{
+			"client is active",
+			func() {
+				clientState := suite.chainA.GetClientState(clientID).(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = clienttypes.ZeroHeight()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			false,
+		},
+		{
+			"no bounties escrowed for the client",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteClientUpdateBounties(suite.chainA.GetContext(), clientID)
+			},
+			false,
+		},
+		{
+			"no bounty escrowed by the refund address",
+			func() {
+				refundAddress = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				refundAddress = "invalid-addr"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()                          // reset
+			suite.coordinator.SetupClients(suite.path) // setup clients
+
+			clientID = suite.path.EndpointA.ClientID
+
+			// fund a fresh refund account with the bounty
+			refundAcc = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			refundAddress = refundAcc.String()
+			suite.chainA.GetSimApp().AccountKeeper.SetAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().AccountKeeper.NewAccountWithAddress(suite.chainA.GetContext(), refundAcc))
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), refundAcc, defaultBounty)
+			suite.Require().NoError(err)
+
+			bounty = types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 1000000, 0)
+			err = suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), clientID, bounty)
+			suite.Require().NoError(err)
+
+			suite.freezeClient()
+
+			tc.malleate()
+
+			bountiesBefore, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+
+			refund, err := suite.chainA.GetSimApp().IBCFeeKeeper.WithdrawClientUpdateBounties(suite.chainA.GetContext(), clientID, refundAddress)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(defaultBounty, refund)
+
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultBounty[0], balance)
+
+				// only the bounties of other refund addresses remain in escrow
+				bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+				for _, bounty := range bountiesInEscrow.Bounties {
+					suite.Require().NotEqual(refundAcc.String(), bounty.RefundAddress)
+				}
+				suite.Require().Equal(len(bountiesBefore.Bounties) > 1, found)
+			} else {
+				suite.Require().Error(err)
+
+				bountiesInEscrow, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+				suite.Require().Equal(bountiesBefore, bountiesInEscrow)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundClientUpdateBounties() {
+	suite.coordinator.SetupClients(suite.path)
+
+	clientID := suite.path.EndpointA.ClientID
+
+	// fund a fresh refund account with the bounty
+	refundAcc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.chainA.GetSimApp().AccountKeeper.SetAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().AccountKeeper.NewAccountWithAddress(suite.chainA.GetContext(), refundAcc))
+	err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), refundAcc, defaultBounty)
+	suite.Require().NoError(err)
+
+	bounty := types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 1000000, 0)
+	err = suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), clientID, bounty)
+	suite.Require().NoError(err)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.RefundClientUpdateBounties(suite.chainA.GetContext(), clientID)
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+	suite.Require().Equal(defaultBounty[0], balance)
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+	suite.Require().False(found)
+
+	// the fee module is locked if the escrow account does not cover the bounties
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), clientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{bounty}))
+	suite.chainA.GetSimApp().IBCFeeKeeper.RefundClientUpdateBounties(suite.chainA.GetContext(), clientID)
+
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), clientID)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestEscrowHandshakeBounty() {
+	var (
+		portID    string
+		channelID string
+		bounty    types.HandshakeBounty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success with existing bounty in escrow", func() {
+				err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowHandshakeBounty(suite.chainA.GetContext(), portID, channelID, bounty)
+				suite.Require().NoError(err)
+			}, true,
+		},
+		{
+			"channel not found", func() {
+				channelID = "channel-100"
+			}, false,
+		},
+		{
+			"channel is not in TRYOPEN state", func() {
+				suite.Require().NoError(suite.path.EndpointB.ChanOpenAck())
+				suite.Require().NoError(suite.path.EndpointA.ChanOpenConfirm())
+			}, false,
+		},
+		{
+			"fee not enabled on channel", func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), portID, channelID)
+			}, false,
+		},
+		{
+			"refund account does not exist", func() {
+				bounty.RefundAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.setupTryOpenChannel()
+
+			portID = suite.path.EndpointA.ChannelConfig.PortID
+			channelID = suite.path.EndpointA.ChannelID
+			refundAcc := suite.chainA.SenderAccount.GetAddress()
+			bounty = types.NewHandshakeBounty(defaultBounty, refundAcc.String())
+
+			tc.malleate()
+
+			expBounties := []types.HandshakeBounty{bounty}
+			if bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), portID, channelID); found {
+				expBounties = append(expBounties, bountiesInEscrow.Bounties...)
+			}
+
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowHandshakeBounty(suite.chainA.GetContext(), portID, channelID, bounty)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), portID, channelID)
+				suite.Require().True(found)
+				suite.Require().Equal(expBounties, bountiesInEscrow.Bounties)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeHandshakeBountiesOnChanOpenConfirm() {
+	suite.setupTryOpenChannel()
+
+	portID := suite.path.EndpointA.ChannelConfig.PortID
+	channelID := suite.path.EndpointA.ChannelID
+	refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	relayer := suite.chainA.SenderAccount.GetAddress()
+
+	err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowHandshakeBounty(suite.chainA.GetContext(), portID, channelID, types.NewHandshakeBounty(defaultBounty, refundAcc.String()))
+	suite.Require().NoError(err)
+
+	originalBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+
+	// the relayer of the channel open confirm is paid the bounty
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenAck())
+	suite.Require().NoError(suite.path.EndpointA.ChanOpenConfirm())
+
+	expectedBal := originalBal.Add(defaultBounty[0])
+	suite.Require().Equal(expectedBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom))
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestRefundHandshakeBountiesOnChannelClosure() {
+	suite.setupTryOpenChannel()
+
+	portID := suite.path.EndpointA.ChannelConfig.PortID
+	channelID := suite.path.EndpointA.ChannelID
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+
+	originalBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+	err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowHandshakeBounty(suite.chainA.GetContext(), portID, channelID, types.NewHandshakeBounty(defaultBounty, refundAcc.String()))
+	suite.Require().NoError(err)
+
+	err = suite.chainA.GetSimApp().IBCFeeKeeper.RefundFeesOnChannelClosure(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(originalBal, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom))
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().False(found)
+}
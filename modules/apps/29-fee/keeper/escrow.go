@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -30,10 +31,13 @@ func (k Keeper) EscrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 	}
 
 	coins := packetFee.Fee.Total()
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
+	if err := k.validateFeeDenoms(ctx, coins); err != nil {
 		return err
 	}
 
+	// the escrow timestamp is used to determine when the fee may be withdrawn, it cannot be provided by the payer
+	packetFee.EscrowTimestamp = uint64(ctx.BlockTime().UnixNano())
+
 	// multiple fees may be escrowed for a single packet, firstly create a slice containing the new fee
 	// retrieve any previous fees stored in escrow for the packet and append them to the list
 	fees := []types.PacketFee{packetFee}
@@ -41,6 +45,14 @@ func (k Keeper) EscrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 		fees = append(fees, feesInEscrow.PacketFees...)
 	}
 
+	if err := k.validatePacketFeeLimits(ctx, fees); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
+		return err
+	}
+
 	packetFees := types.NewPacketFees(fees)
 	k.SetFeesInEscrow(ctx, packetID, packetFees)
 
@@ -49,8 +61,190 @@ func (k Keeper) EscrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 	return nil
 }
 
+// TopUpEscrowedPacketFee adds the provided fee to the packet fee escrowed by the refund address for the given packetID.
+// The first packet fee of the refund address is topped up, no additional packet fee is escrowed.
+func (k Keeper) TopUpEscrowedPacketFee(ctx sdk.Context, packetID channeltypes.PacketId, fee types.Fee, refundAddress string) error {
+	refundAddr, err := sdk.AccAddressFromBech32(refundAddress)
+	if err != nil {
+		return err
+	}
+
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrFeeNotFound, "no packet fees escrowed for port ID %s, channel ID %s and sequence %d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+	}
+
+	for i, packetFee := range feesInEscrow.PacketFees {
+		if packetFee.RefundAddress != refundAddress {
+			continue
+		}
+
+		if err := k.validateFeeDenoms(ctx, fee.Total()); err != nil {
+			return err
+		}
+
+		packetFee.Fee = types.NewFee(
+			packetFee.Fee.RecvFee.Add(fee.RecvFee...),
+			packetFee.Fee.AckFee.Add(fee.AckFee...),
+			packetFee.Fee.TimeoutFee.Add(fee.TimeoutFee...),
+		)
+
+		feesInEscrow.PacketFees[i] = packetFee
+		if err := k.validatePacketFeeLimits(ctx, feesInEscrow.PacketFees); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, fee.Total()); err != nil {
+			return err
+		}
+
+		k.SetFeesInEscrow(ctx, packetID, feesInEscrow)
+
+		EmitIncentivizedPacket(ctx, packetID, packetFee)
+
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrFeeNotFound, "no packet fee escrowed by %s for port ID %s, channel ID %s and sequence %d", refundAddress, packetID.PortId, packetID.ChannelId, packetID.Sequence)
+}
+
+// validateFeeDenoms returns an error if any of the coins is not denominated in one of the allowed fee denominations.
+// All denominations are allowed if the AllowedFeeDenoms param is empty.
+func (k Keeper) validateFeeDenoms(ctx sdk.Context, coins sdk.Coins) error {
+	allowedDenoms := k.GetAllowedFeeDenoms(ctx)
+	if len(allowedDenoms) == 0 {
+		return nil
+	}
+
+	for _, coin := range coins {
+		if !isAllowedDenom(allowedDenoms, coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrFeeDenomNotAllowed, "denom %s is not one of the allowed fee denoms %v", coin.Denom, allowedDenoms)
+		}
+	}
+
+	return nil
+}
+
+// validatePacketFeeLimits returns an error if the packet fees escrowed for a single packet exceed the maximum number of
+// packet fees or the maximum total fee per packet set in the fee middleware params.
+func (k Keeper) validatePacketFeeLimits(ctx sdk.Context, packetFees []types.PacketFee) error {
+	if maxPacketFees := k.GetMaxPacketFees(ctx); maxPacketFees != 0 && uint64(len(packetFees)) > maxPacketFees {
+		return sdkerrors.Wrapf(types.ErrFeeLimitExceeded, "number of packet fees %d exceeds the maximum of %d", len(packetFees), maxPacketFees)
+	}
+
+	var total sdk.Coins
+	for _, packetFee := range packetFees {
+		total = total.Add(packetFee.Fee.Total()...)
+	}
+
+	for _, maxFee := range k.GetMaxFeePerPacket(ctx) {
+		if total.AmountOf(maxFee.Denom).GT(maxFee.Amount) {
+			return sdkerrors.Wrapf(types.ErrFeeLimitExceeded, "total packet fee %s exceeds the maximum fee per packet %s", total, maxFee)
+		}
+	}
+
+	return nil
+}
+
+func isAllowedDenom(allowedDenoms []string, denom string) bool {
+	for _, allowedDenom := range allowedDenoms {
+		if allowedDenom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetTotalFeesInEscrow returns the sum of all packet fees and bounties held in escrow by the 29-fee module account
+func (k Keeper) GetTotalFeesInEscrow(ctx sdk.Context) sdk.Coins {
+	var total sdk.Coins
+	for _, identifiedFees := range k.GetAllIdentifiedPacketFees(ctx) {
+		for _, packetFee := range identifiedFees.PacketFees {
+			total = total.Add(packetFee.Fee.Total()...)
+		}
+	}
+
+	for _, identifiedBounties := range k.GetAllClientUpdateBounties(ctx) {
+		for _, bounty := range identifiedBounties.Bounties {
+			total = total.Add(bounty.Fee...)
+		}
+	}
+
+	for _, identifiedBounties := range k.GetAllHandshakeBounties(ctx) {
+		for _, bounty := range identifiedBounties.Bounties {
+			total = total.Add(bounty.Fee...)
+		}
+	}
+
+	return total
+}
+
+// WithdrawEscrowedPacketFees refunds the packet fees escrowed by the refund address for the given packetID. A packet fee may only
+// be withdrawn if the packet commitment no longer exists or if the refund grace period has elapsed since the fee was escrowed.
+// The withdrawn packet fees are removed from escrow and the refunded coins are returned.
+func (k Keeper) WithdrawEscrowedPacketFees(ctx sdk.Context, packetID channeltypes.PacketId, refundAddress string) (sdk.Coins, error) {
+	refundAddr, err := sdk.AccAddressFromBech32(refundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrFeeNotFound, "no packet fees escrowed for port ID %s, channel ID %s and sequence %d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+	}
+
+	inFlight := len(k.GetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence)) != 0
+	gracePeriod := k.GetRefundGracePeriod(ctx)
+
+	var (
+		hasFee        bool
+		refund        sdk.Coins
+		remainingFees []types.PacketFee
+	)
+
+	for _, packetFee := range feesInEscrow.PacketFees {
+		if packetFee.RefundAddress != refundAddress {
+			remainingFees = append(remainingFees, packetFee)
+			continue
+		}
+
+		hasFee = true
+
+		withdrawableAt := time.Unix(0, int64(packetFee.EscrowTimestamp)).Add(gracePeriod)
+		if inFlight && ctx.BlockTime().Before(withdrawableAt) {
+			remainingFees = append(remainingFees, packetFee)
+			continue
+		}
+
+		refund = refund.Add(packetFee.Fee.Total()...)
+	}
+
+	if !hasFee {
+		return nil, sdkerrors.Wrapf(types.ErrFeeNotFound, "no packet fee escrowed by %s for port ID %s, channel ID %s and sequence %d", refundAddress, packetID.PortId, packetID.ChannelId, packetID.Sequence)
+	}
+
+	if refund.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrFeeNotWithdrawable, "packet with port ID %s, channel ID %s and sequence %d is in flight", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAddr, refund); err != nil {
+		return nil, err
+	}
+
+	if len(remainingFees) == 0 {
+		k.DeleteFeesInEscrow(ctx, packetID)
+	} else {
+		k.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(remainingFees))
+	}
+
+	return refund, nil
+}
+
 // DistributePacketFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packetID while refunding the timeout fees to the refund account.
-func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee) {
+// The fees are paid to the payees registered by the forward and reverse relayers on the source channel, if any.
+// The fees of a PacketFee with a list of permitted relayers are only paid to the relayers in the list, otherwise they are refunded.
+func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
@@ -76,7 +270,18 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 			panic(fmt.Sprintf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		// refund the fees of relayers which are not permitted to receive them, otherwise pay the registered payees
+		forwardRelayerAddr := k.getPayeeAddress(cacheCtx, forwardAddr, packetID.ChannelId)
+		if !k.isPermittedRelayer(cacheCtx, packetFee, forwardRelayer, packetID.ChannelId) {
+			forwardRelayerAddr = nil
+		}
+
+		reverseRelayerAddr := k.getPayeeAddress(cacheCtx, reverseRelayer, packetID.ChannelId)
+		if !k.isPermittedRelayer(cacheCtx, packetFee, reverseRelayer.String(), packetID.ChannelId) {
+			reverseRelayerAddr = refundAddr
+		}
+
+		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardRelayerAddr, reverseRelayerAddr, packetFee)
 	}
 
 	// write the cache
@@ -87,7 +292,7 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 }
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
-// If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
+// If there was no forward relayer, the forward relayer is not permitted or the associated forward relayer address is blocked, the receive fee is refunded.
 func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
 	// distribute fee to valid forward relayer address otherwise refund the fee
 	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
@@ -107,7 +312,9 @@ func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr
 }
 
 // DistributePacketsFeesOnTimeout pays all the timeout fees for a given packetID while refunding the acknowledgement & receive fees to the refund account.
-func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetFees []types.PacketFee) {
+// The timeout fees are paid to the payee registered by the timeout relayer on the source channel, if any.
+// The timeout fee of a PacketFee with a list of permitted relayers is only paid to a relayer in the list, otherwise it is refunded.
+func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
@@ -131,7 +338,13 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 			panic(fmt.Sprintf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		// refund the timeout fee if the timeout relayer is not permitted to receive it, otherwise pay the registered payee
+		timeoutRelayerAddr := k.getPayeeAddress(cacheCtx, timeoutRelayer, packetID.ChannelId)
+		if !k.isPermittedRelayer(cacheCtx, packetFee, timeoutRelayer.String(), packetID.ChannelId) {
+			timeoutRelayerAddr = refundAddr
+		}
+
+		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayerAddr, packetFee)
 	}
 
 	// write the cache
@@ -153,6 +366,44 @@ func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeou
 	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
 }
 
+// isPermittedRelayer returns true if the relayer may be paid the fees of the provided PacketFee. Any relayer is permitted
+// if the PacketFee has no list of permitted relayers. Otherwise the relayer address, or the counterparty address registered
+// by the relayer for the given channel, must be contained in the list.
+func (k Keeper) isPermittedRelayer(ctx sdk.Context, packetFee types.PacketFee, relayer, channelID string) bool {
+	if len(packetFee.Relayers) == 0 {
+		return true
+	}
+
+	counterpartyAddress, found := k.GetCounterpartyAddress(ctx, relayer, channelID)
+	for _, permittedRelayer := range packetFee.Relayers {
+		if permittedRelayer == relayer || (found && permittedRelayer == counterpartyAddress) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getPayeeAddress returns the payee registered by the relayer for the given channel, or the relayer address itself
+// if no payee is registered.
+func (k Keeper) getPayeeAddress(ctx sdk.Context, relayer sdk.AccAddress, channelID string) sdk.AccAddress {
+	if relayer.Empty() {
+		return relayer
+	}
+
+	payee, found := k.GetPayeeAddress(ctx, relayer.String(), channelID)
+	if !found {
+		return relayer
+	}
+
+	payeeAddr, err := sdk.AccAddressFromBech32(payee)
+	if err != nil {
+		return relayer
+	}
+
+	return payeeAddr
+}
+
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
 // If the distribution fails for any reason (such as the receiving address being blocked),
 // the state changes will be discarded.
@@ -181,7 +432,7 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 }
 
-// RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
+// RefundFeesOnChannelClosure will refund all fees and handshake bounties associated with the given port and channel identifiers.
 // If the escrow account runs out of balance then fee module will become locked as this implies the presence
 // of a severe bug. When the fee module is locked, no fee distributions will be performed.
 // Please see ADR 004 for more information.
@@ -230,6 +481,36 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 		k.DeleteFeesInEscrow(cacheCtx, identifiedPacketFee.PacketId)
 	}
 
+	// refund the handshake bounties of a channel which is closed before its handshake is completed
+	if handshakeBounties, found := k.GetHandshakeBounties(cacheCtx, portID, channelID); found {
+		for _, bounty := range handshakeBounties.Bounties {
+			if !k.EscrowAccountHasBalance(cacheCtx, bounty.Fee) {
+				// NOTE: we use the uncached context to lock the fee module so that the state changes from
+				// locking the fee module are persisted
+				k.lockFeeModule(ctx)
+
+				// return a nil error so state changes are committed but distribution stops
+				return nil
+			}
+
+			refundAddr, err := sdk.AccAddressFromBech32(bounty.RefundAddress)
+			if err != nil {
+				return err
+			}
+
+			// if the refund address is blocked, skip and continue distribution
+			if k.bankKeeper.BlockedAddr(refundAddr) {
+				continue
+			}
+
+			if err = k.bankKeeper.SendCoins(cacheCtx, k.GetFeeModuleAddress(), refundAddr, bounty.Fee); err != nil {
+				return err
+			}
+		}
+
+		k.DeleteHandshakeBounties(cacheCtx, portID, channelID)
+	}
+
 	// write the cache
 	writeFn()
 
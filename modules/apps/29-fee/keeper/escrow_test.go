@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestEscrowPacketFee() {
@@ -41,11 +42,39 @@ func (suite *KeeperTestSuite) TestEscrowPacketFee() {
 				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, feesInEscrow)
 			}, true,
 		},
+		{
+			"success with fee denom allowed and fee within limits", func() {
+				params := types.NewParams(types.DefaultRefundGracePeriod, []string{sdk.DefaultBondDenom}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)), 1)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, true,
+		},
 		{
 			"fee not enabled on this channel", func() {
 				packetID.ChannelId = "disabled_channel"
 			}, false,
 		},
+		{
+			"fee denom not allowed", func() {
+				params := types.NewParams(types.DefaultRefundGracePeriod, []string{"atom"}, nil, 0)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, false,
+		},
+		{
+			"max fee per packet exceeded", func() {
+				params := types.NewParams(types.DefaultRefundGracePeriod, nil, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 599)), 0)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, false,
+		},
+		{
+			"max packet fees exceeded", func() {
+				fee := types.NewFee(receiveFee, ackFee, timeoutFee)
+				packetFee := types.NewPacketFee(fee, refundAcc.String(), []string{})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+				params := types.NewParams(types.DefaultRefundGracePeriod, nil, nil, 1)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, false,
+		},
 		{
 			"refundAcc does not exist", func() {
 				// this acc does not exist on chainA
@@ -104,6 +133,8 @@ func (suite *KeeperTestSuite) TestEscrowPacketFee() {
 				suite.Require().True(feesInEscrow.PacketFees[0].Fee.AckFee.IsEqual(fee.AckFee))
 				suite.Require().True(feesInEscrow.PacketFees[0].Fee.RecvFee.IsEqual(fee.RecvFee))
 				suite.Require().True(feesInEscrow.PacketFees[0].Fee.TimeoutFee.IsEqual(fee.TimeoutFee))
+				// check if the escrow timestamp is set to the block time
+				suite.Require().Equal(uint64(suite.chainA.GetContext().BlockTime().UnixNano()), feesInEscrow.PacketFees[0].EscrowTimestamp)
 				// check if the fee is escrowed correctly
 				hasBalance := suite.chainA.GetSimApp().BankKeeper.HasBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(600)})
 				suite.Require().True(hasBalance)
@@ -119,12 +150,246 @@ func (suite *KeeperTestSuite) TestEscrowPacketFee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTopUpEscrowedPacketFee() {
+	var (
+		packetID  channeltypes.PacketId
+		refundAcc sdk.AccAddress
+		topUpFee  types.Fee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: only the recv fee is topped up", func() {
+				topUpFee = types.NewFee(defaultRecvFee, sdk.Coins{}, sdk.Coins{})
+			}, true,
+		},
+		{
+			"no packet fees escrowed for the packet", func() {
+				packetID.Sequence = 2
+			}, false,
+		},
+		{
+			"no packet fee escrowed by the refund address", func() {
+				refundAcc = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			}, false,
+		},
+		{
+			"insufficient balance", func() {
+				topUpFee = types.NewFee(invalidCoins, sdk.Coins{}, sdk.Coins{})
+			}, false,
+		},
+		{
+			"fee denom not allowed", func() {
+				params := types.NewParams(types.DefaultRefundGracePeriod, []string{"atom"}, nil, 0)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, false,
+		},
+		{
+			"max fee per packet exceeded", func() {
+				// two packet fees of 600stake are escrowed for the packet
+				params := types.NewParams(types.DefaultRefundGracePeriod, nil, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1799)), 0)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()                   // reset
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			packetID = channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			topUpFee = fee
+
+			// escrow a packet fee paid by another payer, followed by a packet fee paid by the refund account
+			otherPacketFee := types.NewPacketFee(fee, suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), nil)
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(suite.chainA.GetContext(), packetID, otherPacketFee)
+			suite.Require().NoError(err)
+
+			packetFee := types.NewPacketFee(fee, refundAcc.String(), nil)
+			err = suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(suite.chainA.GetContext(), packetID, packetFee)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			originalBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), refundAcc)
+
+			err = suite.chainA.GetSimApp().IBCFeeKeeper.TopUpEscrowedPacketFee(suite.chainA.GetContext(), packetID, topUpFee, refundAcc.String())
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+
+				// check the packet fee is topped up instead of escrowing an additional packet fee
+				suite.Require().Len(feesInEscrow.PacketFees, 2)
+				suite.Require().Equal(refundAcc.String(), feesInEscrow.PacketFees[0].RefundAddress)
+				suite.Require().Equal(fee.RecvFee.Add(topUpFee.RecvFee...), feesInEscrow.PacketFees[0].Fee.RecvFee)
+				suite.Require().Equal(fee.AckFee.Add(topUpFee.AckFee...), feesInEscrow.PacketFees[0].Fee.AckFee)
+				suite.Require().Equal(fee.TimeoutFee.Add(topUpFee.TimeoutFee...), feesInEscrow.PacketFees[0].Fee.TimeoutFee)
+
+				// check the packet fee of the other payer is unchanged
+				suite.Require().Equal(fee, feesInEscrow.PacketFees[1].Fee)
+
+				// check the refund account has sent the top up fee
+				expBal := originalBal.Sub(topUpFee.Total())
+				suite.Require().Equal(expBal, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), refundAcc))
+			} else {
+				suite.Require().Error(err)
+
+				suite.Require().Equal(originalBal, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), refundAcc))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawEscrowedPacketFees() {
+	var (
+		ctx       sdk.Context
+		packetID  channeltypes.PacketId
+		refundAcc sdk.AccAddress
+		expRefund sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: packet commitment does not exist", func() {}, true,
+		},
+		{
+			"success: refund grace period has elapsed", func() {
+				suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence, []byte("commitment"))
+
+				gracePeriod := suite.chainA.GetSimApp().IBCFeeKeeper.GetRefundGracePeriod(ctx)
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(gracePeriod))
+			}, true,
+		},
+		{
+			"success: only the packet fees escrowed after the refund grace period are kept", func() {
+				suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence, []byte("commitment"))
+
+				gracePeriod := suite.chainA.GetSimApp().IBCFeeKeeper.GetRefundGracePeriod(ctx)
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(gracePeriod))
+
+				// escrow an additional packet fee which is not withdrawable yet
+				packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAcc.String(), nil)
+				err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(ctx, packetID, packetFee)
+				suite.Require().NoError(err)
+			}, true,
+		},
+		{
+			"packet is in flight and the refund grace period has not elapsed", func() {
+				suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence, []byte("commitment"))
+			}, false,
+		},
+		{
+			"no packet fees escrowed for the packet", func() {
+				packetID.Sequence = 2
+			}, false,
+		},
+		{
+			"no packet fee escrowed by the refund address", func() {
+				refundAcc = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()                   // reset
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			ctx = suite.chainA.GetContext()
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			packetID = channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			// escrow two packet fees paid by the refund account, and a packet fee paid by another payer
+			packetFee := types.NewPacketFee(fee, refundAcc.String(), nil)
+			otherPacketFee := types.NewPacketFee(fee, suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), nil)
+			for _, pf := range []types.PacketFee{packetFee, otherPacketFee, packetFee} {
+				err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(ctx, packetID, pf)
+				suite.Require().NoError(err)
+			}
+
+			expRefund = fee.Total().Add(fee.Total()...)
+
+			tc.malleate()
+
+			originalBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc)
+			originalFees, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+
+			refund, err := suite.chainA.GetSimApp().IBCFeeKeeper.WithdrawEscrowedPacketFees(ctx, packetID, refundAcc.String())
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRefund, refund)
+
+				// check the refund account has been refunded
+				suite.Require().Equal(originalBal.Add(expRefund...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc))
+
+				// check the withdrawn packet fees are removed from escrow
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+				suite.Require().True(found)
+				suite.Require().Len(feesInEscrow.PacketFees, len(originalFees.PacketFees)-2)
+				for _, pf := range feesInEscrow.PacketFees {
+					if pf.RefundAddress == refundAcc.String() {
+						suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), pf.EscrowTimestamp)
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(refund)
+
+				suite.Require().Equal(originalBal, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawEscrowedPacketFeesDeletesFees() {
+	suite.coordinator.Setup(suite.path) // setup channel
+
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAcc.String(), nil)
+
+	err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(suite.chainA.GetContext(), packetID, packetFee)
+	suite.Require().NoError(err)
+
+	refund, err := suite.chainA.GetSimApp().IBCFeeKeeper.WithdrawEscrowedPacketFees(suite.chainA.GetContext(), packetID, refundAcc.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(packetFee.Fee.Total(), refund)
+
+	// the fees in escrow are deleted once every packet fee is withdrawn
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+}
+
 func (suite *KeeperTestSuite) TestDistributePacketFeesOnAcknowledgement() {
 	var (
 		forwardRelayer    string
 		forwardRelayerBal sdk.Coin
 		reverseRelayer    sdk.AccAddress
 		reverseRelayerBal sdk.Coin
+		payee             sdk.AccAddress
+		payeeBal          sdk.Coin
 		refundAcc         sdk.AccAddress
 		refundAccBal      sdk.Coin
 		packetFee         types.PacketFee
@@ -213,6 +478,147 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnAcknowledgement() {
 				suite.Require().Equal(expectedRefundAccBal, balance)
 			},
 		},
+		{
+			"success: forward and reverse relayers have registered payees",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), forwardRelayer, payee.String(), suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), reverseRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+			},
+			func() {
+				// check if the payee is paid both the recv and ack fees
+				expectedPayeeAccBal := payeeBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0]).Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedPayeeAccBal, balance)
+
+				// check the relayers are not paid
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(reverseRelayerBal, balance)
+
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(forwardRelayerBal, balance)
+			},
+		},
+		{
+			"success: payee registered on a different channel is not paid",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), reverseRelayer.String(), payee.String(), ibctesting.InvalidID)
+			},
+			func() {
+				// check if the reverse relayer is paid
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().Equal(payeeBal, balance)
+			},
+		},
+		{
+			"permitted relayers: relayers with registered payees are checked against the list",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), reverseRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+
+				packetFees[0].Relayers = []string{payee.String()}
+				packetFees[1].Relayers = []string{payee.String()}
+			},
+			func() {
+				// check the payee is not paid as the reverse relayer is not permitted
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().Equal(payeeBal, balance)
+
+				// check if the refund acc has been refunded all the fees
+				expectedRefundAccBal := sdk.Coins{refundAccBal}.Add(packetFee.Fee.Total()...).Add(packetFee.Fee.Total()...)[0]
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+			},
+		},
+		{
+			"permitted relayers: forward and reverse relayers are paid",
+			func() {
+				packetFees[0].Relayers = []string{forwardRelayer, reverseRelayer.String()}
+				packetFees[1].Relayers = []string{reverseRelayer.String(), forwardRelayer}
+			},
+			func() {
+				// check if the reverse relayer is paid
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check if the forward relayer is paid
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				expectedForwardAccBal := forwardRelayerBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedForwardAccBal, balance)
+			},
+		},
+		{
+			"permitted relayers: reverse relayer is paid through its registered counterparty address",
+			func() {
+				counterpartyAddress := suite.chainB.SenderAccount.GetAddress().String()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyAddress(suite.chainA.GetContext(), reverseRelayer.String(), counterpartyAddress, suite.path.EndpointA.ChannelID)
+
+				packetFees[0].Relayers = []string{forwardRelayer, counterpartyAddress}
+				packetFees[1].Relayers = []string{forwardRelayer, counterpartyAddress}
+			},
+			func() {
+				// check if the reverse relayer is paid
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check if the refund acc has been refunded the timeoutFee
+				expectedRefundAccBal := refundAccBal.Add(defaultTimeoutFee[0]).Add(defaultTimeoutFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+			},
+		},
+		{
+			"permitted relayers: forward and reverse relayers are not permitted, fees returned to sender",
+			func() {
+				otherRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+				packetFees[0].Relayers = []string{otherRelayer}
+				packetFees[1].Relayers = []string{otherRelayer}
+			},
+			func() {
+				// check the relayers are not paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(reverseRelayerBal, balance)
+
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(forwardRelayerBal, balance)
+
+				// check if the refund acc has been refunded all the fees
+				expectedRefundAccBal := sdk.Coins{refundAccBal}.Add(packetFee.Fee.Total()...).Add(packetFee.Fee.Total()...)[0]
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+			},
+		},
+		{
+			"permitted relayers: only the forward relayer is permitted, ack fee returned to sender",
+			func() {
+				packetFees[0].Relayers = []string{forwardRelayer}
+				packetFees[1].Relayers = []string{forwardRelayer}
+			},
+			func() {
+				// check the reverse relayer is not paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(reverseRelayerBal, balance)
+
+				// check if the refund acc has been refunded the timeoutFee & ackFee
+				expectedRefundAccBal := refundAccBal.Add(defaultTimeoutFee[0]).Add(defaultAckFee[0]).Add(defaultTimeoutFee[0]).Add(defaultAckFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+			},
+		},
 		{
 			"invalid refund address: no-op, timeout fee remains in escrow",
 			func() {
@@ -238,6 +644,7 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnAcknowledgement() {
 			// setup accounts
 			forwardRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
 			reverseRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			payee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 			refundAcc = suite.chainA.SenderAccount.GetAddress()
 
 			packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
@@ -259,9 +666,10 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnAcknowledgement() {
 			forwardAccAddress, _ := sdk.AccAddressFromBech32(forwardRelayer)
 			forwardRelayerBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forwardAccAddress, sdk.DefaultBondDenom)
 			reverseRelayerBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+			payeeBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
 			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 
-			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reverseRelayer, packetFees)
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reverseRelayer, packetFees, packetID)
 
 			tc.expResult()
 		})
@@ -272,6 +680,8 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 	var (
 		timeoutRelayer    sdk.AccAddress
 		timeoutRelayerBal sdk.Coin
+		payee             sdk.AccAddress
+		payeeBal          sdk.Coin
 		refundAcc         sdk.AccAddress
 		refundAccBal      sdk.Coin
 		packetFee         types.PacketFee
@@ -328,6 +738,54 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				suite.Require().Equal(expectedRefundAccBal, balance)
 			},
 		},
+		{
+			"success: timeout relayer has a registered payee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), timeoutRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+			},
+			func() {
+				// check if the payee is paid the timeout fees
+				expectedPayeeAccBal := payeeBal.Add(defaultTimeoutFee[0]).Add(defaultTimeoutFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedPayeeAccBal, balance)
+
+				// check the timeout relayer is not paid
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(timeoutRelayerBal, balance)
+			},
+		},
+		{
+			"permitted relayers: timeout relayer is paid",
+			func() {
+				packetFees[0].Relayers = []string{timeoutRelayer.String()}
+				packetFees[1].Relayers = []string{timeoutRelayer.String()}
+			},
+			func() {
+				// check if the timeout relayer is paid
+				expectedTimeoutAccBal := timeoutRelayerBal.Add(defaultTimeoutFee[0]).Add(defaultTimeoutFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedTimeoutAccBal, balance)
+			},
+		},
+		{
+			"permitted relayers: timeout relayer is not permitted, timeout fee returned to sender",
+			func() {
+				otherRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+				packetFees[0].Relayers = []string{otherRelayer}
+				packetFees[1].Relayers = []string{otherRelayer}
+			},
+			func() {
+				// check the timeout relayer is not paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(timeoutRelayerBal, balance)
+
+				// check if the refund acc has been refunded all the fees
+				expectedRefundAccBal := sdk.Coins{refundAccBal}.Add(packetFee.Fee.Total()...).Add(packetFee.Fee.Total()...)[0]
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+			},
+		},
 		{
 			"invalid refund address: no-op, recv and ack fees remain in escrow",
 			func() {
@@ -352,6 +810,7 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 
 			// setup accounts
 			timeoutRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			payee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 			refundAcc = suite.chainA.SenderAccount.GetAddress()
 
 			packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
@@ -371,9 +830,10 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 
 			// fetch the account balances before fee distribution (forward, reverse, refund)
 			timeoutRelayerBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+			payeeBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
 			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 
-			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), timeoutRelayer, packetFees)
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), timeoutRelayer, packetFees, packetID)
 
 			tc.expResult()
 		})
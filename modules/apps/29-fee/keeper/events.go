@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
@@ -23,3 +24,79 @@ func EmitIncentivizedPacket(ctx sdk.Context, packetID channeltypes.PacketId, pac
 		),
 	)
 }
+
+// EmitIncentivizedClientUpdate emits an event so that relayers know an incentivized client update is ready to be relayed
+func EmitIncentivizedClientUpdate(ctx sdk.Context, clientID string, bounty types.ClientUpdateBounty) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIncentivizedClientUpdate,
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyBounty, bounty.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyTargetHeight, fmt.Sprint(bounty.TargetHeight)),
+			sdk.NewAttribute(types.AttributeKeyTargetTimestamp, fmt.Sprint(bounty.TargetTimestamp)),
+		),
+	)
+}
+
+// EmitIncentivizedHandshake emits an event so that relayers know an incentivized channel handshake is ready to be relayed
+func EmitIncentivizedHandshake(ctx sdk.Context, portID, channelID string, bounty types.HandshakeBounty) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIncentivizedHandshake,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyBounty, bounty.Fee.String()),
+		),
+	)
+}
+
+// EmitRegisterPayeeEvent emits an event containing information of a registered payee for a relayer on a particular channel
+func EmitRegisterPayeeEvent(ctx sdk.Context, relayer, payee, channelID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterPayee,
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer),
+			sdk.NewAttribute(types.AttributeKeyPayee, payee),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitWithdrawClientUpdateBounty emits an event containing the client update bounties withdrawn by a refund address
+// for a client
+func EmitWithdrawClientUpdateBounty(ctx sdk.Context, clientID, refundAddress string, refund sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawClientUpdateBounty,
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyRefundAddr, refundAddress),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitWithdrawPacketFee emits an event containing the packet fees withdrawn by a refund address for a packet
+func EmitWithdrawPacketFee(ctx sdk.Context, packetID channeltypes.PacketId, refundAddress string, refund sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawPacketFee,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRefundAddr, refundAddress),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
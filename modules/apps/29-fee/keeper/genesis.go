@@ -16,6 +16,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		k.SetCounterpartyAddress(ctx, relayer.Address, relayer.CounterpartyAddress, relayer.ChannelId)
 	}
 
+	for _, registeredPayee := range state.RegisteredPayees {
+		k.SetPayeeAddress(ctx, registeredPayee.Relayer, registeredPayee.Payee, registeredPayee.ChannelId)
+	}
+
 	for _, forwardAddr := range state.ForwardRelayers {
 		k.SetRelayerAddressForAsyncAck(ctx, forwardAddr.PacketId, forwardAddr.Address)
 	}
@@ -23,14 +27,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, identifiedBounties := range state.ClientUpdateBounties {
+		k.SetClientUpdateBounties(ctx, identifiedBounties.ClientId, types.NewClientUpdateBounties(identifiedBounties.Bounties))
+	}
+
+	for _, identifiedBounties := range state.HandshakeBounties {
+		k.SetHandshakeBounties(ctx, identifiedBounties.PortId, identifiedBounties.ChannelId, types.NewHandshakeBounties(identifiedBounties.Bounties))
+	}
+
+	k.SetParams(ctx, state.Params)
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		IdentifiedFees:     k.GetAllIdentifiedPacketFees(ctx),
-		FeeEnabledChannels: k.GetAllFeeEnabledChannels(ctx),
-		RegisteredRelayers: k.GetAllRelayerAddresses(ctx),
-		ForwardRelayers:    k.GetAllForwardRelayerAddresses(ctx),
+		IdentifiedFees:       k.GetAllIdentifiedPacketFees(ctx),
+		FeeEnabledChannels:   k.GetAllFeeEnabledChannels(ctx),
+		RegisteredRelayers:   k.GetAllRelayerAddresses(ctx),
+		ForwardRelayers:      k.GetAllForwardRelayerAddresses(ctx),
+		RegisteredPayees:     k.GetAllPayees(ctx),
+		Params:               k.GetParams(ctx),
+		ClientUpdateBounties: k.GetAllClientUpdateBounties(ctx),
+		HandshakeBounties:    k.GetAllHandshakeBounties(ctx),
 	}
 }
@@ -46,6 +46,23 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:           ibctesting.FirstChannelID,
 			},
 		},
+		RegisteredPayees: []types.RegisteredPayee{
+			{
+				Relayer:   sender,
+				Payee:     suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+				ChannelId: ibctesting.FirstChannelID,
+			},
+		},
+		ClientUpdateBounties: []types.IdentifiedClientUpdateBounties{
+			types.NewIdentifiedClientUpdateBounties(ibctesting.FirstClientID, []types.ClientUpdateBounty{
+				types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 100, 0),
+			}),
+		},
+		HandshakeBounties: []types.IdentifiedHandshakeBounties{
+			types.NewIdentifiedHandshakeBounties(ibctesting.MockFeePort, ibctesting.FirstChannelID, []types.HandshakeBounty{
+				types.NewHandshakeBounty(defaultBounty, refundAcc.String()),
+			}),
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -63,6 +80,21 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	addr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyAddress(suite.chainA.GetContext(), sender, ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredRelayers[0].CounterpartyAddress, addr)
+
+	// check payee addresses
+	payeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), sender, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RegisteredPayees[0].Payee, payeeAddr)
+
+	// check client update bounties
+	clientUpdateBounties, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), ibctesting.FirstClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.ClientUpdateBounties[0].Bounties, clientUpdateBounties.Bounties)
+
+	// check handshake bounties
+	handshakeBounties, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.HandshakeBounties[0].Bounties, handshakeBounties.Bounties)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -87,9 +119,20 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set counterparty address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyAddress(suite.chainA.GetContext(), sender, counterparty, ibctesting.FirstChannelID)
 
+	// set payee address
+	payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), sender, payee, ibctesting.FirstChannelID)
+
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, sender)
 
+	// set client update and handshake bounties
+	clientUpdateBounty := types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 100, 0)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), ibctesting.FirstClientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{clientUpdateBounty}))
+
+	handshakeBounty := types.NewHandshakeBounty(defaultBounty, refundAcc.String())
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetHandshakeBounties(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewHandshakeBounties([]types.HandshakeBounty{handshakeBounty}))
+
 	// export genesis
 	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
 
@@ -110,4 +153,18 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// check registered relayer addresses
 	suite.Require().Equal(sender, genesisState.ForwardRelayers[0].Address)
 	suite.Require().Equal(packetID, genesisState.ForwardRelayers[0].PacketId)
+
+	// check registered payee addresses
+	suite.Require().Equal(sender, genesisState.RegisteredPayees[0].Relayer)
+	suite.Require().Equal(payee, genesisState.RegisteredPayees[0].Payee)
+	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredPayees[0].ChannelId)
+
+	// check client update bounties
+	suite.Require().Equal(ibctesting.FirstClientID, genesisState.ClientUpdateBounties[0].ClientId)
+	suite.Require().Equal([]types.ClientUpdateBounty{clientUpdateBounty}, genesisState.ClientUpdateBounties[0].Bounties)
+
+	// check handshake bounties
+	suite.Require().Equal(ibctesting.MockFeePort, genesisState.HandshakeBounties[0].PortId)
+	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.HandshakeBounties[0].ChannelId)
+	suite.Require().Equal([]types.HandshakeBounty{handshakeBounty}, genesisState.HandshakeBounties[0].Bounties)
 }
@@ -192,6 +192,34 @@ func (k Keeper) CounterpartyAddress(goCtx context.Context, req *types.QueryCount
 	}, nil
 }
 
+// Payee implements the Query/Payee gRPC method and returns the registered payee address to which packet fees are paid out
+func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*types.QueryPayeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payeeAddr, found := k.GetPayeeAddress(ctx, req.Relayer, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "payee address not found for address: %s on channel: %s", req.Relayer, req.ChannelId)
+	}
+
+	return &types.QueryPayeeResponse{
+		PayeeAddress: payeeAddr,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method and returns the fee middleware parameters
+func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
 // FeeEnabledChannels implements the Query/FeeEnabledChannels gRPC method and returns a list of fee enabled channels
 func (k Keeper) FeeEnabledChannels(goCtx context.Context, req *types.QueryFeeEnabledChannelsRequest) (*types.QueryFeeEnabledChannelsResponse, error) {
 	if req == nil {
@@ -242,3 +270,39 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// ClientUpdateBounties implements the Query/ClientUpdateBounties gRPC method and returns the bounties escrowed for the update of a client
+func (k Keeper) ClientUpdateBounties(goCtx context.Context, req *types.QueryClientUpdateBountiesRequest) (*types.QueryClientUpdateBountiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bounties, found := k.GetClientUpdateBounties(ctx, req.ClientId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client update bounties not found for client: %s", req.ClientId)
+	}
+
+	return &types.QueryClientUpdateBountiesResponse{
+		Bounties: bounties.Bounties,
+	}, nil
+}
+
+// HandshakeBounties implements the Query/HandshakeBounties gRPC method and returns the bounties escrowed for the completion of a channel handshake
+func (k Keeper) HandshakeBounties(goCtx context.Context, req *types.QueryHandshakeBountiesRequest) (*types.QueryHandshakeBountiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bounties, found := k.GetHandshakeBounties(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "handshake bounties not found for port: %s on channel: %s", req.PortId, req.ChannelId)
+	}
+
+	return &types.QueryHandshakeBountiesResponse{
+		Bounties: bounties.Bounties,
+	}, nil
+}
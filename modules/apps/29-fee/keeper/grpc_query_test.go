@@ -30,6 +30,7 @@ func (suite *KeeperTestSuite) TestQueryIncentivizedPackets() {
 
 				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
 				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), []string(nil))
+				packetFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
 
 				for i := 0; i < 3; i++ {
 					// escrow packet fees for three different packets
@@ -115,6 +116,7 @@ func (suite *KeeperTestSuite) TestQueryIncentivizedPacket() {
 			packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
 			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
 			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), []string(nil))
+			packetFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
 
 			for i := 0; i < 3; i++ {
 				// escrow three packet fees for the same packet
@@ -271,6 +273,7 @@ func (suite *KeeperTestSuite) TestQueryTotalRecvFees() {
 
 			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
 			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), []string(nil))
+			packetFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
 
 			for i := 0; i < 3; i++ {
 				// escrow three packet fees for the same packet
@@ -335,6 +338,7 @@ func (suite *KeeperTestSuite) TestQueryTotalAckFees() {
 
 			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
 			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), []string(nil))
+			packetFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
 
 			for i := 0; i < 3; i++ {
 				// escrow three packet fees for the same packet
@@ -399,6 +403,7 @@ func (suite *KeeperTestSuite) TestQueryTotalTimeoutFees() {
 
 			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
 			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), []string(nil))
+			packetFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
 
 			for i := 0; i < 3; i++ {
 				// escrow three packet fees for the same packet
@@ -494,6 +499,182 @@ func (suite *KeeperTestSuite) TestQueryCounterpartyAddress() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPayee() {
+	var (
+		req *types.QueryPayeeRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"payee address not found: invalid channel",
+			func() {
+				req.ChannelId = "invalid-channel-id"
+			},
+			false,
+		},
+		{
+			"payee address not found: invalid relayer address",
+			func() {
+				req.Relayer = "invalid-addr"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pk := secp256k1.GenPrivKey().PubKey()
+			expPayeeAddr := sdk.AccAddress(pk.Address())
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(
+				suite.chainA.GetContext(),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				expPayeeAddr.String(),
+				suite.path.EndpointA.ChannelID,
+			)
+
+			req = &types.QueryPayeeRequest{
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Relayer:   suite.chainA.SenderAccount.GetAddress().String(),
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.Payee(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPayeeAddr.String(), res.PayeeAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientUpdateBounties() {
+	var (
+		req *types.QueryClientUpdateBountiesRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"bounties not found",
+			func() {
+				req.ClientId = "07-tendermint-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			bounty := types.NewClientUpdateBounty(defaultBounty, suite.chainA.SenderAccount.GetAddress().String(), 100, 0)
+			expBounties := []types.ClientUpdateBounty{bounty}
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), ibctesting.FirstClientID, types.NewClientUpdateBounties(expBounties))
+
+			req = &types.QueryClientUpdateBountiesRequest{
+				ClientId: ibctesting.FirstClientID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.ClientUpdateBounties(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expBounties, res.Bounties)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryHandshakeBounties() {
+	var (
+		req *types.QueryHandshakeBountiesRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"bounties not found",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			bounty := types.NewHandshakeBounty(defaultBounty, suite.chainA.SenderAccount.GetAddress().String())
+			expBounties := []types.HandshakeBounty{bounty}
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetHandshakeBounties(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewHandshakeBounties(expBounties))
+
+			req = &types.QueryHandshakeBountiesRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.HandshakeBounties(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expBounties, res.Bounties)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	expParams := types.DefaultParams()
+	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&expParams, res.Params)
+}
+
 func (suite *KeeperTestSuite) TestQueryFeeEnabledChannels() {
 	var (
 		req                   *types.QueryFeeEnabledChannelsRequest
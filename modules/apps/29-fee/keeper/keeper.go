@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -24,27 +25,41 @@ type Keeper struct {
 	storeKey sdk.StoreKey
 	cdc      codec.BinaryCodec
 
+	paramSpace paramtypes.Subspace
+
 	authKeeper    types.AccountKeeper
 	ics4Wrapper   types.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
+
+	// the address capable of unlocking the fee module, usually the gov module account
+	authority string
 }
 
 // NewKeeper creates a new 29-fee Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, clientKeeper types.ClientKeeper, portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
 
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      key,
+		paramSpace:    paramSpace,
 		ics4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
@@ -69,6 +84,16 @@ func (k Keeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (
 	return k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
 }
 
+// GetPacketCommitment wraps IBC ChannelKeeper's GetPacketCommitment function
+func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
+	return k.channelKeeper.GetPacketCommitment(ctx, portID, channelID, sequence)
+}
+
+// GetAuthority returns the address capable of unlocking the fee module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetFeeModuleAddress returns the ICS29 Fee ModuleAccount address
 func (k Keeper) GetFeeModuleAddress() sdk.AccAddress {
 	return k.authKeeper.GetModuleAddress(types.ModuleName)
@@ -93,6 +118,42 @@ func (k Keeper) lockFeeModule(ctx sdk.Context) {
 	store.Set(types.KeyLocked(), []byte{1})
 }
 
+// unlockFeeModule deletes the flag locking the fee module, fee handling logic resumes for all fee enabled channels.
+func (k Keeper) unlockFeeModule(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLocked())
+}
+
+// verifyAndUnlockFeeModule unlocks the locked fee module once the escrow account holds sufficient funds to cover all
+// escrowed packet fees and bounties. The total fees in escrow are returned.
+func (k Keeper) verifyAndUnlockFeeModule(ctx sdk.Context) (sdk.Coins, error) {
+	if !k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleNotLocked
+	}
+
+	totalFees := k.GetTotalFeesInEscrow(ctx)
+	if !k.EscrowAccountHasBalance(ctx, totalFees) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientEscrowBalance, "escrowed fees %s", totalFees)
+	}
+
+	k.unlockFeeModule(ctx)
+
+	return totalFees, nil
+}
+
+// HandleUnlockFeeModuleProposal unlocks the locked fee module once the escrow account holds sufficient funds to
+// cover all escrowed packet fees and bounties.
+func (k Keeper) HandleUnlockFeeModuleProposal(ctx sdk.Context, p *types.UnlockFeeModuleProposal) error {
+	totalFees, err := k.verifyAndUnlockFeeModule(ctx)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("fee module unlocked by governance proposal", "title", p.Title, "escrowed-fees", totalFees)
+
+	return nil
+}
+
 // IsLocked indicates if the fee module is locked
 // Please see ADR 004 for more information.
 func (k Keeper) IsLocked(ctx sdk.Context) bool {
@@ -188,6 +249,49 @@ func (k Keeper) GetAllRelayerAddresses(ctx sdk.Context) []types.RegisteredRelaye
 	return registeredAddrArr
 }
 
+// SetPayeeAddress stores the fee payee for the given relayer and channel
+func (k Keeper) SetPayeeAddress(ctx sdk.Context, relayerAddr, payeeAddr, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPayee(relayerAddr, channelID), []byte(payeeAddr))
+}
+
+// GetPayeeAddress retrieves the fee payee address stored in state given the provided channel identifier and relayer address
+func (k Keeper) GetPayeeAddress(ctx sdk.Context, relayerAddr, channelID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPayee(relayerAddr, channelID)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// GetAllPayees returns all registered payees
+func (k Keeper) GetAllPayees(ctx sdk.Context) []types.RegisteredPayee {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.PayeeKeyPrefix))
+	defer iterator.Close()
+
+	var registeredPayees []types.RegisteredPayee
+	for ; iterator.Valid(); iterator.Next() {
+		relayerAddr, channelID, err := types.ParseKeyPayee(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		payee := types.RegisteredPayee{
+			Relayer:   relayerAddr,
+			Payee:     string(iterator.Value()),
+			ChannelId: channelID,
+		}
+
+		registeredPayees = append(registeredPayees, payee)
+	}
+
+	return registeredPayees
+}
+
 // SetRelayerAddressForAsyncAck sets the forward relayer address during OnRecvPacket in case of async acknowledgement
 func (k Keeper) SetRelayerAddressForAsyncAck(ctx sdk.Context, packetID channeltypes.PacketId, address string) {
 	store := ctx.KVStore(k.storeKey)
@@ -320,6 +424,104 @@ func (k Keeper) GetAllIdentifiedPacketFees(ctx sdk.Context) []types.IdentifiedPa
 	return identifiedFees
 }
 
+// GetClientUpdateBounties returns all client update bounties escrowed for a given client
+func (k Keeper) GetClientUpdateBounties(ctx sdk.Context, clientID string) (types.ClientUpdateBounties, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyClientUpdateBounties(clientID))
+	if bz == nil {
+		return types.ClientUpdateBounties{}, false
+	}
+
+	var bounties types.ClientUpdateBounties
+	k.cdc.MustUnmarshal(bz, &bounties)
+
+	return bounties, true
+}
+
+// SetClientUpdateBounties sets the given client update bounties keyed by the client identifier
+func (k Keeper) SetClientUpdateBounties(ctx sdk.Context, clientID string, bounties types.ClientUpdateBounties) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bounties)
+	store.Set(types.KeyClientUpdateBounties(clientID), bz)
+}
+
+// DeleteClientUpdateBounties deletes the client update bounties associated with the given client
+func (k Keeper) DeleteClientUpdateBounties(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyClientUpdateBounties(clientID))
+}
+
+// GetAllClientUpdateBounties returns a list of all IdentifiedClientUpdateBounties that are stored in state
+func (k Keeper) GetAllClientUpdateBounties(ctx sdk.Context) []types.IdentifiedClientUpdateBounties {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.ClientUpdateBountiesPrefix))
+	defer iterator.Close()
+
+	var identifiedBounties []types.IdentifiedClientUpdateBounties
+	for ; iterator.Valid(); iterator.Next() {
+		clientID, err := types.ParseKeyClientUpdateBounties(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var bounties types.ClientUpdateBounties
+		k.cdc.MustUnmarshal(iterator.Value(), &bounties)
+
+		identifiedBounties = append(identifiedBounties, types.NewIdentifiedClientUpdateBounties(clientID, bounties.Bounties))
+	}
+
+	return identifiedBounties
+}
+
+// GetHandshakeBounties returns all handshake bounties escrowed for a given channel
+func (k Keeper) GetHandshakeBounties(ctx sdk.Context, portID, channelID string) (types.HandshakeBounties, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyHandshakeBounties(portID, channelID))
+	if bz == nil {
+		return types.HandshakeBounties{}, false
+	}
+
+	var bounties types.HandshakeBounties
+	k.cdc.MustUnmarshal(bz, &bounties)
+
+	return bounties, true
+}
+
+// SetHandshakeBounties sets the given handshake bounties keyed by the port and channel identifiers
+func (k Keeper) SetHandshakeBounties(ctx sdk.Context, portID, channelID string, bounties types.HandshakeBounties) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bounties)
+	store.Set(types.KeyHandshakeBounties(portID, channelID), bz)
+}
+
+// DeleteHandshakeBounties deletes the handshake bounties associated with the given port and channel identifiers
+func (k Keeper) DeleteHandshakeBounties(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyHandshakeBounties(portID, channelID))
+}
+
+// GetAllHandshakeBounties returns a list of all IdentifiedHandshakeBounties that are stored in state
+func (k Keeper) GetAllHandshakeBounties(ctx sdk.Context) []types.IdentifiedHandshakeBounties {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.HandshakeBountiesPrefix))
+	defer iterator.Close()
+
+	var identifiedBounties []types.IdentifiedHandshakeBounties
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseKeyHandshakeBounties(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var bounties types.HandshakeBounties
+		k.cdc.MustUnmarshal(iterator.Value(), &bounties)
+
+		identifiedBounties = append(identifiedBounties, types.NewIdentifiedHandshakeBounties(portID, channelID, bounties.Bounties))
+	}
+
+	return identifiedBounties
+}
+
 // MustMarshalFees attempts to encode a Fee object and returns the
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustMarshalFees(fees types.PacketFees) []byte {
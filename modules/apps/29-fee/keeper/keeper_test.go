@@ -257,3 +257,28 @@ func (suite *KeeperTestSuite) TestGetAllRelayerAddresses() {
 	suite.Require().Len(addr, len(expectedAddr))
 	suite.Require().Equal(addr, expectedAddr)
 }
+
+func (suite *KeeperTestSuite) TestGetAllPayees() {
+	var expectedPayees []types.RegisteredPayee
+
+	for i := 0; i < 3; i++ {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(
+			suite.chainA.GetContext(),
+			suite.chainA.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			ibctesting.FirstChannelID,
+		)
+
+		registeredPayee := types.RegisteredPayee{
+			Relayer:   suite.chainA.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			Payee:     suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			ChannelId: ibctesting.FirstChannelID,
+		}
+
+		expectedPayees = append(expectedPayees, registeredPayee)
+	}
+
+	registeredPayees := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllPayees(suite.chainA.GetContext())
+	suite.Require().Len(registeredPayees, len(expectedPayees))
+	suite.Require().ElementsMatch(expectedPayees, registeredPayees)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -26,6 +27,41 @@ func (k Keeper) RegisterCounterpartyAddress(goCtx context.Context, msg *types.Ms
 	return &types.MsgRegisterCounterpartyAddressResponse{}, nil
 }
 
+// RegisterPayee defines a rpc handler method for MsgRegisterPayee
+// RegisterPayee is called by the relayer on each channelEnd and allows them to set an optional
+// payee to which escrowed packet fees will be paid out. The payee should be registered on the source chain from which
+// packets originate as this is where fee distribution takes place. This function may be called more than once by a relayer,
+// in which case, the latest payee is always used.
+func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee) (*types.MsgRegisterPayeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payee, err := sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(payee) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not authorized to receive funds", payee)
+	}
+
+	// only register payee address if the channel exists and is fee enabled
+	if _, found := k.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	k.SetPayeeAddress(ctx, msg.Relayer, msg.Payee, msg.ChannelId)
+
+	k.Logger(ctx).Info("registering payee address for relayer", "relayer", msg.Relayer, "payee", msg.Payee, "channel", msg.ChannelId)
+
+	EmitRegisterPayeeEvent(ctx, msg.Relayer, msg.Payee, msg.ChannelId)
+
+	return &types.MsgRegisterPayeeResponse{}, nil
+}
+
 // PayPacketFee defines a rpc handler method for MsgPayPacketFee
 // PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to relay the packet with the next sequence
 func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee) (*types.MsgPayPacketFeeResponse, error) {
@@ -71,3 +107,116 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// TopUpPacketFee defines a rpc handler method for MsgTopUpPacketFee
+// TopUpPacketFee adds to the packet fee escrowed by the signer for a known packet, instead of escrowing an additional packet fee
+func (k Keeper) TopUpPacketFee(goCtx context.Context, msg *types.MsgTopUpPacketFee) (*types.MsgTopUpPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if err := k.TopUpEscrowedPacketFee(ctx, msg.PacketId, msg.Fee, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTopUpPacketFeeResponse{}, nil
+}
+
+// WithdrawPacketFee defines a rpc handler method for MsgWithdrawPacketFee
+// WithdrawPacketFee refunds the packet fees escrowed by the refund address for a packet which is no longer in flight,
+// or which has not been relayed within the refund grace period since the fees were escrowed
+func (k Keeper) WithdrawPacketFee(goCtx context.Context, msg *types.MsgWithdrawPacketFee) (*types.MsgWithdrawPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	refund, err := k.WithdrawEscrowedPacketFees(ctx, msg.PacketId, msg.RefundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("packet fees withdrawn", "refund-address", msg.RefundAddress, "refund", refund, "port-id", msg.PacketId.PortId, "channel-id", msg.PacketId.ChannelId, "sequence", msg.PacketId.Sequence)
+
+	EmitWithdrawPacketFee(ctx, msg.PacketId, msg.RefundAddress, refund)
+
+	return &types.MsgWithdrawPacketFeeResponse{}, nil
+}
+
+// PayClientUpdateBounty defines a rpc handler method for MsgPayClientUpdateBounty
+// PayClientUpdateBounty escrows a bounty which is paid to the relayer of the first successful update of an active client
+// once the target block height or timestamp of the bounty has been reached
+func (k Keeper) PayClientUpdateBounty(goCtx context.Context, msg *types.MsgPayClientUpdateBounty) (*types.MsgPayClientUpdateBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if err := k.EscrowClientUpdateBounty(ctx, msg.ClientId, msg.Bounty); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayClientUpdateBountyResponse{}, nil
+}
+
+// WithdrawClientUpdateBounty defines a rpc handler method for MsgWithdrawClientUpdateBounty
+// WithdrawClientUpdateBounty refunds the client update bounties escrowed by the refund address for a client which is no
+// longer active
+func (k Keeper) WithdrawClientUpdateBounty(goCtx context.Context, msg *types.MsgWithdrawClientUpdateBounty) (*types.MsgWithdrawClientUpdateBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	refund, err := k.WithdrawClientUpdateBounties(ctx, msg.ClientId, msg.RefundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("client update bounties withdrawn", "refund-address", msg.RefundAddress, "refund", refund, "client-id", msg.ClientId)
+
+	EmitWithdrawClientUpdateBounty(ctx, msg.ClientId, msg.RefundAddress, refund)
+
+	return &types.MsgWithdrawClientUpdateBountyResponse{}, nil
+}
+
+// PayHandshakeBounty defines a rpc handler method for MsgPayHandshakeBounty
+// PayHandshakeBounty escrows a bounty which is paid to the relayer of the channel open confirm of a channel in the TRYOPEN state
+func (k Keeper) PayHandshakeBounty(goCtx context.Context, msg *types.MsgPayHandshakeBounty) (*types.MsgPayHandshakeBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if err := k.EscrowHandshakeBounty(ctx, msg.PortId, msg.ChannelId, msg.Bounty); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayHandshakeBountyResponse{}, nil
+}
+
+// UnlockFeeModule defines a rpc handler method for MsgUnlockFeeModule
+// UnlockFeeModule unlocks the fee module once the escrow account holds sufficient funds to cover all escrowed packet fees
+// and bounties, it may only be executed by the fee module authority
+func (k Keeper) UnlockFeeModule(goCtx context.Context, msg *types.MsgUnlockFeeModule) (*types.MsgUnlockFeeModuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	totalFees, err := k.verifyAndUnlockFeeModule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("fee module unlocked", "authority", msg.Authority, "escrowed-fees", totalFees)
+
+	return &types.MsgUnlockFeeModuleResponse{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
@@ -53,6 +54,75 @@ func (suite *KeeperTestSuite) TestRegisterCounterpartyAddress() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRegisterPayee() {
+	var msg *types.MsgRegisterPayee
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"channel does not exist",
+			false,
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+		},
+		{
+			"channel is not fee enabled",
+			false,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"given payee is blocked",
+			false,
+			func() {
+				msg.Payee = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress().String()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.Setup(suite.path)
+
+		msg = types.NewMsgRegisterPayee(
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+			suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+		)
+
+		tc.malleate()
+
+		res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterPayee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			payeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(
+				suite.chainA.GetContext(),
+				suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				suite.path.EndpointA.ChannelID,
+			)
+
+			suite.Require().True(found)
+			suite.Require().Equal(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), payeeAddr)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 func (suite *KeeperTestSuite) TestPayPacketFee() {
 	testCases := []struct {
 		name     string
@@ -151,3 +221,312 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestTopUpPacketFee() {
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"fee module is locked",
+			false,
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.Setup(suite.path) // setup channel
+
+		refundAcc := suite.chainA.SenderAccount.GetAddress()
+		packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+		err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(suite.chainA.GetContext(), packetID, types.NewPacketFee(fee, refundAcc.String(), nil))
+		suite.Require().NoError(err)
+
+		tc.malleate()
+
+		msg := types.NewMsgTopUpPacketFee(packetID, fee, refundAcc.String())
+		_, err = suite.chainA.GetSimApp().IBCFeeKeeper.TopUpPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err) // message committed
+
+			feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+			suite.Require().True(found)
+			suite.Require().Len(feesInEscrow.PacketFees, 1)
+			suite.Require().Equal(fee.Total().Add(fee.Total()...), feesInEscrow.PacketFees[0].Fee.Total())
+		} else {
+			suite.Require().Error(err)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawPacketFee() {
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"fee module is locked",
+			false,
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.Setup(suite.path) // setup channel
+
+		refundAcc := suite.chainA.SenderAccount.GetAddress()
+		packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+		err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(suite.chainA.GetContext(), packetID, types.NewPacketFee(fee, refundAcc.String(), nil))
+		suite.Require().NoError(err)
+
+		tc.malleate()
+
+		msg := types.NewMsgWithdrawPacketFee(packetID, refundAcc.String())
+		_, err = suite.chainA.GetSimApp().IBCFeeKeeper.WithdrawPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err) // message committed
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+		} else {
+			suite.Require().Error(err)
+			suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayClientUpdateBounty() {
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"fee module is locked",
+			false,
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.SetupClients(suite.path) // setup clients
+
+		refundAcc := suite.chainA.SenderAccount.GetAddress()
+		bounty := types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 1, 0)
+
+		tc.malleate()
+
+		msg := types.NewMsgPayClientUpdateBounty(suite.path.EndpointA.ClientID, bounty)
+		_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayClientUpdateBounty(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err) // message committed
+
+			bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), suite.path.EndpointA.ClientID)
+			suite.Require().True(found)
+			suite.Require().Equal([]types.ClientUpdateBounty{bounty}, bountiesInEscrow.Bounties)
+		} else {
+			suite.Require().Error(err)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawClientUpdateBounty() {
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"fee module is locked",
+			false,
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.SetupClients(suite.path) // setup clients
+
+		refundAcc := suite.chainA.SenderAccount.GetAddress()
+		bounty := types.NewClientUpdateBounty(defaultBounty, refundAcc.String(), 1000000, 0)
+		err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, bounty)
+		suite.Require().NoError(err)
+
+		suite.freezeClient()
+
+		tc.malleate()
+
+		msg := types.NewMsgWithdrawClientUpdateBounty(suite.path.EndpointA.ClientID, refundAcc.String())
+		_, err = suite.chainA.GetSimApp().IBCFeeKeeper.WithdrawClientUpdateBounty(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err) // message committed
+
+			_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), suite.path.EndpointA.ClientID)
+			suite.Require().False(found)
+		} else {
+			suite.Require().Error(err)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayHandshakeBounty() {
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"fee module is locked",
+			false,
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.setupTryOpenChannel()
+
+		refundAcc := suite.chainA.SenderAccount.GetAddress()
+		bounty := types.NewHandshakeBounty(defaultBounty, refundAcc.String())
+
+		tc.malleate()
+
+		msg := types.NewMsgPayHandshakeBounty(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, bounty)
+		_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayHandshakeBounty(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err) // message committed
+
+			bountiesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHandshakeBounties(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal([]types.HandshakeBounty{bounty}, bountiesInEscrow.Bounties)
+		} else {
+			suite.Require().Error(err)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnlockFeeModule() {
+	var (
+		authority string
+		packetID  channeltypes.PacketId
+	)
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"unauthorized authority",
+			false,
+			func() {
+				authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+		},
+		{
+			"fee module is not locked",
+			false,
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.ModuleName))
+				store.Delete(types.KeyLocked())
+			},
+		},
+		{
+			"escrow account balance does not cover the escrowed packet fees",
+			false,
+			func() {
+				// store an additional packet fee which is not held by the escrow account
+				packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), suite.chainA.SenderAccount.GetAddress().String(), nil)
+				packetID.Sequence = 2
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			},
+		},
+		{
+			"escrow account balance does not cover the escrowed bounties",
+			false,
+			func() {
+				// store a bounty which is not held by the escrow account
+				bounty := types.NewClientUpdateBounty(defaultRecvFee, suite.chainA.SenderAccount.GetAddress().String(), 1, 0)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateBounties(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, types.NewClientUpdateBounties([]types.ClientUpdateBounty{bounty}))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.Setup(suite.path) // setup channel
+
+		authority = suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority()
+		packetID = channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+		err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(suite.chainA.GetContext(), packetID, types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil))
+		suite.Require().NoError(err)
+
+		lockFeeModule(suite.chainA)
+
+		tc.malleate()
+
+		msg := types.NewMsgUnlockFeeModule(authority)
+		_, err = suite.chainA.GetSimApp().IBCFeeKeeper.UnlockFeeModule(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err) // message committed
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+		} else {
+			suite.Require().Error(err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+)
+
+// GetRefundGracePeriod retrieves the refund grace period from the paramstore.
+// The default is returned if the param is not set.
+func (k Keeper) GetRefundGracePeriod(ctx sdk.Context) time.Duration {
+	res := types.DefaultRefundGracePeriod
+	k.paramSpace.GetIfExists(ctx, types.KeyRefundGracePeriod, &res)
+	return res
+}
+
+// GetAllowedFeeDenoms retrieves the allowed fee denominations from the paramstore
+func (k Keeper) GetAllowedFeeDenoms(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowedFeeDenoms, &res)
+	return res
+}
+
+// GetMaxFeePerPacket retrieves the maximum total fee per packet from the paramstore
+func (k Keeper) GetMaxFeePerPacket(ctx sdk.Context) sdk.Coins {
+	var res sdk.Coins
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxFeePerPacket, &res)
+	return res
+}
+
+// GetMaxPacketFees retrieves the maximum number of packet fees per packet from the paramstore.
+// The default is returned if the param is not set.
+func (k Keeper) GetMaxPacketFees(ctx sdk.Context) uint64 {
+	res := uint64(types.DefaultMaxPacketFees)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxPacketFees, &res)
+	return res
+}
+
+// GetParams returns the total set of the fee middleware parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetRefundGracePeriod(ctx), k.GetAllowedFeeDenoms(ctx), k.GetMaxFeePerPacket(ctx), k.GetMaxPacketFees(ctx))
+}
+
+// SetParams sets the total set of the fee middleware parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
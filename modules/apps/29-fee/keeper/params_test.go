@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+)
+
+func (suite *KeeperTestSuite) TestParams() {
+	expParams := types.DefaultParams()
+
+	params := suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+
+	expParams.RefundGracePeriod = time.Hour
+	expParams.AllowedFeeDenoms = []string{sdk.DefaultBondDenom}
+	expParams.MaxFeePerPacket = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	expParams.MaxPacketFees = 10
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+}
+
+func (suite *KeeperTestSuite) TestParamsNotSet() {
+	ctx := suite.chainA.GetContext()
+
+	// remove the params, as on chains upgrading with an existing fee params subspace
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey))
+	for _, key := range [][]byte{types.KeyRefundGracePeriod, types.KeyAllowedFeeDenoms, types.KeyMaxFeePerPacket, types.KeyMaxPacketFees} {
+		store.Delete(append([]byte(types.ModuleName+"/"), key...))
+	}
+
+	subspace := suite.chainA.GetSimApp().GetSubspace(types.ModuleName)
+	suite.Require().False(subspace.Has(ctx, types.KeyRefundGracePeriod))
+
+	suite.Require().NotPanics(func() {
+		params := suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(ctx)
+		suite.Require().Equal(types.DefaultParams(), params)
+	})
+}
@@ -0,0 +1,41 @@
+package fee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+// NewProposalHandler defines the ics29 fee proposal handler
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UnlockFeeModuleProposal:
+			return k.HandleUnlockFeeModuleProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ics29 fee proposal content type: %T", c)
+		}
+	}
+}
+
+// NewClientProposalHandler wraps the provided 02-client proposal handler. The client update bounties of the subject
+// client of an executed ClientUpdateProposal are refunded, as the client is recovered without a MsgUpdateClient.
+// No bounties are refunded while the fee module is locked.
+func NewClientProposalHandler(k keeper.Keeper, clientProposalHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := clientProposalHandler(ctx, content); err != nil {
+			return err
+		}
+
+		if c, ok := content.(*clienttypes.ClientUpdateProposal); ok && !k.IsLocked(ctx) {
+			k.RefundClientUpdateBounties(ctx, c.SubjectClientId)
+		}
+
+		return nil
+	}
+}
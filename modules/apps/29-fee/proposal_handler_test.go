@@ -0,0 +1,118 @@
+package fee_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *FeeTestSuite) TestClientProposalHandlerRefundsClientUpdateBounties() {
+	subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(subjectPath)
+	subject := subjectPath.EndpointA.ClientID
+
+	substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(substitutePath)
+	substitute := substitutePath.EndpointA.ClientID
+
+	err := substitutePath.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bounty := types.NewClientUpdateBounty(fee, refundAcc.String(), 1000000, 0)
+
+	err = suite.chainA.GetSimApp().IBCFeeKeeper.EscrowClientUpdateBounty(suite.chainA.GetContext(), subject, bounty)
+	suite.Require().NoError(err)
+
+	// freeze the subject client, so that it may be recovered with the substitute client
+	tmClientState := suite.chainA.GetClientState(subject).(*ibctmtypes.ClientState)
+	tmClientState.AllowUpdateAfterMisbehaviour = true
+	tmClientState.FrozenHeight = tmClientState.LatestHeight
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+
+	tmClientState = suite.chainA.GetClientState(substitute).(*ibctmtypes.ClientState)
+	tmClientState.AllowUpdateAfterMisbehaviour = true
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, tmClientState)
+
+	handler := suite.chainA.GetSimApp().GovKeeper.Router().GetRoute(clienttypes.RouterKey)
+	originalBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+	// the bounties remain in escrow if the proposal fails
+	err = handler(suite.chainA.GetContext(), clienttypes.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, subject, ibctesting.InvalidID))
+	suite.Require().Error(err)
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), subject)
+	suite.Require().True(found)
+
+	// the bounties are refunded once the subject client is recovered
+	err = handler(suite.chainA.GetContext(), clienttypes.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, subject, substitute))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(originalBal.Add(fee[0]), suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom))
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateBounties(suite.chainA.GetContext(), subject)
+	suite.Require().False(found)
+}
+
+func (suite *FeeTestSuite) TestUnlockFeeModuleProposal() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"fee module is not locked",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.ModuleName))
+				store.Delete(types.KeyLocked())
+			},
+			false,
+		},
+		{
+			"escrow account balance does not cover the escrowed packet fees",
+			func() {
+				// store an additional packet fee which is not held by the escrow account
+				packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 2)
+				packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), suite.chainA.SenderAccount.GetAddress().String(), nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), suite.chainA.SenderAccount.GetAddress().String(), nil)
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowPacketFee(suite.chainA.GetContext(), packetID, packetFee)
+			suite.Require().NoError(err)
+
+			lockFeeModule(suite.chainA)
+
+			tc.malleate()
+
+			handler := suite.chainA.GetSimApp().GovKeeper.Router().GetRoute(types.RouterKey)
+			err = handler(suite.chainA.GetContext(), types.NewUnlockFeeModuleProposal(ibctesting.Title, ibctesting.Description))
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
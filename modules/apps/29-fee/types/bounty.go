@@ -0,0 +1,117 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewClientUpdateBounty creates and returns a new ClientUpdateBounty struct including the bounty, refund address and
+// the target block height and timestamp from which the bounty may be claimed
+func NewClientUpdateBounty(fee sdk.Coins, refundAddr string, targetHeight, targetTimestamp uint64) ClientUpdateBounty {
+	return ClientUpdateBounty{
+		Fee:             fee,
+		RefundAddress:   refundAddr,
+		TargetHeight:    targetHeight,
+		TargetTimestamp: targetTimestamp,
+	}
+}
+
+// Validate performs basic stateless validation of the associated ClientUpdateBounty
+func (b ClientUpdateBounty) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.RefundAddress); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert RefundAddress into sdk.AccAddress")
+	}
+
+	if err := validateBountyFee(b.Fee); err != nil {
+		return err
+	}
+
+	if b.TargetHeight == 0 && b.TargetTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidBounty, "target height and target timestamp cannot both be 0")
+	}
+
+	return nil
+}
+
+// IsClaimable returns true if the block height or block time of the provided context has reached the
+// target height or target timestamp of the bounty
+func (b ClientUpdateBounty) IsClaimable(ctx sdk.Context) bool {
+	if b.TargetHeight != 0 && uint64(ctx.BlockHeight()) >= b.TargetHeight {
+		return true
+	}
+
+	if b.TargetTimestamp != 0 && uint64(ctx.BlockTime().UnixNano()) >= b.TargetTimestamp {
+		return true
+	}
+
+	return false
+}
+
+// IsReachedByConsensusTimestamp returns true if the provided consensus state timestamp has reached the target
+// timestamp of the bounty
+func (b ClientUpdateBounty) IsReachedByConsensusTimestamp(timestamp uint64) bool {
+	return b.TargetTimestamp != 0 && timestamp >= b.TargetTimestamp
+}
+
+// NewClientUpdateBounties creates and returns a new ClientUpdateBounties struct including a list of type ClientUpdateBounty
+func NewClientUpdateBounties(bounties []ClientUpdateBounty) ClientUpdateBounties {
+	return ClientUpdateBounties{
+		Bounties: bounties,
+	}
+}
+
+// NewIdentifiedClientUpdateBounties creates and returns a new IdentifiedClientUpdateBounties struct containing a
+// client ID and client update bounties
+func NewIdentifiedClientUpdateBounties(clientID string, bounties []ClientUpdateBounty) IdentifiedClientUpdateBounties {
+	return IdentifiedClientUpdateBounties{
+		ClientId: clientID,
+		Bounties: bounties,
+	}
+}
+
+// NewHandshakeBounty creates and returns a new HandshakeBounty struct including the bounty and refund address
+func NewHandshakeBounty(fee sdk.Coins, refundAddr string) HandshakeBounty {
+	return HandshakeBounty{
+		Fee:           fee,
+		RefundAddress: refundAddr,
+	}
+}
+
+// Validate performs basic stateless validation of the associated HandshakeBounty
+func (b HandshakeBounty) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.RefundAddress); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert RefundAddress into sdk.AccAddress")
+	}
+
+	return validateBountyFee(b.Fee)
+}
+
+// NewHandshakeBounties creates and returns a new HandshakeBounties struct including a list of type HandshakeBounty
+func NewHandshakeBounties(bounties []HandshakeBounty) HandshakeBounties {
+	return HandshakeBounties{
+		Bounties: bounties,
+	}
+}
+
+// NewIdentifiedHandshakeBounties creates and returns a new IdentifiedHandshakeBounties struct containing a port ID,
+// channel ID and handshake bounties
+func NewIdentifiedHandshakeBounties(portID, channelID string, bounties []HandshakeBounty) IdentifiedHandshakeBounties {
+	return IdentifiedHandshakeBounties{
+		PortId:    portID,
+		ChannelId: channelID,
+		Bounties:  bounties,
+	}
+}
+
+// validateBountyFee asserts that the bounty fee is valid and not empty or zero
+func validateBountyFee(fee sdk.Coins) error {
+	if !fee.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bounty: %s", fee)
+	}
+
+	if fee.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "bounty is zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+)
+
+func TestClientUpdateBountyValidation(t *testing.T) {
+	var (
+		bounty types.ClientUpdateBounty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with target timestamp",
+			func() {
+				bounty.TargetHeight = 0
+				bounty.TargetTimestamp = 1
+			},
+			true,
+		},
+		{
+			"target height and target timestamp are both 0",
+			func() {
+				bounty.TargetHeight = 0
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				bounty.RefundAddress = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"invalid bounty",
+			func() {
+				bounty.Fee = invalidFee
+			},
+			false,
+		},
+		{
+			"empty bounty",
+			func() {
+				bounty.Fee = sdk.Coins{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		bounty = types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 1, 0)
+
+		tc.malleate() // malleate mutates test data
+
+		err := bounty.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestClientUpdateBountyIsClaimable(t *testing.T) {
+	blockTime := time.Unix(1000, 0)
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Height: 10, Time: blockTime})
+
+	testCases := []struct {
+		name      string
+		bounty    types.ClientUpdateBounty
+		claimable bool
+	}{
+		{"target height reached", types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 10, 0), true},
+		{"target height not reached", types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 11, 0), false},
+		{"target timestamp reached", types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 0, uint64(blockTime.UnixNano())), true},
+		{"target timestamp not reached", types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 0, uint64(blockTime.UnixNano())+1), false},
+		{"only target timestamp reached", types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 11, uint64(blockTime.UnixNano())), true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.claimable, tc.bounty.IsClaimable(ctx), tc.name)
+	}
+}
+
+func TestClientUpdateBountyIsReachedByConsensusTimestamp(t *testing.T) {
+	timestamp := uint64(time.Unix(1000, 0).UnixNano())
+
+	testCases := []struct {
+		name    string
+		bounty  types.ClientUpdateBounty
+		reached bool
+	}{
+		{"target timestamp reached", types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 0, timestamp), true},
+		{"target timestamp not reached", types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 0, timestamp+1), false},
+		{"target timestamp disabled", types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 10, 0), false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.reached, tc.bounty.IsReachedByConsensusTimestamp(timestamp), tc.name)
+	}
+}
+
+func TestHandshakeBountyValidation(t *testing.T) {
+	var (
+		bounty types.HandshakeBounty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid refund address",
+			func() {
+				bounty.RefundAddress = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"invalid bounty",
+			func() {
+				bounty.Fee = invalidFee
+			},
+			false,
+		},
+		{
+			"empty bounty",
+			func() {
+				bounty.Fee = sdk.Coins{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		bounty = types.NewHandshakeBounty(defaultRecvFee, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := bounty.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -5,14 +5,22 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/ibc 29-fee interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterCounterpartyAddress{}, "cosmos-sdk/MsgRegisterCounterpartyAddress", nil)
+	cdc.RegisterConcrete(&MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee", nil)
 	cdc.RegisterConcrete(&MsgPayPacketFee{}, "cosmos-sdk/MsgPayPacketFee", nil)
 	cdc.RegisterConcrete(&MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync", nil)
+	cdc.RegisterConcrete(&MsgTopUpPacketFee{}, "cosmos-sdk/MsgTopUpPacketFee", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPacketFee{}, "cosmos-sdk/MsgWithdrawPacketFee", nil)
+	cdc.RegisterConcrete(&MsgPayClientUpdateBounty{}, "cosmos-sdk/MsgPayClientUpdateBounty", nil)
+	cdc.RegisterConcrete(&MsgWithdrawClientUpdateBounty{}, "cosmos-sdk/MsgWithdrawClientUpdateBounty", nil)
+	cdc.RegisterConcrete(&MsgPayHandshakeBounty{}, "cosmos-sdk/MsgPayHandshakeBounty", nil)
+	cdc.RegisterConcrete(&MsgUnlockFeeModule{}, "cosmos-sdk/MsgUnlockFeeModule", nil)
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -21,9 +29,17 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgRegisterCounterpartyAddress{},
+		&MsgRegisterPayee{},
 		&MsgPayPacketFee{},
 		&MsgPayPacketFeeAsync{},
+		&MsgTopUpPacketFee{},
+		&MsgWithdrawPacketFee{},
+		&MsgPayClientUpdateBounty{},
+		&MsgWithdrawClientUpdateBounty{},
+		&MsgPayHandshakeBounty{},
+		&MsgUnlockFeeModule{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil), &UnlockFeeModuleProposal{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -10,10 +10,17 @@ var (
 	ErrRefundAccNotFound             = sdkerrors.Register(ModuleName, 3, "no account found for given refund address")
 	ErrBalanceNotFound               = sdkerrors.Register(ModuleName, 4, "balance not found for given account address")
 	ErrFeeNotFound                   = sdkerrors.Register(ModuleName, 5, "there is no fee escrowed for the given packetID")
-	ErrRelayersNotNil                = sdkerrors.Register(ModuleName, 6, "relayers must be nil. This feature is not supported")
 	ErrCounterpartyAddressEmpty      = sdkerrors.Register(ModuleName, 7, "counterparty address must not be empty")
 	ErrForwardRelayerAddressNotFound = sdkerrors.Register(ModuleName, 8, "forward relayer address not found")
 	ErrFeeNotEnabled                 = sdkerrors.Register(ModuleName, 9, "fee module is not enabled for this channel. If this error occurs after channel setup, fee module may not be enabled")
 	ErrRelayerNotFoundForAsyncAck    = sdkerrors.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = sdkerrors.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
+	ErrInvalidRelayers               = sdkerrors.Register(ModuleName, 12, "invalid list of permitted relayers")
+	ErrFeeNotWithdrawable            = sdkerrors.Register(ModuleName, 13, "packet fee cannot be withdrawn while the packet is in flight and the refund grace period has not elapsed")
+	ErrInvalidBounty                 = sdkerrors.Register(ModuleName, 14, "invalid bounty")
+	ErrFeeDenomNotAllowed            = sdkerrors.Register(ModuleName, 15, "fee denomination is not allowed")
+	ErrFeeLimitExceeded              = sdkerrors.Register(ModuleName, 16, "packet fee limit exceeded")
+	ErrFeeModuleNotLocked            = sdkerrors.Register(ModuleName, 17, "the fee module is not locked")
+	ErrInsufficientEscrowBalance     = sdkerrors.Register(ModuleName, 18, "escrow account balance does not cover the escrowed fees")
+	ErrBountyNotWithdrawable         = sdkerrors.Register(ModuleName, 19, "client update bounty cannot be withdrawn while the client is active")
 )
@@ -2,9 +2,21 @@ package types
 
 // 29-fee events
 const (
-	EventTypeIncentivizedPacket = "incentivized_ibc_packet"
+	EventTypeIncentivizedPacket         = "incentivized_ibc_packet"
+	EventTypeRegisterPayee              = "register_payee"
+	EventTypeWithdrawPacketFee          = "withdraw_packet_fee"
+	EventTypeIncentivizedClientUpdate   = "incentivized_client_update"
+	EventTypeWithdrawClientUpdateBounty = "withdraw_client_update_bounty"
+	EventTypeIncentivizedHandshake      = "incentivized_channel_handshake"
 
-	AttributeKeyRecvFee    = "recv_fee"
-	AttributeKeyAckFee     = "ack_fee"
-	AttributeKeyTimeoutFee = "timeout_fee"
+	AttributeKeyRecvFee         = "recv_fee"
+	AttributeKeyAckFee          = "ack_fee"
+	AttributeKeyTimeoutFee      = "timeout_fee"
+	AttributeKeyRelayer         = "relayer"
+	AttributeKeyPayee           = "payee"
+	AttributeKeyRefund          = "refund"
+	AttributeKeyRefundAddr      = "refund_address"
+	AttributeKeyBounty          = "bounty"
+	AttributeKeyTargetHeight    = "target_height"
+	AttributeKeyTargetTimestamp = "target_timestamp"
 )
@@ -25,9 +25,8 @@ func (p PacketFee) Validate() error {
 		return sdkerrors.Wrap(err, "failed to convert RefundAddress into sdk.AccAddress")
 	}
 
-	// enforce relayer is nil
-	if p.Relayers != nil {
-		return ErrRelayersNotNil
+	if err := ValidateRelayers(p.Relayers); err != nil {
+		return err
 	}
 
 	if err := p.Fee.Validate(); err != nil {
@@ -37,6 +36,25 @@ func (p PacketFee) Validate() error {
 	return nil
 }
 
+// ValidateRelayers performs basic stateless validation of an optional list of permitted relayers.
+// The relayer addresses may be addresses on either chain, thus only empty and duplicate addresses are rejected.
+func ValidateRelayers(relayers []string) error {
+	seen := make(map[string]bool)
+	for _, relayer := range relayers {
+		if strings.TrimSpace(relayer) == "" {
+			return sdkerrors.Wrap(ErrInvalidRelayers, "relayer address cannot be empty")
+		}
+
+		if seen[relayer] {
+			return sdkerrors.Wrapf(ErrInvalidRelayers, "duplicate relayer address %s", relayer)
+		}
+
+		seen[relayer] = true
+	}
+
+	return nil
+}
+
 // NewPacketFees creates and returns a new PacketFees struct including a list of type PacketFee
 func NewPacketFees(packetFees []PacketFee) PacketFees {
 	return PacketFees{
@@ -10,15 +10,19 @@ import (
 	types1 "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -98,6 +102,8 @@ type PacketFee struct {
 	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
 	// optional list of relayers permitted to receive fees
 	Relayers []string `protobuf:"bytes,3,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// the block time at which the fee was escrowed in nanoseconds since the unix epoch, set by the fee module
+	EscrowTimestamp uint64 `protobuf:"varint,4,opt,name=escrow_timestamp,json=escrowTimestamp,proto3" json:"escrow_timestamp,omitempty" yaml:"escrow_timestamp"`
 }
 
 func (m *PacketFee) Reset()         { *m = PacketFee{} }
@@ -154,6 +160,13 @@ func (m *PacketFee) GetRelayers() []string {
 	return nil
 }
 
+func (m *PacketFee) GetEscrowTimestamp() uint64 {
+	if m != nil {
+		return m.EscrowTimestamp
+	}
+	return 0
+}
+
 // PacketFees contains a list of type PacketFee
 type PacketFees struct {
 	// list of packet fees
@@ -255,348 +268,2219 @@ func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
-	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
-	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
-	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+// Params defines the set of ICS29 fee middleware parameters
+type Params struct {
+	// the period after which the refund address of an escrowed packet fee may withdraw it if the packet has not been
+	// relayed
+	RefundGracePeriod time.Duration `protobuf:"bytes,1,opt,name=refund_grace_period,json=refundGracePeriod,proto3,stdduration" json:"refund_grace_period" yaml:"refund_grace_period"`
+	// the denominations in which fees and bounties may be escrowed, all denominations are allowed when empty
+	AllowedFeeDenoms []string `protobuf:"bytes,2,rep,name=allowed_fee_denoms,json=allowedFeeDenoms,proto3" json:"allowed_fee_denoms,omitempty" yaml:"allowed_fee_denoms"`
+	// the maximum total fee which may be escrowed for a single packet, denominations which are not listed are not limited
+	MaxFeePerPacket github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_fee_per_packet,json=maxFeePerPacket,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee_per_packet" yaml:"max_fee_per_packet"`
+	// the maximum number of packet fees which may be escrowed for a single packet, unlimited when set to 0
+	MaxPacketFees uint64 `protobuf:"varint,4,opt,name=max_packet_fees,json=maxPacketFees,proto3" json:"max_packet_fees,omitempty" yaml:"max_packet_fees"`
 }
 
-func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
-
-var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0x73, 0x09, 0x6a, 0x1b, 0x47, 0x14, 0x74, 0x2a, 0x22, 0x8d, 0xe0, 0x52, 0x3c, 0x65,
-	0x89, 0xad, 0xa4, 0x30, 0xc0, 0x04, 0x57, 0x29, 0x52, 0x27, 0xd0, 0x89, 0x89, 0x25, 0xf2, 0xd9,
-	0x2f, 0xa9, 0x95, 0xdc, 0xf9, 0x74, 0xbe, 0x44, 0xca, 0xca, 0x27, 0xe0, 0x1b, 0xb0, 0xf3, 0x49,
-	0xba, 0x20, 0x75, 0x64, 0x0a, 0x28, 0xf9, 0x06, 0xdd, 0x91, 0x90, 0x7d, 0x4e, 0x94, 0x82, 0xaa,
-	0xaa, 0x12, 0xd3, 0xf9, 0xd9, 0xef, 0xef, 0xdf, 0xb3, 0xdf, 0xff, 0x8c, 0x5e, 0xc8, 0x98, 0x53,
-	0x96, 0x65, 0x53, 0xc9, 0x59, 0x21, 0x55, 0xaa, 0xe9, 0x08, 0x80, 0xce, 0x7b, 0xe6, 0x43, 0xb2,
-	0x5c, 0x15, 0xca, 0x7f, 0x2a, 0x63, 0x4e, 0x76, 0x53, 0x88, 0x59, 0x9b, 0xf7, 0x5a, 0x01, 0x57,
-	0x3a, 0x51, 0x9a, 0xc6, 0x4c, 0x1b, 0x49, 0x0c, 0x05, 0xeb, 0x51, 0xae, 0x64, 0x5a, 0x0a, 0x5b,
-	0x47, 0x63, 0x35, 0x56, 0x76, 0x48, 0xcd, 0xc8, 0xcd, 0x5a, 0x22, 0x57, 0x39, 0x50, 0x7e, 0xc1,
-	0xd2, 0x14, 0xa6, 0x86, 0xe6, 0x86, 0x65, 0x0a, 0xfe, 0x5d, 0x45, 0xb5, 0x01, 0x80, 0xbf, 0x40,
-	0x07, 0x39, 0xf0, 0xf9, 0x70, 0x04, 0xd0, 0xf4, 0x4e, 0x6a, 0x9d, 0x46, 0xff, 0x98, 0x94, 0x4c,
-	0x62, 0x98, 0xc4, 0x31, 0xc9, 0x99, 0x92, 0x69, 0x78, 0x76, 0xb9, 0x6c, 0x57, 0xae, 0x97, 0xed,
-	0x47, 0x0b, 0x96, 0x4c, 0xdf, 0xe0, 0x8d, 0x10, 0x7f, 0xfb, 0xd9, 0xee, 0x8c, 0x65, 0x71, 0x31,
-	0x8b, 0x09, 0x57, 0x09, 0x75, 0x35, 0x97, 0x9f, 0xae, 0x16, 0x13, 0x5a, 0x2c, 0x32, 0xd0, 0x76,
-	0x0f, 0x1d, 0xed, 0x1b, 0x99, 0x41, 0xcf, 0xd1, 0x3e, 0xe3, 0x13, 0x4b, 0xae, 0xde, 0x45, 0x0e,
-	0x1d, 0xf9, 0xb0, 0x24, 0x3b, 0xdd, 0xfd, 0xc0, 0x7b, 0x8c, 0x4f, 0x0c, 0xf7, 0xb3, 0x87, 0x1a,
-	0x85, 0x4c, 0x40, 0xcd, 0x0a, 0x0b, 0xaf, 0xdd, 0x05, 0x1f, 0x38, 0xb8, 0x5f, 0xc2, 0x77, 0xb4,
-	0xf7, 0x2b, 0x00, 0x39, 0xe5, 0x00, 0x00, 0x7f, 0xf5, 0x50, 0xfd, 0x03, 0xe3, 0x13, 0x30, 0x91,
-	0xff, 0x12, 0xd5, 0xca, 0x06, 0x78, 0x9d, 0x46, 0xff, 0x19, 0xb9, 0xc5, 0x0d, 0x64, 0x00, 0x10,
-	0x3e, 0x30, 0xc5, 0x44, 0x26, 0xdd, 0x7f, 0x8b, 0x0e, 0x73, 0x18, 0xcd, 0x52, 0x31, 0x64, 0x42,
-	0xe4, 0xa0, 0x75, 0xb3, 0x7a, 0xe2, 0x75, 0xea, 0xe1, 0xf1, 0xf5, 0xb2, 0xfd, 0x64, 0xd3, 0xa2,
-	0xdd, 0x75, 0x1c, 0x3d, 0x2c, 0x27, 0xde, 0x95, 0xb1, 0xdf, 0x32, 0xdd, 0x9f, 0xb2, 0x05, 0xe4,
-	0xda, 0x5e, 0x43, 0x3d, 0xda, 0xc6, 0x38, 0x41, 0x68, 0x5b, 0xa0, 0xf6, 0x87, 0xa8, 0x91, 0xd9,
-	0xc8, 0x1c, 0x5b, 0x3b, 0xab, 0xe0, 0x5b, 0x2b, 0xdd, 0x2a, 0xc3, 0xd6, 0xcd, 0xcb, 0xdb, 0xd9,
-	0x04, 0x47, 0x28, 0xdb, 0x02, 0xf0, 0x77, 0x0f, 0x1d, 0x9d, 0x0b, 0x48, 0x0b, 0x39, 0x92, 0x20,
-	0x76, 0xc8, 0x1f, 0x51, 0xdd, 0x89, 0xa4, 0x70, 0x37, 0xf4, 0xdc, 0x72, 0x8d, 0xc1, 0xc9, 0xc6,
-	0xd5, 0x5b, 0xe6, 0xb9, 0x08, 0x9b, 0x0e, 0xf9, 0xf8, 0x06, 0x52, 0x0a, 0x1c, 0x1d, 0x64, 0x2e,
-	0xe7, 0xef, 0xf3, 0x54, 0xff, 0xf7, 0x79, 0xc2, 0xf7, 0x97, 0xab, 0xc0, 0xbb, 0x5a, 0x05, 0xde,
-	0xaf, 0x55, 0xe0, 0x7d, 0x59, 0x07, 0x95, 0xab, 0x75, 0x50, 0xf9, 0xb1, 0x0e, 0x2a, 0x9f, 0x5e,
-	0xfd, 0x6b, 0x18, 0x19, 0xf3, 0xee, 0x58, 0xd1, 0xf9, 0x29, 0x4d, 0x94, 0x98, 0x4d, 0x41, 0x9b,
-	0xf7, 0x42, 0xd3, 0xfe, 0xeb, 0xae, 0x79, 0x2a, 0xac, 0x87, 0xe2, 0x3d, 0xfb, 0xe3, 0x9e, 0xfe,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x6c, 0xa2, 0x41, 0x4f, 0x04, 0x00, 0x00,
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{4}
 }
-
-func (m *Fee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Fee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TimeoutFee) > 0 {
-		for iNdEx := len(m.TimeoutFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TimeoutFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRefundGracePeriod() time.Duration {
+	if m != nil {
+		return m.RefundGracePeriod
 	}
-	if len(m.AckFee) > 0 {
-		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return 0
+}
+
+func (m *Params) GetAllowedFeeDenoms() []string {
+	if m != nil {
+		return m.AllowedFeeDenoms
 	}
-	if len(m.RecvFee) > 0 {
-		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func (m *Params) GetMaxFeePerPacket() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxFeePerPacket
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PacketFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMaxPacketFees() uint64 {
+	if m != nil {
+		return m.MaxPacketFees
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PacketFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClientUpdateBounty defines a bounty escrowed to incentivize the update of a light client. The bounty is paid to
+// the relayer of the first successful client update once the target block height or timestamp has been reached. As an
+// update with an already stored header succeeds without updating the client, the update must increase the latest
+// height of the client, unless the timestamp of the latest consensus state of the client has reached the target timestamp
+type ClientUpdateBounty struct {
+	// the bounty paid to the relayer of the client update
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// the refund address of the bounty
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
+	// the block height of this chain from which the bounty may be claimed, disabled when set to 0
+	TargetHeight uint64 `protobuf:"varint,3,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty" yaml:"target_height"`
+	// the block time of this chain in nanoseconds since the unix epoch from which the bounty may be claimed, disabled
+	// when set to 0
+	TargetTimestamp uint64 `protobuf:"varint,4,opt,name=target_timestamp,json=targetTimestamp,proto3" json:"target_timestamp,omitempty" yaml:"target_timestamp"`
 }
 
-func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintFee(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.RefundAddress) > 0 {
-		i -= len(m.RefundAddress)
-		copy(dAtA[i:], m.RefundAddress)
-		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+func (m *ClientUpdateBounty) Reset()         { *m = ClientUpdateBounty{} }
+func (m *ClientUpdateBounty) String() string { return proto.CompactTextString(m) }
+func (*ClientUpdateBounty) ProtoMessage()    {}
+func (*ClientUpdateBounty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{5}
+}
+func (m *ClientUpdateBounty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientUpdateBounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientUpdateBounty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintFee(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ClientUpdateBounty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientUpdateBounty.Merge(m, src)
+}
+func (m *ClientUpdateBounty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientUpdateBounty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientUpdateBounty.DiscardUnknown(m)
 }
 
-func (m *PacketFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClientUpdateBounty proto.InternalMessageInfo
+
+func (m *ClientUpdateBounty) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PacketFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClientUpdateBounty) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
 }
 
-func (m *PacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketFees) > 0 {
-		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ClientUpdateBounty) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *IdentifiedPacketFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClientUpdateBounty) GetTargetTimestamp() uint64 {
+	if m != nil {
+		return m.TargetTimestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *IdentifiedPacketFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClientUpdateBounties contains a list of type ClientUpdateBounty
+type ClientUpdateBounties struct {
+	// list of client update bounties
+	Bounties []ClientUpdateBounty `protobuf:"bytes,1,rep,name=bounties,proto3" json:"bounties"`
 }
 
-func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketFees) > 0 {
-		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+func (m *ClientUpdateBounties) Reset()         { *m = ClientUpdateBounties{} }
+func (m *ClientUpdateBounties) String() string { return proto.CompactTextString(m) }
+func (*ClientUpdateBounties) ProtoMessage()    {}
+func (*ClientUpdateBounties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{6}
+}
+func (m *ClientUpdateBounties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientUpdateBounties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientUpdateBounties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintFee(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ClientUpdateBounties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientUpdateBounties.Merge(m, src)
+}
+func (m *ClientUpdateBounties) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientUpdateBounties) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientUpdateBounties.DiscardUnknown(m)
 }
 
-func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFee(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ClientUpdateBounties proto.InternalMessageInfo
+
+func (m *ClientUpdateBounties) GetBounties() []ClientUpdateBounty {
+	if m != nil {
+		return m.Bounties
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Fee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RecvFee) > 0 {
-		for _, e := range m.RecvFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+
+// IdentifiedClientUpdateBounties contains a list of type ClientUpdateBounty and the associated client identifier
+type IdentifiedClientUpdateBounties struct {
+	// unique client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// list of client update bounties
+	Bounties []ClientUpdateBounty `protobuf:"bytes,2,rep,name=bounties,proto3" json:"bounties"`
+}
+
+func (m *IdentifiedClientUpdateBounties) Reset()         { *m = IdentifiedClientUpdateBounties{} }
+func (m *IdentifiedClientUpdateBounties) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedClientUpdateBounties) ProtoMessage()    {}
+func (*IdentifiedClientUpdateBounties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{7}
+}
+func (m *IdentifiedClientUpdateBounties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedClientUpdateBounties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedClientUpdateBounties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AckFee) > 0 {
-		for _, e := range m.AckFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+}
+func (m *IdentifiedClientUpdateBounties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedClientUpdateBounties.Merge(m, src)
+}
+func (m *IdentifiedClientUpdateBounties) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedClientUpdateBounties) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedClientUpdateBounties.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedClientUpdateBounties proto.InternalMessageInfo
+
+func (m *IdentifiedClientUpdateBounties) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IdentifiedClientUpdateBounties) GetBounties() []ClientUpdateBounty {
+	if m != nil {
+		return m.Bounties
+	}
+	return nil
+}
+
+// HandshakeBounty defines a bounty escrowed to incentivize the completion of a channel handshake. The bounty is paid
+// to the relayer of the channel open confirm
+type HandshakeBounty struct {
+	// the bounty paid to the relayer of the channel open confirm
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// the refund address of the bounty
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
+}
+
+func (m *HandshakeBounty) Reset()         { *m = HandshakeBounty{} }
+func (m *HandshakeBounty) String() string { return proto.CompactTextString(m) }
+func (*HandshakeBounty) ProtoMessage()    {}
+func (*HandshakeBounty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{8}
+}
+func (m *HandshakeBounty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandshakeBounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandshakeBounty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TimeoutFee) > 0 {
-		for _, e := range m.TimeoutFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+}
+func (m *HandshakeBounty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeBounty.Merge(m, src)
+}
+func (m *HandshakeBounty) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandshakeBounty) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeBounty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandshakeBounty proto.InternalMessageInfo
+
+func (m *HandshakeBounty) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *HandshakeBounty) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+// HandshakeBounties contains a list of type HandshakeBounty
+type HandshakeBounties struct {
+	// list of handshake bounties
+	Bounties []HandshakeBounty `protobuf:"bytes,1,rep,name=bounties,proto3" json:"bounties"`
+}
+
+func (m *HandshakeBounties) Reset()         { *m = HandshakeBounties{} }
+func (m *HandshakeBounties) String() string { return proto.CompactTextString(m) }
+func (*HandshakeBounties) ProtoMessage()    {}
+func (*HandshakeBounties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{9}
+}
+func (m *HandshakeBounties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandshakeBounties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandshakeBounties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *HandshakeBounties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeBounties.Merge(m, src)
+}
+func (m *HandshakeBounties) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandshakeBounties) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeBounties.DiscardUnknown(m)
 }
 
-func (m *PacketFee) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_HandshakeBounties proto.InternalMessageInfo
+
+func (m *HandshakeBounties) GetBounties() []HandshakeBounty {
+	if m != nil {
+		return m.Bounties
 	}
-	var l int
-	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovFee(uint64(l))
-	l = len(m.RefundAddress)
-	if l > 0 {
-		n += 1 + l + sovFee(uint64(l))
+	return nil
+}
+
+// IdentifiedHandshakeBounties contains a list of type HandshakeBounty and the associated port and channel identifiers
+type IdentifiedHandshakeBounties struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// list of handshake bounties
+	Bounties []HandshakeBounty `protobuf:"bytes,3,rep,name=bounties,proto3" json:"bounties"`
+}
+
+func (m *IdentifiedHandshakeBounties) Reset()         { *m = IdentifiedHandshakeBounties{} }
+func (m *IdentifiedHandshakeBounties) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedHandshakeBounties) ProtoMessage()    {}
+func (*IdentifiedHandshakeBounties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{10}
+}
+func (m *IdentifiedHandshakeBounties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedHandshakeBounties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedHandshakeBounties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovFee(uint64(l))
+}
+func (m *IdentifiedHandshakeBounties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedHandshakeBounties.Merge(m, src)
+}
+func (m *IdentifiedHandshakeBounties) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedHandshakeBounties) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedHandshakeBounties.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedHandshakeBounties proto.InternalMessageInfo
+
+func (m *IdentifiedHandshakeBounties) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IdentifiedHandshakeBounties) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IdentifiedHandshakeBounties) GetBounties() []HandshakeBounty {
+	if m != nil {
+		return m.Bounties
+	}
+	return nil
+}
+
+// UnlockFeeModuleProposal is a governance proposal which unlocks a locked fee module, once the escrow account
+// holds sufficient funds to cover all escrowed packet fees and bounties.
+type UnlockFeeModuleProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *UnlockFeeModuleProposal) Reset()         { *m = UnlockFeeModuleProposal{} }
+func (m *UnlockFeeModuleProposal) String() string { return proto.CompactTextString(m) }
+func (*UnlockFeeModuleProposal) ProtoMessage()    {}
+func (*UnlockFeeModuleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{11}
+}
+func (m *UnlockFeeModuleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockFeeModuleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockFeeModuleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UnlockFeeModuleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockFeeModuleProposal.Merge(m, src)
+}
+func (m *UnlockFeeModuleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockFeeModuleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockFeeModuleProposal.DiscardUnknown(m)
 }
 
-func (m *PacketFees) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_UnlockFeeModuleProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
+	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
+	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
+	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+	proto.RegisterType((*Params)(nil), "ibc.applications.fee.v1.Params")
+	proto.RegisterType((*ClientUpdateBounty)(nil), "ibc.applications.fee.v1.ClientUpdateBounty")
+	proto.RegisterType((*ClientUpdateBounties)(nil), "ibc.applications.fee.v1.ClientUpdateBounties")
+	proto.RegisterType((*IdentifiedClientUpdateBounties)(nil), "ibc.applications.fee.v1.IdentifiedClientUpdateBounties")
+	proto.RegisterType((*HandshakeBounty)(nil), "ibc.applications.fee.v1.HandshakeBounty")
+	proto.RegisterType((*HandshakeBounties)(nil), "ibc.applications.fee.v1.HandshakeBounties")
+	proto.RegisterType((*IdentifiedHandshakeBounties)(nil), "ibc.applications.fee.v1.IdentifiedHandshakeBounties")
+	proto.RegisterType((*UnlockFeeModuleProposal)(nil), "ibc.applications.fee.v1.UnlockFeeModuleProposal")
+}
+
+func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
+
+var fileDescriptor_cb3319f1af2a53e5 = []byte{
+	// 1026 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x21, 0x8d, 0xc7, 0xa4, 0x4e, 0x06, 0x97, 0x38, 0x2e, 0xf5, 0x86, 0x39, 0x20,
+	0x4b, 0x55, 0x76, 0x49, 0x5a, 0x0e, 0x54, 0x42, 0x82, 0x4d, 0x65, 0x6a, 0x50, 0x44, 0xb4, 0x6a,
+	0x85, 0x84, 0x84, 0x56, 0xe3, 0xdd, 0x67, 0x7b, 0xe5, 0xdd, 0x9d, 0x65, 0x67, 0xed, 0xc6, 0x57,
+	0x4e, 0x1c, 0xb9, 0x20, 0x71, 0x44, 0x88, 0x13, 0x47, 0xce, 0xfc, 0x01, 0x3d, 0x80, 0xd4, 0x23,
+	0x27, 0x17, 0x25, 0x07, 0xee, 0xbe, 0x23, 0xa1, 0xd9, 0x19, 0x7f, 0xc5, 0x84, 0x28, 0x6a, 0x2f,
+	0x9c, 0x76, 0xde, 0xd7, 0xfc, 0xde, 0xef, 0xbd, 0x99, 0xb7, 0x83, 0xde, 0xf6, 0xdb, 0xae, 0x49,
+	0xe3, 0x38, 0xf0, 0x5d, 0x9a, 0xfa, 0x2c, 0xe2, 0x66, 0x07, 0xc0, 0x1c, 0x1e, 0x88, 0x8f, 0x11,
+	0x27, 0x2c, 0x65, 0x78, 0xc7, 0x6f, 0xbb, 0xc6, 0xa2, 0x8b, 0x21, 0x6c, 0xc3, 0x83, 0x5a, 0xdd,
+	0x65, 0x3c, 0x64, 0xdc, 0x6c, 0x53, 0x2e, 0x42, 0xda, 0x90, 0xd2, 0x03, 0xd3, 0x65, 0x7e, 0x24,
+	0x03, 0x6b, 0x95, 0x2e, 0xeb, 0xb2, 0x6c, 0x69, 0x8a, 0x95, 0xd2, 0xd6, 0xbb, 0x8c, 0x75, 0x03,
+	0x30, 0x33, 0xa9, 0x3d, 0xe8, 0x98, 0xde, 0x20, 0xc9, 0xf6, 0x55, 0xf6, 0x2c, 0x23, 0x97, 0x25,
+	0x60, 0xba, 0x3d, 0x1a, 0x45, 0x10, 0x88, 0x6c, 0xd4, 0x52, 0xba, 0x90, 0xbf, 0xf3, 0xa8, 0xd0,
+	0x04, 0xc0, 0x23, 0xb4, 0x91, 0x80, 0x3b, 0x74, 0x3a, 0x00, 0x55, 0x6d, 0xaf, 0xd0, 0x28, 0x1d,
+	0xee, 0x1a, 0x32, 0x27, 0x43, 0xe4, 0x64, 0xa8, 0x9c, 0x8c, 0x23, 0xe6, 0x47, 0xd6, 0xd1, 0xb3,
+	0xb1, 0x9e, 0x9b, 0x8c, 0xf5, 0xf2, 0x88, 0x86, 0xc1, 0x03, 0x32, 0x0d, 0x24, 0x3f, 0xbf, 0xd0,
+	0x1b, 0x5d, 0x3f, 0xed, 0x0d, 0xda, 0x86, 0xcb, 0x42, 0x53, 0x71, 0x92, 0x9f, 0x7d, 0xee, 0xf5,
+	0xcd, 0x74, 0x14, 0x03, 0xcf, 0xf6, 0xe0, 0xf6, 0x0d, 0x11, 0x26, 0xa0, 0x87, 0xe8, 0x06, 0x75,
+	0xfb, 0x19, 0x72, 0xfe, 0x2a, 0x64, 0x4b, 0x21, 0xdf, 0x94, 0xc8, 0x2a, 0xee, 0x7a, 0xc0, 0xeb,
+	0xd4, 0xed, 0x0b, 0xdc, 0xaf, 0x35, 0x54, 0x4a, 0xfd, 0x10, 0xd8, 0x20, 0xcd, 0xc0, 0x0b, 0x57,
+	0x81, 0x37, 0x15, 0x38, 0x96, 0xe0, 0x0b, 0xb1, 0xd7, 0x4b, 0x00, 0xa9, 0xc8, 0x26, 0x00, 0xf9,
+	0x4b, 0x43, 0xc5, 0x13, 0xea, 0xf6, 0x41, 0x48, 0xf8, 0x3e, 0x2a, 0xc8, 0x06, 0x68, 0x8d, 0xd2,
+	0xe1, 0x5b, 0xc6, 0x25, 0xa7, 0xc5, 0x68, 0x02, 0x58, 0x6b, 0x22, 0x19, 0x5b, 0xb8, 0xe3, 0x0f,
+	0xd1, 0xcd, 0x04, 0x3a, 0x83, 0xc8, 0x73, 0xa8, 0xe7, 0x25, 0xc0, 0x79, 0x35, 0xbf, 0xa7, 0x35,
+	0x8a, 0xd6, 0xee, 0x64, 0xac, 0xdf, 0x9a, 0xb6, 0x68, 0xd1, 0x4e, 0xec, 0x4d, 0xa9, 0xf8, 0x48,
+	0xca, 0xb8, 0x26, 0xba, 0x1f, 0xd0, 0x11, 0x24, 0x3c, 0x2b, 0x43, 0xd1, 0x9e, 0xc9, 0xb8, 0x89,
+	0xb6, 0x80, 0xbb, 0x09, 0x7b, 0xea, 0x88, 0xb4, 0x79, 0x4a, 0xc3, 0xb8, 0xba, 0xb6, 0xa7, 0x35,
+	0xd6, 0xac, 0xdb, 0x93, 0xb1, 0xbe, 0x23, 0xf7, 0xbf, 0xe8, 0x41, 0xec, 0xb2, 0x54, 0x3d, 0x9e,
+	0x69, 0x42, 0x84, 0x66, 0x44, 0x39, 0x76, 0x50, 0x29, 0xce, 0x24, 0x51, 0x3e, 0xae, 0x8e, 0x1c,
+	0xb9, 0x94, 0xf1, 0x2c, 0xd2, 0xaa, 0x2d, 0x37, 0x61, 0x61, 0x13, 0x62, 0xa3, 0x78, 0x06, 0x40,
+	0x7e, 0xd7, 0x50, 0xa5, 0xe5, 0x41, 0x94, 0xfa, 0x1d, 0x1f, 0xbc, 0x05, 0xe4, 0xc7, 0xa8, 0xa8,
+	0x82, 0x7c, 0x4f, 0x55, 0xfa, 0x4e, 0x86, 0x2b, 0x2e, 0x8a, 0x31, 0xbd, 0x1d, 0x33, 0xcc, 0x96,
+	0x67, 0x55, 0x15, 0xe4, 0xd6, 0x12, 0xa4, 0xef, 0x11, 0x7b, 0x23, 0x56, 0x3e, 0x17, 0xf9, 0xe4,
+	0x5f, 0x39, 0x9f, 0x9f, 0x0a, 0x68, 0xfd, 0x84, 0x26, 0x34, 0xe4, 0xf8, 0x2b, 0xf4, 0x86, 0xea,
+	0x67, 0x37, 0xa1, 0x2e, 0x38, 0x31, 0x24, 0x3e, 0x9b, 0x72, 0xd9, 0x35, 0xe4, 0x50, 0x30, 0xa6,
+	0x43, 0xc1, 0x78, 0xa8, 0x86, 0x82, 0xf5, 0x8e, 0x82, 0xaa, 0x2d, 0x9d, 0x89, 0xc5, 0x3d, 0xc8,
+	0xf7, 0x2f, 0x74, 0xcd, 0xde, 0x96, 0x96, 0x8f, 0x85, 0xe1, 0x24, 0xd3, 0xe3, 0x4f, 0x11, 0xa6,
+	0x41, 0xc0, 0x9e, 0x82, 0x27, 0x52, 0x73, 0x3c, 0x88, 0x58, 0x28, 0x59, 0x16, 0xad, 0x3b, 0x93,
+	0xb1, 0xbe, 0xab, 0xee, 0xe3, 0x8a, 0x0f, 0xb1, 0xb7, 0x94, 0xb2, 0x09, 0xf0, 0x30, 0x53, 0xe1,
+	0xef, 0x34, 0x84, 0x43, 0x7a, 0x9a, 0x79, 0xc5, 0x90, 0x38, 0x92, 0xe5, 0xd5, 0xf7, 0xef, 0x58,
+	0xe5, 0xaf, 0xc0, 0x56, 0xb7, 0xb8, 0xde, 0x35, 0x2c, 0x87, 0xf4, 0xb4, 0x09, 0x82, 0xa1, 0xec,
+	0x06, 0xb6, 0x90, 0x50, 0x39, 0x8b, 0x7d, 0x94, 0x07, 0xbd, 0x36, 0x19, 0xeb, 0x6f, 0xce, 0x41,
+	0x97, 0x7a, 0xb4, 0x19, 0xd2, 0xd3, 0xf9, 0xe9, 0x22, 0xbf, 0xe6, 0x11, 0x3e, 0x0a, 0x7c, 0x88,
+	0xd2, 0x27, 0xb1, 0x47, 0x53, 0xb0, 0xd8, 0x20, 0x4a, 0x47, 0xf8, 0xcb, 0xe9, 0xc5, 0xbe, 0x82,
+	0xe2, 0xbb, 0x82, 0xe2, 0xb5, 0x58, 0xbc, 0xa2, 0x09, 0xf0, 0x01, 0xda, 0x4c, 0x69, 0xd2, 0x85,
+	0xd4, 0xe9, 0x81, 0xdf, 0xed, 0x89, 0x6e, 0x08, 0xe6, 0xd5, 0xc9, 0x58, 0xaf, 0xc8, 0x0d, 0x96,
+	0xcc, 0xc4, 0x7e, 0x5d, 0xca, 0x8f, 0x32, 0x51, 0x0c, 0x09, 0x65, 0xff, 0x8f, 0x21, 0x71, 0xd1,
+	0x83, 0xd8, 0x65, 0xa9, 0x9a, 0x0f, 0x09, 0x40, 0x95, 0x95, 0xea, 0xf9, 0xc0, 0xf1, 0x31, 0xda,
+	0x68, 0xab, 0xb5, 0x2a, 0xe2, 0xdd, 0x4b, 0xef, 0xd6, 0x6a, 0xf9, 0xd5, 0xb0, 0x9c, 0x6d, 0x41,
+	0x7e, 0xd4, 0x50, 0x7d, 0x3e, 0x1c, 0xfe, 0x15, 0xf1, 0x00, 0x15, 0xdd, 0x4c, 0x3f, 0x1d, 0x13,
+	0x45, 0xab, 0x32, 0x9f, 0x01, 0x33, 0x13, 0xb1, 0x37, 0xe4, 0xba, 0xe5, 0x2d, 0x25, 0x99, 0x7f,
+	0xf9, 0x24, 0x7f, 0xd1, 0x50, 0xf9, 0x11, 0x8d, 0x3c, 0xde, 0xa3, 0xfd, 0xff, 0xcb, 0x39, 0x22,
+	0x0e, 0xda, 0x5e, 0xce, 0x59, 0xd4, 0xf2, 0x93, 0x95, 0xee, 0x35, 0x2e, 0x2d, 0xcc, 0x05, 0xc6,
+	0x2b, 0x55, 0xf9, 0x4d, 0x43, 0xb7, 0xe7, 0xad, 0x5b, 0xc5, 0xba, 0x8b, 0x6e, 0xc4, 0x2c, 0x59,
+	0xe8, 0x1a, 0x9e, 0x3f, 0x17, 0x94, 0x81, 0xd8, 0xeb, 0x62, 0xd5, 0xf2, 0xf0, 0x7d, 0x84, 0xd4,
+	0xc0, 0x17, 0xfe, 0x92, 0xeb, 0xad, 0xc9, 0x58, 0xdf, 0x56, 0x5d, 0x9e, 0xd9, 0x88, 0x5d, 0x54,
+	0x42, 0xcb, 0x5b, 0xa2, 0x53, 0x78, 0x49, 0x3a, 0x9f, 0xa3, 0x9d, 0x27, 0x51, 0xc0, 0xb2, 0x17,
+	0xc9, 0x31, 0xf3, 0x06, 0x01, 0x9c, 0x24, 0x2c, 0x66, 0x9c, 0x06, 0xb8, 0x82, 0x5e, 0x4b, 0xfd,
+	0x34, 0x90, 0xcf, 0x81, 0xa2, 0x2d, 0x05, 0xbc, 0x87, 0x4a, 0x9e, 0xf8, 0xb5, 0xfa, 0xb1, 0xc0,
+	0x91, 0x39, 0xdb, 0x8b, 0xaa, 0x07, 0x6b, 0xdf, 0xfc, 0xa0, 0xe7, 0xac, 0xcf, 0x9e, 0x9d, 0xd5,
+	0xb5, 0xe7, 0x67, 0x75, 0xed, 0xcf, 0xb3, 0xba, 0xf6, 0xed, 0x79, 0x3d, 0xf7, 0xfc, 0xbc, 0x9e,
+	0xfb, 0xe3, 0xbc, 0x9e, 0xfb, 0xe2, 0xbd, 0xd5, 0x33, 0xe1, 0xb7, 0xdd, 0xfd, 0x2e, 0x33, 0x87,
+	0xf7, 0xcc, 0x30, 0xcb, 0x81, 0x8b, 0x77, 0x2c, 0x37, 0x0f, 0xdf, 0xdf, 0x17, 0x4f, 0xd8, 0xec,
+	0x98, 0xb4, 0xd7, 0xb3, 0x1f, 0xca, 0xbd, 0x7f, 0x06, 0x00, 0x4a, 0x3c, 0xb0, 0x89, 0xe7, 0x0a,
+	0x00, 0x00,
+}
+
+func (m *Fee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Fee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PacketFees) > 0 {
-		for _, e := range m.PacketFees {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+	if len(m.TimeoutFee) > 0 {
+		for iNdEx := len(m.TimeoutFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.AckFee) > 0 {
+		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecvFee) > 0 {
+		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *IdentifiedPacketFees) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovFee(uint64(l))
-	if len(m.PacketFees) > 0 {
-		for _, e := range m.PacketFees {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EscrowTimestamp != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.EscrowTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintFee(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedPacketFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedPacketFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPacketFees != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxPacketFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MaxFeePerPacket) > 0 {
+		for iNdEx := len(m.MaxFeePerPacket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFeePerPacket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedFeeDenoms) > 0 {
+		for iNdEx := len(m.AllowedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedFeeDenoms[iNdEx])
+			i = encodeVarintFee(dAtA, i, uint64(len(m.AllowedFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RefundGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RefundGracePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFee(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientUpdateBounty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientUpdateBounty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientUpdateBounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetTimestamp != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.TargetTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientUpdateBounties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientUpdateBounties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientUpdateBounties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for iNdEx := len(m.Bounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedClientUpdateBounties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedClientUpdateBounties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedClientUpdateBounties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for iNdEx := len(m.Bounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandshakeBounty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeBounty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeBounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandshakeBounties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeBounties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeBounties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for iNdEx := len(m.Bounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedHandshakeBounties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedHandshakeBounties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedHandshakeBounties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for iNdEx := len(m.Bounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockFeeModuleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockFeeModuleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockFeeModuleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if m.EscrowTimestamp != 0 {
+		n += 1 + sovFee(uint64(m.EscrowTimestamp))
+	}
+	return n
+}
+
+func (m *PacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedPacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RefundGracePeriod)
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.AllowedFeeDenoms) > 0 {
+		for _, s := range m.AllowedFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.MaxFeePerPacket) > 0 {
+		for _, e := range m.MaxFeePerPacket {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if m.MaxPacketFees != 0 {
+		n += 1 + sovFee(uint64(m.MaxPacketFees))
+	}
+	return n
+}
+
+func (m *ClientUpdateBounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.TargetHeight != 0 {
+		n += 1 + sovFee(uint64(m.TargetHeight))
+	}
+	if m.TargetTimestamp != 0 {
+		n += 1 + sovFee(uint64(m.TargetTimestamp))
+	}
+	return n
+}
+
+func (m *ClientUpdateBounties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for _, e := range m.Bounties {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedClientUpdateBounties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Bounties) > 0 {
+		for _, e := range m.Bounties {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HandshakeBounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func (m *HandshakeBounties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for _, e := range m.Bounties {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedHandshakeBounties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Bounties) > 0 {
+		for _, e := range m.Bounties {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlockFeeModuleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowTimestamp", wireType)
+			}
+			m.EscrowTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RefundGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFeeDenoms = append(m.AllowedFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFeePerPacket = append(m.MaxFeePerPacket, types.Coin{})
+			if err := m.MaxFeePerPacket[len(m.MaxFeePerPacket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketFees", wireType)
+			}
+			m.MaxPacketFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientUpdateBounty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientUpdateBounty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientUpdateBounty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTimestamp", wireType)
+			}
+			m.TargetTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovFee(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFee(x uint64) (n int) {
-	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Fee) Unmarshal(dAtA []byte) error {
+func (m *ClientUpdateBounties) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -619,15 +2503,15 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientUpdateBounties: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientUpdateBounties: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -654,16 +2538,66 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvFee = append(m.RecvFee, types.Coin{})
-			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bounties = append(m.Bounties, ClientUpdateBounty{})
+			if err := m.Bounties[len(m.Bounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedClientUpdateBounties) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedClientUpdateBounties: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedClientUpdateBounties: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -673,29 +2607,27 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckFee = append(m.AckFee, types.Coin{})
-			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -722,8 +2654,8 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
-			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bounties = append(m.Bounties, ClientUpdateBounty{})
+			if err := m.Bounties[len(m.Bounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -748,7 +2680,7 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketFee) Unmarshal(dAtA []byte) error {
+func (m *HandshakeBounty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -771,10 +2703,10 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandshakeBounty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandshakeBounty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -806,7 +2738,8 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -842,11 +2775,61 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			}
 			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandshakeBounties) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandshakeBounties: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandshakeBounties: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -856,23 +2839,25 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.Bounties = append(m.Bounties, HandshakeBounty{})
+			if err := m.Bounties[len(m.Bounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -895,7 +2880,7 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketFees) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedHandshakeBounties) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -918,15 +2903,79 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedHandshakeBounties: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedHandshakeBounties: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -953,8 +3002,8 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bounties = append(m.Bounties, HandshakeBounty{})
+			if err := m.Bounties[len(m.Bounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -979,7 +3028,7 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+func (m *UnlockFeeModuleProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1002,17 +3051,17 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockFeeModuleProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockFeeModuleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -1022,30 +3071,29 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -1055,25 +3103,23 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
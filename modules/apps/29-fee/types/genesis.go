@@ -10,22 +10,30 @@ import (
 )
 
 // NewGenesisState creates a 29-fee GenesisState instance.
-func NewGenesisState(identifiedFees []IdentifiedPacketFees, feeEnabledChannels []FeeEnabledChannel, registeredRelayers []RegisteredRelayerAddress, forwardRelayers []ForwardRelayerAddress) *GenesisState {
+func NewGenesisState(identifiedFees []IdentifiedPacketFees, feeEnabledChannels []FeeEnabledChannel, registeredRelayers []RegisteredRelayerAddress, forwardRelayers []ForwardRelayerAddress, registeredPayees []RegisteredPayee, params Params, clientUpdateBounties []IdentifiedClientUpdateBounties, handshakeBounties []IdentifiedHandshakeBounties) *GenesisState {
 	return &GenesisState{
-		IdentifiedFees:     identifiedFees,
-		FeeEnabledChannels: feeEnabledChannels,
-		RegisteredRelayers: registeredRelayers,
-		ForwardRelayers:    forwardRelayers,
+		IdentifiedFees:       identifiedFees,
+		FeeEnabledChannels:   feeEnabledChannels,
+		RegisteredRelayers:   registeredRelayers,
+		ForwardRelayers:      forwardRelayers,
+		RegisteredPayees:     registeredPayees,
+		Params:               params,
+		ClientUpdateBounties: clientUpdateBounties,
+		HandshakeBounties:    handshakeBounties,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		IdentifiedFees:     []IdentifiedPacketFees{},
-		ForwardRelayers:    []ForwardRelayerAddress{},
-		FeeEnabledChannels: []FeeEnabledChannel{},
-		RegisteredRelayers: []RegisteredRelayerAddress{},
+		IdentifiedFees:       []IdentifiedPacketFees{},
+		ForwardRelayers:      []ForwardRelayerAddress{},
+		FeeEnabledChannels:   []FeeEnabledChannel{},
+		RegisteredRelayers:   []RegisteredRelayerAddress{},
+		RegisteredPayees:     []RegisteredPayee{},
+		Params:               DefaultParams(),
+		ClientUpdateBounties: []IdentifiedClientUpdateBounties{},
+		HandshakeBounties:    []IdentifiedHandshakeBounties{},
 	}
 }
 
@@ -77,5 +85,54 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Validate RegisteredPayees
+	for _, registeredPayee := range gs.RegisteredPayees {
+		if err := host.ChannelIdentifierValidator(registeredPayee.ChannelId); err != nil {
+			return sdkerrors.Wrap(err, "invalid channel ID")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(registeredPayee.Relayer); err != nil {
+			return sdkerrors.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(registeredPayee.Payee); err != nil {
+			return sdkerrors.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+		}
+	}
+
+	// Validate ClientUpdateBounties
+	for _, identifiedBounties := range gs.ClientUpdateBounties {
+		if err := host.ClientIdentifierValidator(identifiedBounties.ClientId); err != nil {
+			return sdkerrors.Wrap(err, "invalid client ID")
+		}
+
+		for _, bounty := range identifiedBounties.Bounties {
+			if err := bounty.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Validate HandshakeBounties
+	for _, identifiedBounties := range gs.HandshakeBounties {
+		if err := host.PortIdentifierValidator(identifiedBounties.PortId); err != nil {
+			return sdkerrors.Wrap(err, "invalid port ID")
+		}
+
+		if err := host.ChannelIdentifierValidator(identifiedBounties.ChannelId); err != nil {
+			return sdkerrors.Wrap(err, "invalid channel ID")
+		}
+
+		for _, bounty := range identifiedBounties.Bounties {
+			if err := bounty.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
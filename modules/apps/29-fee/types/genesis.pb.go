@@ -34,6 +34,14 @@ type GenesisState struct {
 	RegisteredRelayers []RegisteredRelayerAddress `protobuf:"bytes,3,rep,name=registered_relayers,json=registeredRelayers,proto3" json:"registered_relayers" yaml:"registered_relayers"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,4,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers" yaml:"forward_relayers"`
+	// list of registered payees
+	RegisteredPayees []RegisteredPayee `protobuf:"bytes,5,rep,name=registered_payees,json=registeredPayees,proto3" json:"registered_payees" yaml:"registered_payees"`
+	// the fee middleware parameters
+	Params Params `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+	// list of identified client update bounties
+	ClientUpdateBounties []IdentifiedClientUpdateBounties `protobuf:"bytes,7,rep,name=client_update_bounties,json=clientUpdateBounties,proto3" json:"client_update_bounties" yaml:"client_update_bounties"`
+	// list of identified channel handshake bounties
+	HandshakeBounties []IdentifiedHandshakeBounties `protobuf:"bytes,8,rep,name=handshake_bounties,json=handshakeBounties,proto3" json:"handshake_bounties" yaml:"handshake_bounties"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +105,34 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetRegisteredPayees() []RegisteredPayee {
+	if m != nil {
+		return m.RegisteredPayees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetClientUpdateBounties() []IdentifiedClientUpdateBounties {
+	if m != nil {
+		return m.ClientUpdateBounties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHandshakeBounties() []IdentifiedHandshakeBounties {
+	if m != nil {
+		return m.HandshakeBounties
+	}
+	return nil
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -216,6 +252,70 @@ func (m *RegisteredRelayerAddress) GetChannelId() string {
 	return ""
 }
 
+// RegisteredPayee contains the relayer address and payee address for a specific channel
+type RegisteredPayee struct {
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the relayer address
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// the payee address
+	Payee string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+}
+
+func (m *RegisteredPayee) Reset()         { *m = RegisteredPayee{} }
+func (m *RegisteredPayee) String() string { return proto.CompactTextString(m) }
+func (*RegisteredPayee) ProtoMessage()    {}
+func (*RegisteredPayee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{3}
+}
+func (m *RegisteredPayee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredPayee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredPayee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredPayee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredPayee.Merge(m, src)
+}
+func (m *RegisteredPayee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredPayee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredPayee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredPayee proto.InternalMessageInfo
+
+func (m *RegisteredPayee) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RegisteredPayee) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RegisteredPayee) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
 // ForwardRelayerAddress contains the forward relayer address and PacketId used for async acknowledgements
 type ForwardRelayerAddress struct {
 	// the forward relayer address
@@ -228,7 +328,7 @@ func (m *ForwardRelayerAddress) Reset()         { *m = ForwardRelayerAddress{} }
 func (m *ForwardRelayerAddress) String() string { return proto.CompactTextString(m) }
 func (*ForwardRelayerAddress) ProtoMessage()    {}
 func (*ForwardRelayerAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7191992e856dff95, []int{3}
+	return fileDescriptor_7191992e856dff95, []int{4}
 }
 func (m *ForwardRelayerAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,6 +375,7 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.fee.v1.GenesisState")
 	proto.RegisterType((*FeeEnabledChannel)(nil), "ibc.applications.fee.v1.FeeEnabledChannel")
 	proto.RegisterType((*RegisteredRelayerAddress)(nil), "ibc.applications.fee.v1.RegisteredRelayerAddress")
+	proto.RegisterType((*RegisteredPayee)(nil), "ibc.applications.fee.v1.RegisteredPayee")
 	proto.RegisterType((*ForwardRelayerAddress)(nil), "ibc.applications.fee.v1.ForwardRelayerAddress")
 }
 
@@ -283,44 +384,55 @@ func init() {
 }
 
 var fileDescriptor_7191992e856dff95 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd4, 0x30,
-	0x14, 0xdc, 0xb4, 0x55, 0x4b, 0x5d, 0xd4, 0x0f, 0xb7, 0xa5, 0x51, 0x11, 0x49, 0x31, 0x42, 0xaa,
-	0x40, 0x4d, 0xb4, 0x2d, 0x1c, 0xe0, 0xc6, 0x22, 0x8a, 0xf6, 0x04, 0x32, 0x9c, 0xb8, 0x44, 0xf9,
-	0x78, 0x49, 0x2d, 0xb2, 0x71, 0x64, 0xbb, 0x41, 0xcb, 0x8d, 0x0b, 0x1c, 0xe1, 0x17, 0x71, 0xee,
-	0xb1, 0x47, 0x4e, 0x2b, 0xd4, 0xfe, 0x83, 0xfe, 0x02, 0x94, 0x38, 0xe9, 0x6e, 0x97, 0x0d, 0xe2,
-	0xf6, 0x62, 0xcf, 0xbc, 0x19, 0x8f, 0xf3, 0x8c, 0x1e, 0xb2, 0x20, 0x74, 0xfd, 0x3c, 0x4f, 0x59,
-	0xe8, 0x2b, 0xc6, 0x33, 0xe9, 0xc6, 0x00, 0x6e, 0xd1, 0x75, 0x13, 0xc8, 0x40, 0x32, 0xe9, 0xe4,
-	0x82, 0x2b, 0x8e, 0x77, 0x58, 0x10, 0x3a, 0x93, 0x30, 0x27, 0x06, 0x70, 0x8a, 0xee, 0xee, 0x56,
-	0xc2, 0x13, 0x5e, 0x61, 0xdc, 0xb2, 0xd2, 0xf0, 0xdd, 0xfb, 0x6d, 0x5d, 0x4b, 0xd6, 0x04, 0x24,
-	0xe4, 0x02, 0xdc, 0xf0, 0xc4, 0xcf, 0x32, 0x48, 0xcb, 0xed, 0xba, 0xd4, 0x10, 0xf2, 0x7d, 0x01,
-	0xdd, 0x7e, 0xad, 0x6d, 0xbc, 0x53, 0xbe, 0x02, 0x5c, 0xa0, 0x35, 0x16, 0x41, 0xa6, 0x58, 0xcc,
-	0x20, 0xf2, 0x62, 0x00, 0x69, 0x1a, 0x7b, 0xf3, 0xfb, 0x2b, 0x87, 0x07, 0x4e, 0x8b, 0x3f, 0xa7,
-	0x7f, 0x8d, 0x7f, 0xeb, 0x87, 0x1f, 0x41, 0x1d, 0x03, 0xc8, 0x9e, 0x75, 0x36, 0xb2, 0x3b, 0x57,
-	0x23, 0xfb, 0xce, 0xd0, 0x1f, 0xa4, 0xcf, 0xc9, 0x54, 0x4f, 0x42, 0x57, 0xc7, 0x2b, 0x25, 0x1e,
-	0x7f, 0x31, 0xd0, 0x56, 0x0c, 0xe0, 0x41, 0xe6, 0x07, 0x29, 0x44, 0x5e, 0x6d, 0x53, 0x9a, 0x73,
-	0x95, 0xfa, 0xa3, 0x56, 0xf5, 0x63, 0x80, 0x57, 0x9a, 0xf3, 0x52, 0x53, 0x7a, 0x0f, 0x6a, 0xe9,
-	0xbb, 0x5a, 0x7a, 0x56, 0x57, 0x42, 0x71, 0x3c, 0xcd, 0x93, 0xf8, 0xab, 0x81, 0x36, 0x05, 0x24,
-	0x4c, 0x2a, 0x10, 0x10, 0x79, 0x02, 0x52, 0x7f, 0x08, 0x42, 0x9a, 0xf3, 0x95, 0x85, 0x6e, 0xab,
-	0x05, 0x7a, 0xcd, 0xa1, 0x9a, 0xf2, 0x22, 0x8a, 0x04, 0x48, 0xd9, 0x23, 0xb5, 0x93, 0x5d, 0xed,
-	0x64, 0x46, 0x6f, 0x42, 0xb1, 0x98, 0x66, 0x4b, 0xfc, 0x19, 0xad, 0xc7, 0x5c, 0x7c, 0xf2, 0xc5,
-	0x84, 0x89, 0x85, 0xca, 0x84, 0xd3, 0x9e, 0x83, 0x26, 0x4c, 0x39, 0xb0, 0x6b, 0x07, 0x3b, 0x75,
-	0x16, 0x53, 0x5d, 0x09, 0x5d, 0x8b, 0x6f, 0xf0, 0x24, 0x29, 0xd0, 0xc6, 0x5f, 0x91, 0xe2, 0xc7,
-	0x68, 0x29, 0xe7, 0x42, 0x79, 0x2c, 0x32, 0x8d, 0x3d, 0x63, 0x7f, 0xb9, 0x87, 0xaf, 0x46, 0xf6,
-	0xaa, 0xee, 0x59, 0x6f, 0x10, 0xba, 0x58, 0x56, 0xfd, 0x08, 0x3f, 0x41, 0xa8, 0xce, 0xb9, 0xc4,
-	0xcf, 0x55, 0xf8, 0xed, 0xab, 0x91, 0xbd, 0xa1, 0xf1, 0xe3, 0x3d, 0x42, 0x97, 0xeb, 0x8f, 0x7e,
-	0x44, 0x7e, 0x1a, 0xc8, 0x6c, 0x0b, 0x12, 0x9b, 0x68, 0xc9, 0xd7, 0xa5, 0xd6, 0xa7, 0xcd, 0x27,
-	0xa6, 0x68, 0x2b, 0xe4, 0xa7, 0x99, 0x02, 0x91, 0xfb, 0x42, 0x0d, 0xbd, 0x06, 0xa6, 0x65, 0xed,
-	0xf1, 0x6f, 0x30, 0x0b, 0x45, 0xe8, 0xe6, 0xe4, 0x72, 0xa3, 0x76, 0xf3, 0x00, 0xf3, 0xff, 0x79,
-	0x80, 0x6f, 0x06, 0xda, 0x9e, 0x79, 0x09, 0xff, 0x70, 0xff, 0x1e, 0x2d, 0xe7, 0xd5, 0xcc, 0x34,
-	0x49, 0xad, 0x1c, 0xde, 0xab, 0x6e, 0xb8, 0x9c, 0x5a, 0xa7, 0x19, 0xd5, 0xa2, 0xeb, 0xe8, 0xc9,
-	0xea, 0x47, 0x3d, 0xb3, 0xbe, 0xd0, 0xf5, 0x3a, 0xfc, 0x86, 0x4d, 0xe8, 0xad, 0xbc, 0xc1, 0xbc,
-	0x39, 0xbb, 0xb0, 0x8c, 0xf3, 0x0b, 0xcb, 0xf8, 0x7d, 0x61, 0x19, 0x3f, 0x2e, 0xad, 0xce, 0xf9,
-	0xa5, 0xd5, 0xf9, 0x75, 0x69, 0x75, 0x3e, 0x3c, 0x4d, 0x98, 0x3a, 0x39, 0x0d, 0x9c, 0x90, 0x0f,
-	0xdc, 0x90, 0xcb, 0x01, 0x97, 0x2e, 0x0b, 0xc2, 0x83, 0x84, 0xbb, 0xc5, 0x91, 0x3b, 0xe0, 0xd1,
-	0x69, 0x0a, 0xb2, 0x7c, 0x54, 0xa4, 0x7b, 0xf8, 0xec, 0xa0, 0x7c, 0x4f, 0xd4, 0x30, 0x07, 0x19,
-	0x2c, 0x56, 0x8f, 0xc5, 0xd1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x33, 0x0f, 0x78, 0xca,
-	0x04, 0x00, 0x00,
+	// 753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xbf, 0x4f, 0xfb, 0x46,
+	0x14, 0x8f, 0xbf, 0x7c, 0x49, 0xe0, 0xa8, 0x80, 0x1c, 0x01, 0x5c, 0x2a, 0x92, 0x70, 0x15, 0x52,
+	0xd4, 0x0a, 0x5b, 0x04, 0xaa, 0xaa, 0x95, 0x3a, 0xd4, 0xa8, 0xb4, 0x99, 0x8a, 0xae, 0xed, 0xd2,
+	0xc5, 0xba, 0xd8, 0xcf, 0x89, 0x45, 0x62, 0x5b, 0x3e, 0x27, 0x28, 0xdd, 0xba, 0xb4, 0x6b, 0x87,
+	0xfe, 0x3d, 0x9d, 0x19, 0x19, 0x3b, 0x45, 0x15, 0x0c, 0xdd, 0xf9, 0x0b, 0xaa, 0xfb, 0xe1, 0x10,
+	0x4c, 0x52, 0x65, 0xbb, 0xb3, 0x3f, 0xbf, 0xde, 0xf9, 0x3d, 0x1f, 0x3a, 0x0d, 0xbb, 0x9e, 0xcd,
+	0x92, 0x64, 0x10, 0x7a, 0x2c, 0x0b, 0xe3, 0x88, 0xdb, 0x01, 0x80, 0x3d, 0x3e, 0xb7, 0x7b, 0x10,
+	0x01, 0x0f, 0xb9, 0x95, 0xa4, 0x71, 0x16, 0xe3, 0xc3, 0xb0, 0xeb, 0x59, 0xf3, 0x30, 0x2b, 0x00,
+	0xb0, 0xc6, 0xe7, 0x47, 0xb5, 0x5e, 0xdc, 0x8b, 0x25, 0xc6, 0x16, 0x2b, 0x05, 0x3f, 0x3a, 0x59,
+	0xa6, 0x2a, 0x58, 0x73, 0x10, 0x2f, 0x4e, 0xc1, 0xf6, 0xfa, 0x2c, 0x8a, 0x60, 0x20, 0x5e, 0xeb,
+	0xa5, 0x82, 0x90, 0x7f, 0x2b, 0xe8, 0x83, 0x6f, 0x55, 0x8c, 0x1f, 0x32, 0x96, 0x01, 0x1e, 0xa3,
+	0x9d, 0xd0, 0x87, 0x28, 0x0b, 0x83, 0x10, 0x7c, 0x37, 0x00, 0xe0, 0xa6, 0xd1, 0x5c, 0x6b, 0x6d,
+	0xb5, 0xcf, 0xac, 0x25, 0xf9, 0xac, 0xce, 0x0c, 0x7f, 0xc3, 0xbc, 0x5b, 0xc8, 0xae, 0x01, 0xb8,
+	0x53, 0xbf, 0x9f, 0x36, 0x4a, 0xcf, 0xd3, 0xc6, 0xc1, 0x84, 0x0d, 0x07, 0x5f, 0x92, 0x82, 0x26,
+	0xa1, 0xdb, 0x2f, 0x4f, 0x04, 0x1e, 0xff, 0x6a, 0xa0, 0x5a, 0x00, 0xe0, 0x42, 0xc4, 0xba, 0x03,
+	0xf0, 0x5d, 0x1d, 0x93, 0x9b, 0xef, 0xa4, 0xfb, 0x27, 0x4b, 0xdd, 0xaf, 0x01, 0xbe, 0x51, 0x9c,
+	0x2b, 0x45, 0x71, 0x3e, 0xd6, 0xd6, 0x1f, 0x29, 0xeb, 0x45, 0xaa, 0x84, 0xe2, 0xa0, 0xc8, 0xe3,
+	0xf8, 0x37, 0x03, 0xed, 0xa5, 0xd0, 0x0b, 0x79, 0x06, 0x29, 0xf8, 0x6e, 0x0a, 0x03, 0x36, 0x81,
+	0x94, 0x9b, 0x6b, 0x32, 0xc2, 0xf9, 0xd2, 0x08, 0x74, 0xc6, 0xa1, 0x8a, 0xf2, 0xb5, 0xef, 0xa7,
+	0xc0, 0xb9, 0x43, 0x74, 0x92, 0x23, 0x95, 0x64, 0x81, 0x36, 0xa1, 0x38, 0x2d, 0xb2, 0x39, 0xfe,
+	0x05, 0xed, 0x06, 0x71, 0x7a, 0xc7, 0xd2, 0xb9, 0x10, 0xef, 0x65, 0x08, 0x6b, 0xf9, 0x39, 0x28,
+	0x42, 0x21, 0x41, 0x43, 0x27, 0x38, 0xd4, 0x67, 0x51, 0x50, 0x25, 0x74, 0x27, 0x78, 0xc5, 0xe3,
+	0xf8, 0x0e, 0x55, 0xe7, 0x72, 0x26, 0x6c, 0x22, 0x5a, 0x60, 0x5d, 0x9a, 0xb7, 0x56, 0x38, 0x81,
+	0x1b, 0x41, 0x70, 0x9a, 0xda, 0xd6, 0x7c, 0x53, 0xb8, 0x12, 0x24, 0x74, 0x37, 0x7d, 0x4d, 0xe1,
+	0xf8, 0x2b, 0x54, 0x4e, 0x58, 0xca, 0x86, 0xdc, 0x2c, 0x37, 0x8d, 0xd6, 0x56, 0xbb, 0xb1, 0xd4,
+	0xed, 0x46, 0xc2, 0x9c, 0xf7, 0xc2, 0x84, 0x6a, 0x12, 0xfe, 0xd3, 0x40, 0x07, 0xde, 0x20, 0x84,
+	0x28, 0x73, 0x47, 0x89, 0xcf, 0x32, 0x70, 0xbb, 0xf1, 0x28, 0xca, 0x42, 0xe0, 0x66, 0x45, 0xa6,
+	0xff, 0x7c, 0x85, 0x06, 0xbe, 0x92, 0x02, 0x3f, 0x49, 0xbe, 0xa3, 0xe9, 0xce, 0xa9, 0x2e, 0xe6,
+	0x58, 0x15, 0xb3, 0xd8, 0x84, 0xd0, 0x9a, 0xb7, 0x80, 0x2c, 0x7a, 0x0a, 0xf7, 0x59, 0xe4, 0xf3,
+	0x3e, 0xbb, 0x9d, 0x8b, 0xb4, 0x21, 0x23, 0x5d, 0xae, 0x10, 0xe9, 0xbb, 0x9c, 0x3c, 0xcb, 0x73,
+	0xa2, 0xf3, 0x7c, 0xa8, 0xf2, 0xbc, 0x55, 0x27, 0xb4, 0xda, 0x2f, 0xb2, 0xc8, 0x18, 0x55, 0xdf,
+	0x8c, 0x0a, 0xfe, 0x14, 0x55, 0x92, 0x38, 0xcd, 0xdc, 0xd0, 0x37, 0x8d, 0xa6, 0xd1, 0xda, 0x74,
+	0xf0, 0xf3, 0xb4, 0xb1, 0xad, 0x74, 0xf5, 0x0b, 0x42, 0xcb, 0x62, 0xd5, 0xf1, 0xf1, 0x25, 0x42,
+	0x7a, 0x7e, 0x04, 0xfe, 0x9d, 0xc4, 0xef, 0x3f, 0x4f, 0x1b, 0x55, 0x7d, 0x2e, 0xb3, 0x77, 0x84,
+	0x6e, 0xea, 0x4d, 0xc7, 0x27, 0x7f, 0x19, 0xc8, 0x5c, 0x36, 0x20, 0xd8, 0x44, 0x15, 0xa6, 0x96,
+	0xca, 0x9f, 0xe6, 0x5b, 0x4c, 0x51, 0xcd, 0x13, 0xd1, 0x21, 0x4d, 0x58, 0x9a, 0x4d, 0xdc, 0x1c,
+	0xa6, 0x6c, 0x1b, 0x2f, 0xe3, 0xbd, 0x08, 0x45, 0xe8, 0xde, 0xfc, 0xe3, 0xdc, 0xed, 0x75, 0x01,
+	0x6b, 0x2b, 0x16, 0x70, 0x87, 0x76, 0x0a, 0xed, 0x5d, 0x10, 0x32, 0x56, 0x13, 0x12, 0xc5, 0xea,
+	0xb9, 0x53, 0x55, 0xd0, 0x7c, 0x8b, 0x6b, 0x68, 0x5d, 0xce, 0x85, 0xca, 0x44, 0xd5, 0x86, 0xfc,
+	0x6e, 0xa0, 0xfd, 0x85, 0x53, 0xfd, 0x3f, 0xc7, 0xf6, 0x23, 0xda, 0x4c, 0xe4, 0x4f, 0x38, 0xff,
+	0x44, 0x5b, 0xed, 0x63, 0xd9, 0x64, 0xe2, 0x1a, 0xb0, 0xf2, 0x7f, 0xbf, 0x9c, 0x21, 0x81, 0xea,
+	0xf8, 0x8e, 0xa9, 0xbb, 0x69, 0x57, 0x7f, 0xf5, 0x9c, 0x4d, 0xe8, 0x46, 0x92, 0x63, 0xbe, 0xbf,
+	0x7f, 0xac, 0x1b, 0x0f, 0x8f, 0x75, 0xe3, 0x9f, 0xc7, 0xba, 0xf1, 0xc7, 0x53, 0xbd, 0xf4, 0xf0,
+	0x54, 0x2f, 0xfd, 0xfd, 0x54, 0x2f, 0xfd, 0xfc, 0x59, 0x2f, 0xcc, 0xfa, 0xa3, 0xae, 0xe5, 0xc5,
+	0x43, 0xdb, 0x8b, 0xf9, 0x30, 0xe6, 0x76, 0xd8, 0xf5, 0xce, 0x7a, 0xb1, 0x3d, 0xbe, 0xb0, 0x87,
+	0xb1, 0x3f, 0x1a, 0x00, 0x17, 0xb7, 0x14, 0xb7, 0xdb, 0x5f, 0x9c, 0x89, 0x0b, 0x2a, 0x9b, 0x24,
+	0xc0, 0xbb, 0x65, 0x79, 0xfb, 0x5c, 0xfc, 0x37, 0x00, 0xfc, 0x44, 0x56, 0x1a, 0x1b, 0x07, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -343,6 +455,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HandshakeBounties) > 0 {
+		for iNdEx := len(m.HandshakeBounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandshakeBounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClientUpdateBounties) > 0 {
+		for iNdEx := len(m.ClientUpdateBounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientUpdateBounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.RegisteredPayees) > 0 {
+		for iNdEx := len(m.RegisteredPayees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredPayees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ForwardRelayers) > 0 {
 		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -483,6 +647,50 @@ func (m *RegisteredRelayerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *RegisteredPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ForwardRelayerAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -564,6 +772,26 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RegisteredPayees) > 0 {
+		for _, e := range m.RegisteredPayees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ClientUpdateBounties) > 0 {
+		for _, e := range m.ClientUpdateBounties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HandshakeBounties) > 0 {
+		for _, e := range m.HandshakeBounties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -605,6 +833,27 @@ func (m *RegisteredRelayerAddress) Size() (n int) {
 	return n
 }
 
+func (m *RegisteredPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *ForwardRelayerAddress) Size() (n int) {
 	if m == nil {
 		return 0
@@ -791,6 +1040,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPayees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredPayees = append(m.RegisteredPayees, RegisteredPayee{})
+			if err := m.RegisteredPayees[len(m.RegisteredPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpdateBounties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientUpdateBounties = append(m.ClientUpdateBounties, IdentifiedClientUpdateBounties{})
+			if err := m.ClientUpdateBounties[len(m.ClientUpdateBounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeBounties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandshakeBounties = append(m.HandshakeBounties, IdentifiedHandshakeBounties{})
+			if err := m.HandshakeBounties[len(m.HandshakeBounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1072,6 +1456,152 @@ func (m *RegisteredRelayerAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,11 @@ func TestValidateGenesis(t *testing.T) {
 		sender          string
 		forwardAddr     string
 		counterparty    string
+		payee           string
+		params          types.Params
+		clientBounty    types.ClientUpdateBounty
+		handshakeBounty types.HandshakeBounty
+		clientID        string
 		portID          string
 		channelID       string
 		packetChannelID string
@@ -138,6 +144,62 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid ClientUpdateBounties: invalid client ID",
+			func() {
+				clientID = ""
+			},
+			false,
+		},
+		{
+			"invalid ClientUpdateBounties: no target height or timestamp",
+			func() {
+				clientBounty.TargetHeight = 0
+			},
+			false,
+		},
+		{
+			"invalid HandshakeBounties: invalid refund address",
+			func() {
+				handshakeBounty.RefundAddress = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"invalid HandshakeBounties: zero bounty",
+			func() {
+				handshakeBounty.Fee = sdk.Coins{}
+			},
+			false,
+		},
+		{
+			"invalid Params: negative refund grace period",
+			func() {
+				params.RefundGracePeriod = -time.Hour
+			},
+			false,
+		},
+		{
+			"invalid RegisteredPayee: invalid relayer",
+			func() {
+				sender = ""
+			},
+			false,
+		},
+		{
+			"invalid RegisteredPayee: invalid payee",
+			func() {
+				payee = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid RegisteredPayee: invalid channel",
+			func() {
+				channelID = ""
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -164,6 +226,11 @@ func TestValidateGenesis(t *testing.T) {
 		sender = addr1
 		counterparty = addr2
 		forwardAddr = addr2
+		payee = addr2
+		params = types.DefaultParams()
+		clientID = ibctesting.FirstClientID
+		clientBounty = types.NewClientUpdateBounty(validCoins, addr1, 100, 0)
+		handshakeBounty = types.NewHandshakeBounty(validCoins, addr1)
 
 		tc.malleate()
 
@@ -198,6 +265,20 @@ func TestValidateGenesis(t *testing.T) {
 					PacketId: channeltypes.NewPacketId(portID, packetChannelID, 1),
 				},
 			},
+			RegisteredPayees: []types.RegisteredPayee{
+				{
+					Relayer:   sender,
+					Payee:     payee,
+					ChannelId: channelID,
+				},
+			},
+			Params: params,
+			ClientUpdateBounties: []types.IdentifiedClientUpdateBounties{
+				types.NewIdentifiedClientUpdateBounties(clientID, []types.ClientUpdateBounty{clientBounty}),
+			},
+			HandshakeBounties: []types.IdentifiedHandshakeBounties{
+				types.NewIdentifiedHandshakeBounties(portID, channelID, []types.HandshakeBounty{handshakeBounty}),
+			},
 		}
 
 		err := genState.Validate()
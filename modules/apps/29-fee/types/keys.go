@@ -31,11 +31,20 @@ const (
 	// CounterpartyRelayerAddressKeyPrefix is the key prefix for relayer address mapping
 	CounterpartyRelayerAddressKeyPrefix = "relayerAddress"
 
+	// PayeeKeyPrefix is the key prefix for the fee payee address stored in state
+	PayeeKeyPrefix = "payee"
+
 	// FeesInEscrowPrefix is the key prefix for fee in escrow mapping
 	FeesInEscrowPrefix = "feesInEscrow"
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// ClientUpdateBountiesPrefix is the key prefix for client update bounties stored in state
+	ClientUpdateBountiesPrefix = "clientUpdateBounties"
+
+	// HandshakeBountiesPrefix is the key prefix for channel handshake bounties stored in state
+	HandshakeBountiesPrefix = "handshakeBounties"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -87,6 +96,27 @@ func ParseKeyCounterpartyRelayer(key string) (address string, channelID string,
 	return keySplit[1], keySplit[2], nil
 }
 
+// KeyPayee returns the key for relayer address -> payee address mapping
+func KeyPayee(relayerAddr, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", PayeeKeyPrefix, relayerAddr, channelID))
+}
+
+// ParseKeyPayee returns the registered relayer address and channelID used to store the fee payee address
+func ParseKeyPayee(key string) (relayerAddr, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != PayeeKeyPrefix {
+		return "", "", sdkerrors.Wrapf(sdkerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", PayeeKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
 // KeyForwardRelayerAddress returns the key for packetID -> forwardAddress mapping
 func KeyForwardRelayerAddress(packetID channeltypes.PacketId) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%d", ForwardRelayerPrefix, packetID.PortId, packetID.ChannelId, packetID.Sequence))
@@ -137,3 +167,45 @@ func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
 func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", FeesInEscrowPrefix, portID, channelID))
 }
+
+// KeyClientUpdateBounties returns the key for the client update bounties of the given client
+func KeyClientUpdateBounties(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ClientUpdateBountiesPrefix, clientID))
+}
+
+// ParseKeyClientUpdateBounties parses the key used to store client update bounties and returns the client ID
+func ParseKeyClientUpdateBounties(key string) (clientID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 2 {
+		return "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 2, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != ClientUpdateBountiesPrefix {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", ClientUpdateBountiesPrefix, keySplit[0])
+	}
+
+	return keySplit[1], nil
+}
+
+// KeyHandshakeBounties returns the key for the handshake bounties of the given port and channel identifiers
+func KeyHandshakeBounties(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", HandshakeBountiesPrefix, portID, channelID))
+}
+
+// ParseKeyHandshakeBounties parses the key used to store handshake bounties and returns the port and channel identifiers
+func ParseKeyHandshakeBounties(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != HandshakeBountiesPrefix {
+		return "", "", sdkerrors.Wrapf(sdkerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", HandshakeBountiesPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
@@ -25,6 +25,11 @@ func TestKeyCounterpartyRelayer(t *testing.T) {
 	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s", types.CounterpartyRelayerAddressKeyPrefix, relayerAddress, channelID))
 }
 
+func TestKeyPayee(t *testing.T) {
+	key := types.KeyPayee("relayer-address", ibctesting.FirstChannelID)
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s", types.PayeeKeyPrefix, "relayer-address", ibctesting.FirstChannelID))
+}
+
 func TestKeyFeesInEscrow(t *testing.T) {
 	key := types.KeyFeesInEscrow(validPacketID)
 	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%d", types.FeesInEscrowPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID, 1))
@@ -174,3 +179,117 @@ func TestParseKeyCounterpartyRelayer(t *testing.T) {
 		}
 	}
 }
+
+func TestParseKeyPayee(t *testing.T) {
+	var (
+		relayerAddress = "relayer_address"
+	)
+
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyPayee(relayerAddress, ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			"payee/relayer_address/transfer/channel-0",
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			string(types.KeyCounterpartyRelayer(relayerAddress, ibctesting.FirstChannelID)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		address, channelID, err := types.ParseKeyPayee(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, relayerAddress, address)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestParseKeyClientUpdateBounties(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyClientUpdateBounties(ibctesting.FirstClientID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyHandshakeBounties(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%s", types.FeesInEscrowPrefix, ibctesting.FirstClientID),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		clientID, err := types.ParseKeyClientUpdateBounties(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.FirstClientID, clientID)
+		} else {
+			require.Error(t, err)
+			require.Empty(t, clientID)
+		}
+	}
+}
+
+func TestParseKeyHandshakeBounties(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyHandshakeBounties(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyClientUpdateBounties(ibctesting.FirstClientID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			string(types.KeyFeeEnabled(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		portID, channelID, err := types.ParseKeyHandshakeBounties(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.MockFeePort, portID)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err)
+			require.Empty(t, portID)
+			require.Empty(t, channelID)
+		}
+	}
+}
@@ -14,6 +14,14 @@ import (
 const (
 	TypeMsgPayPacketFee      = "payPacketFee"
 	TypeMsgPayPacketFeeAsync = "payPacketFeeAsync"
+	TypeMsgTopUpPacketFee    = "topUpPacketFee"
+	TypeMsgWithdrawPacketFee = "withdrawPacketFee"
+
+	TypeMsgPayClientUpdateBounty      = "payClientUpdateBounty"
+	TypeMsgWithdrawClientUpdateBounty = "withdrawClientUpdateBounty"
+	TypeMsgPayHandshakeBounty         = "payHandshakeBounty"
+
+	TypeMsgUnlockFeeModule = "unlockFeeModule"
 )
 
 // NewMsgRegisterCounterpartyAddress creates a new instance of MsgRegisterCounterpartyAddress
@@ -53,6 +61,48 @@ func (msg MsgRegisterCounterpartyAddress) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+// NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
+func NewMsgRegisterPayee(portID, channelID, relayerAddr, payeeAddr string) *MsgRegisterPayee {
+	return &MsgRegisterPayee{
+		PortId:    portID,
+		ChannelId: channelID,
+		Relayer:   relayerAddr,
+		Payee:     payeeAddr,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterPayee fields
+func (msg MsgRegisterPayee) ValidateBasic() error {
+	// validate portId
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	// validate channelId
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from relayer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Payee); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from payee address")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterPayee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 // NewMsgPayPacketFee creates a new instance of MsgPayPacketFee
 func NewMsgPayPacketFee(fee Fee, sourcePortId, sourceChannelId, signer string, relayers []string) *MsgPayPacketFee {
 	return &MsgPayPacketFee{
@@ -81,9 +131,8 @@ func (msg MsgPayPacketFee) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
 	}
 
-	// enforce relayer is nil
-	if msg.Relayers != nil {
-		return ErrRelayersNotNil
+	if err := ValidateRelayers(msg.Relayers); err != nil {
+		return err
 	}
 
 	if err := msg.Fee.Validate(); err != nil {
@@ -162,3 +211,282 @@ func (msg MsgPayPacketFeeAsync) Type() string {
 func (msg MsgPayPacketFeeAsync) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgTopUpPacketFee creates a new instance of MsgTopUpPacketFee
+func NewMsgTopUpPacketFee(packetID channeltypes.PacketId, fee Fee, signer string) *MsgTopUpPacketFee {
+	return &MsgTopUpPacketFee{
+		PacketId: packetID,
+		Fee:      fee,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgTopUpPacketFee fields
+func (msg MsgTopUpPacketFee) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	// signer check
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	if err := msg.Fee.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTopUpPacketFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgTopUpPacketFee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgTopUpPacketFee) Type() string {
+	return TypeMsgTopUpPacketFee
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgTopUpPacketFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWithdrawPacketFee creates a new instance of MsgWithdrawPacketFee
+func NewMsgWithdrawPacketFee(packetID channeltypes.PacketId, refundAddress string) *MsgWithdrawPacketFee {
+	return &MsgWithdrawPacketFee{
+		PacketId:      packetID,
+		RefundAddress: refundAddress,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgWithdrawPacketFee fields
+func (msg MsgWithdrawPacketFee) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.RefundAddress into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgWithdrawPacketFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.RefundAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgWithdrawPacketFee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgWithdrawPacketFee) Type() string {
+	return TypeMsgWithdrawPacketFee
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgWithdrawPacketFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgPayClientUpdateBounty creates a new instance of MsgPayClientUpdateBounty
+func NewMsgPayClientUpdateBounty(clientID string, bounty ClientUpdateBounty) *MsgPayClientUpdateBounty {
+	return &MsgPayClientUpdateBounty{
+		ClientId: clientID,
+		Bounty:   bounty,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgPayClientUpdateBounty fields
+func (msg MsgPayClientUpdateBounty) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if err := msg.Bounty.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+// The signer of the bounty message must be the refund address
+func (msg MsgPayClientUpdateBounty) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Bounty.RefundAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgPayClientUpdateBounty) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgPayClientUpdateBounty) Type() string {
+	return TypeMsgPayClientUpdateBounty
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgPayClientUpdateBounty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWithdrawClientUpdateBounty creates a new instance of MsgWithdrawClientUpdateBounty
+func NewMsgWithdrawClientUpdateBounty(clientID, refundAddress string) *MsgWithdrawClientUpdateBounty {
+	return &MsgWithdrawClientUpdateBounty{
+		ClientId:      clientID,
+		RefundAddress: refundAddress,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgWithdrawClientUpdateBounty fields
+func (msg MsgWithdrawClientUpdateBounty) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.RefundAddress into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgWithdrawClientUpdateBounty) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.RefundAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgWithdrawClientUpdateBounty) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgWithdrawClientUpdateBounty) Type() string {
+	return TypeMsgWithdrawClientUpdateBounty
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgWithdrawClientUpdateBounty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgPayHandshakeBounty creates a new instance of MsgPayHandshakeBounty
+func NewMsgPayHandshakeBounty(portID, channelID string, bounty HandshakeBounty) *MsgPayHandshakeBounty {
+	return &MsgPayHandshakeBounty{
+		PortId:    portID,
+		ChannelId: channelID,
+		Bounty:    bounty,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgPayHandshakeBounty fields
+func (msg MsgPayHandshakeBounty) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if err := msg.Bounty.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+// The signer of the bounty message must be the refund address
+func (msg MsgPayHandshakeBounty) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Bounty.RefundAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgPayHandshakeBounty) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgPayHandshakeBounty) Type() string {
+	return TypeMsgPayHandshakeBounty
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgPayHandshakeBounty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgUnlockFeeModule creates a new instance of MsgUnlockFeeModule
+func NewMsgUnlockFeeModule(authority string) *MsgUnlockFeeModule {
+	return &MsgUnlockFeeModule{
+		Authority: authority,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUnlockFeeModule fields
+func (msg MsgUnlockFeeModule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Authority into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+// The signer of the unlock message must be the fee module authority
+func (msg MsgUnlockFeeModule) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUnlockFeeModule) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgUnlockFeeModule) Type() string {
+	return TypeMsgUnlockFeeModule
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgUnlockFeeModule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
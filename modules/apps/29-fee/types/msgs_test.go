@@ -78,6 +78,83 @@ func TestRegisterCountepartyAddressGetSigners(t *testing.T) {
 	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(accAddress)}, msg.GetSigners())
 }
 
+func TestMsgRegisterPayeeValidation(t *testing.T) {
+	var (
+		msg *types.MsgRegisterPayee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"success: relayer and payee are equal",
+			func() {
+				msg.Relayer = defaultAccAddress
+				msg.Payee = defaultAccAddress
+			},
+			true,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				msg.Relayer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid payee address",
+			func() {
+				msg.Payee = "invalid-address"
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		relayerAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		payeeAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+		msg = types.NewMsgRegisterPayee(ibctesting.MockPort, ibctesting.FirstChannelID, relayerAddr.String(), payeeAddr.String())
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestRegisterPayeeGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRegisterPayee(ibctesting.MockPort, ibctesting.FirstChannelID, accAddress.String(), defaultAccAddress)
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(accAddress)}, msg.GetSigners())
+}
+
 func TestMsgPayPacketFeeValidation(t *testing.T) {
 	var (
 		msg *types.MsgPayPacketFee
@@ -108,9 +185,23 @@ func TestMsgPayPacketFeeValidation(t *testing.T) {
 			false,
 		},
 		{
-			"relayers is not nil",
+			"success: with permitted relayers",
 			func() {
-				msg.Relayers = []string{defaultAccAddress}
+				msg.Relayers = []string{defaultAccAddress, "cosmos1counterpartyaddress"}
+			},
+			true,
+		},
+		{
+			"empty relayer address",
+			func() {
+				msg.Relayers = []string{defaultAccAddress, " "}
+			},
+			false,
+		},
+		{
+			"duplicate relayer address",
+			func() {
+				msg.Relayers = []string{defaultAccAddress, defaultAccAddress}
 			},
 			false,
 		},
@@ -203,9 +294,23 @@ func TestMsgPayPacketFeeAsyncValidation(t *testing.T) {
 			false,
 		},
 		{
-			"relayers is not nil",
+			"success: with permitted relayers",
 			func() {
-				msg.PacketFee.Relayers = []string{defaultAccAddress}
+				msg.PacketFee.Relayers = []string{defaultAccAddress, "cosmos1counterpartyaddress"}
+			},
+			true,
+		},
+		{
+			"empty relayer address",
+			func() {
+				msg.PacketFee.Relayers = []string{defaultAccAddress, " "}
+			},
+			false,
+		},
+		{
+			"duplicate relayer address",
+			func() {
+				msg.PacketFee.Relayers = []string{defaultAccAddress, defaultAccAddress}
 			},
 			false,
 		},
@@ -317,3 +422,397 @@ func TestMsgPayPacketFeeAsyncGetSignBytes(t *testing.T) {
 		_ = msg.GetSignBytes()
 	})
 }
+
+func TestMsgTopUpPacketFeeValidation(t *testing.T) {
+	var (
+		msg *types.MsgTopUpPacketFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.PacketId.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PacketId.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				msg.PacketId.Sequence = 0
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"should fail with single invalid fee",
+			func() {
+				msg.Fee.AckFee = invalidFee
+			},
+			false,
+		},
+		{
+			"should fail if all fees are empty",
+			func() {
+				msg.Fee.AckFee = sdk.Coins{}
+				msg.Fee.RecvFee = sdk.Coins{}
+				msg.Fee.TimeoutFee = sdk.Coins{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+		msg = types.NewMsgTopUpPacketFee(packetID, fee, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestTopUpPacketFeeGetSigners(t *testing.T) {
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	msg := types.NewMsgTopUpPacketFee(packetID, fee, signer.String())
+
+	require.Equal(t, []sdk.AccAddress{signer}, msg.GetSigners())
+}
+
+func TestMsgTopUpPacketFeeType(t *testing.T) {
+	var msg types.MsgTopUpPacketFee
+	require.Equal(t, "topUpPacketFee", msg.Type())
+}
+
+func TestMsgWithdrawPacketFeeValidation(t *testing.T) {
+	var (
+		msg *types.MsgWithdrawPacketFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.PacketId.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				msg.PacketId.Sequence = 0
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.RefundAddress = "invalid-addr"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+
+		msg = types.NewMsgWithdrawPacketFee(packetID, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestWithdrawPacketFeeGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+
+	msg := types.NewMsgWithdrawPacketFee(packetID, refundAddr.String())
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+}
+
+func TestMsgWithdrawPacketFeeType(t *testing.T) {
+	var msg types.MsgWithdrawPacketFee
+	require.Equal(t, "withdrawPacketFee", msg.Type())
+}
+
+func TestMsgPayClientUpdateBountyValidation(t *testing.T) {
+	var (
+		msg *types.MsgPayClientUpdateBounty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.Bounty.RefundAddress = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"invalid bounty",
+			func() {
+				msg.Bounty.Fee = invalidFee
+			},
+			false,
+		},
+		{
+			"target height and target timestamp are both 0",
+			func() {
+				msg.Bounty.TargetHeight = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		bounty := types.NewClientUpdateBounty(defaultRecvFee, defaultAccAddress, 100, 0)
+
+		msg = types.NewMsgPayClientUpdateBounty(ibctesting.FirstClientID, bounty)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestPayClientUpdateBountyGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bounty := types.NewClientUpdateBounty(defaultRecvFee, refundAddr.String(), 100, 0)
+
+	msg := types.NewMsgPayClientUpdateBounty(ibctesting.FirstClientID, bounty)
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+}
+
+func TestMsgPayClientUpdateBountyType(t *testing.T) {
+	var msg types.MsgPayClientUpdateBounty
+	require.Equal(t, "payClientUpdateBounty", msg.Type())
+}
+
+func TestMsgWithdrawClientUpdateBountyValidation(t *testing.T) {
+	var (
+		msg *types.MsgWithdrawClientUpdateBounty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.RefundAddress = "invalid-addr"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgWithdrawClientUpdateBounty(ibctesting.FirstClientID, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestWithdrawClientUpdateBountyGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := types.NewMsgWithdrawClientUpdateBounty(ibctesting.FirstClientID, refundAddr.String())
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+}
+
+func TestMsgWithdrawClientUpdateBountyType(t *testing.T) {
+	var msg types.MsgWithdrawClientUpdateBounty
+	require.Equal(t, "withdrawClientUpdateBounty", msg.Type())
+}
+
+func TestMsgPayHandshakeBountyValidation(t *testing.T) {
+	var (
+		msg *types.MsgPayHandshakeBounty
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.Bounty.RefundAddress = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"empty bounty",
+			func() {
+				msg.Bounty.Fee = sdk.Coins{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		bounty := types.NewHandshakeBounty(defaultRecvFee, defaultAccAddress)
+
+		msg = types.NewMsgPayHandshakeBounty(ibctesting.MockFeePort, ibctesting.FirstChannelID, bounty)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestPayHandshakeBountyGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bounty := types.NewHandshakeBounty(defaultRecvFee, refundAddr.String())
+
+	msg := types.NewMsgPayHandshakeBounty(ibctesting.MockFeePort, ibctesting.FirstChannelID, bounty)
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+}
+
+func TestMsgPayHandshakeBountyType(t *testing.T) {
+	var msg types.MsgPayHandshakeBounty
+	require.Equal(t, "payHandshakeBounty", msg.Type())
+}
+
+func TestMsgUnlockFeeModuleValidation(t *testing.T) {
+	msg := types.NewMsgUnlockFeeModule(defaultAccAddress)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgUnlockFeeModule("invalid-addr")
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestUnlockFeeModuleGetSigners(t *testing.T) {
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUnlockFeeModule(authority.String())
+
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+}
+
+func TestMsgUnlockFeeModuleType(t *testing.T) {
+	var msg types.MsgUnlockFeeModule
+	require.Equal(t, "unlockFeeModule", msg.Type())
+}
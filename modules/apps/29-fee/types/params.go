@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultRefundGracePeriod is the default period after which an escrowed packet fee may be withdrawn
+	DefaultRefundGracePeriod = 24 * time.Hour
+
+	// DefaultMaxPacketFees is the default maximum number of packet fees escrowed for a single packet
+	DefaultMaxPacketFees = 100
+)
+
+var (
+	// KeyRefundGracePeriod is the store key for the RefundGracePeriod param
+	KeyRefundGracePeriod = []byte("RefundGracePeriod")
+
+	// KeyAllowedFeeDenoms is the store key for the AllowedFeeDenoms param
+	KeyAllowedFeeDenoms = []byte("AllowedFeeDenoms")
+
+	// KeyMaxFeePerPacket is the store key for the MaxFeePerPacket param
+	KeyMaxFeePerPacket = []byte("MaxFeePerPacket")
+
+	// KeyMaxPacketFees is the store key for the MaxPacketFees param
+	KeyMaxPacketFees = []byte("MaxPacketFees")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the fee middleware
+func NewParams(refundGracePeriod time.Duration, allowedFeeDenoms []string, maxFeePerPacket sdk.Coins, maxPacketFees uint64) Params {
+	return Params{
+		RefundGracePeriod: refundGracePeriod,
+		AllowedFeeDenoms:  allowedFeeDenoms,
+		MaxFeePerPacket:   maxFeePerPacket,
+		MaxPacketFees:     maxPacketFees,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the fee middleware
+func DefaultParams() Params {
+	return NewParams(DefaultRefundGracePeriod, nil, nil, DefaultMaxPacketFees)
+}
+
+// Validate validates all fee middleware parameters
+func (p Params) Validate() error {
+	if err := validateRefundGracePeriod(p.RefundGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateAllowedFeeDenoms(p.AllowedFeeDenoms); err != nil {
+		return err
+	}
+
+	if err := validateMaxFeePerPacket(p.MaxFeePerPacket); err != nil {
+		return err
+	}
+
+	return validateMaxPacketFees(p.MaxPacketFees)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRefundGracePeriod, p.RefundGracePeriod, validateRefundGracePeriod),
+		paramtypes.NewParamSetPair(KeyAllowedFeeDenoms, p.AllowedFeeDenoms, validateAllowedFeeDenoms),
+		paramtypes.NewParamSetPair(KeyMaxFeePerPacket, p.MaxFeePerPacket, validateMaxFeePerPacket),
+		paramtypes.NewParamSetPair(KeyMaxPacketFees, p.MaxPacketFees, validateMaxPacketFees),
+	}
+}
+
+func validateRefundGracePeriod(i interface{}) error {
+	gracePeriod, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if gracePeriod < 0 {
+		return fmt.Errorf("refund grace period cannot be negative: %s", gracePeriod)
+	}
+
+	return nil
+}
+
+func validateAllowedFeeDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed fee denom: %s", denom)
+		}
+
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateMaxFeePerPacket(i interface{}) error {
+	maxFee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := maxFee.Validate(); err != nil {
+		return fmt.Errorf("invalid max fee per packet: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxPacketFees(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"zero refund grace period", types.NewParams(0, nil, nil, 0), true},
+		{"custom params", types.NewParams(time.Hour, []string{sdk.DefaultBondDenom, "atom"}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), 10), true},
+		{"negative refund grace period", types.NewParams(-time.Hour, nil, nil, 0), false},
+		{"invalid allowed fee denom", types.NewParams(time.Hour, []string{"1"}, nil, 0), false},
+		{"duplicate allowed fee denom", types.NewParams(time.Hour, []string{sdk.DefaultBondDenom, sdk.DefaultBondDenom}, nil, 0), false},
+		{"invalid max fee per packet", types.NewParams(time.Hour, nil, sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}}, 0), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
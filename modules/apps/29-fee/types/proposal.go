@@ -0,0 +1,41 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUnlockFeeModule defines the type for an UnlockFeeModuleProposal
+	ProposalTypeUnlockFeeModule = "UnlockFeeModule"
+)
+
+var _ govtypes.Content = &UnlockFeeModuleProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUnlockFeeModule)
+}
+
+// NewUnlockFeeModuleProposal creates a new fee module unlock proposal.
+func NewUnlockFeeModuleProposal(title, description string) govtypes.Content {
+	return &UnlockFeeModuleProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// GetTitle returns the title of a fee module unlock proposal.
+func (ufmp *UnlockFeeModuleProposal) GetTitle() string { return ufmp.Title }
+
+// GetDescription returns the description of a fee module unlock proposal.
+func (ufmp *UnlockFeeModuleProposal) GetDescription() string { return ufmp.Description }
+
+// ProposalRoute returns the routing key of a fee module unlock proposal.
+func (ufmp *UnlockFeeModuleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a fee module unlock proposal.
+func (ufmp *UnlockFeeModuleProposal) ProposalType() string { return ProposalTypeUnlockFeeModule }
+
+// ValidateBasic runs basic stateless validity checks
+func (ufmp *UnlockFeeModuleProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(ufmp)
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestUnlockFeeModuleProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *types.UnlockFeeModuleProposal
+		expPass  bool
+	}{
+		{
+			"success",
+			types.NewUnlockFeeModuleProposal(ibctesting.Title, ibctesting.Description).(*types.UnlockFeeModuleProposal),
+			true,
+		},
+		{
+			"empty title",
+			types.NewUnlockFeeModuleProposal("", ibctesting.Description).(*types.UnlockFeeModuleProposal),
+			false,
+		},
+		{
+			"empty description",
+			types.NewUnlockFeeModuleProposal(ibctesting.Title, "").(*types.UnlockFeeModuleProposal),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+
+		require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute())
+		require.Equal(t, types.ProposalTypeUnlockFeeModule, tc.proposal.ProposalType())
+	}
+}
@@ -738,6 +738,190 @@ func (m *QueryCounterpartyAddressResponse) GetCounterpartyAddress() string {
 	return ""
 }
 
+// QueryPayeeRequest defines the request type for the Payee rpc
+type QueryPayeeRequest struct {
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the relayer address to which the payee is registered
+	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (m *QueryPayeeRequest) Reset()         { *m = QueryPayeeRequest{} }
+func (m *QueryPayeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPayeeRequest) ProtoMessage()    {}
+func (*QueryPayeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{14}
+}
+func (m *QueryPayeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayeeRequest.Merge(m, src)
+}
+func (m *QueryPayeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayeeRequest proto.InternalMessageInfo
+
+func (m *QueryPayeeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPayeeRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+// QueryPayeeResponse defines the response type for the Payee rpc
+type QueryPayeeResponse struct {
+	// the payee address to which packet fees are paid out
+	PayeeAddress string `protobuf:"bytes,1,opt,name=payee_address,json=payeeAddress,proto3" json:"payee_address,omitempty" yaml:"payee_address"`
+}
+
+func (m *QueryPayeeResponse) Reset()         { *m = QueryPayeeResponse{} }
+func (m *QueryPayeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPayeeResponse) ProtoMessage()    {}
+func (*QueryPayeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{15}
+}
+func (m *QueryPayeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayeeResponse.Merge(m, src)
+}
+func (m *QueryPayeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayeeResponse proto.InternalMessageInfo
+
+func (m *QueryPayeeResponse) GetPayeeAddress() string {
+	if m != nil {
+		return m.PayeeAddress
+	}
+	return ""
+}
+
+// QueryParamsRequest defines the request type for the Params rpc
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{16}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for the Params rpc
+type QueryParamsResponse struct {
+	// params defines the parameters of the fee middleware
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{17}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // QueryFeeEnabledChannelsRequest defines the request type for the FeeEnabledChannels rpc
 type QueryFeeEnabledChannelsRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -750,7 +934,7 @@ func (m *QueryFeeEnabledChannelsRequest) Reset()         { *m = QueryFeeEnabledC
 func (m *QueryFeeEnabledChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeEnabledChannelsRequest) ProtoMessage()    {}
 func (*QueryFeeEnabledChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0638a8a78ca2503c, []int{14}
+	return fileDescriptor_0638a8a78ca2503c, []int{18}
 }
 func (m *QueryFeeEnabledChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +987,7 @@ func (m *QueryFeeEnabledChannelsResponse) Reset()         { *m = QueryFeeEnabled
 func (m *QueryFeeEnabledChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeEnabledChannelsResponse) ProtoMessage()    {}
 func (*QueryFeeEnabledChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0638a8a78ca2503c, []int{15}
+	return fileDescriptor_0638a8a78ca2503c, []int{19}
 }
 func (m *QueryFeeEnabledChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1035,7 @@ func (m *QueryFeeEnabledChannelRequest) Reset()         { *m = QueryFeeEnabledCh
 func (m *QueryFeeEnabledChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeEnabledChannelRequest) ProtoMessage()    {}
 func (*QueryFeeEnabledChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0638a8a78ca2503c, []int{16}
+	return fileDescriptor_0638a8a78ca2503c, []int{20}
 }
 func (m *QueryFeeEnabledChannelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,7 +1088,7 @@ func (m *QueryFeeEnabledChannelResponse) Reset()         { *m = QueryFeeEnabledC
 func (m *QueryFeeEnabledChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeEnabledChannelResponse) ProtoMessage()    {}
 func (*QueryFeeEnabledChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0638a8a78ca2503c, []int{17}
+	return fileDescriptor_0638a8a78ca2503c, []int{21}
 }
 func (m *QueryFeeEnabledChannelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,6 +1124,199 @@ func (m *QueryFeeEnabledChannelResponse) GetFeeEnabled() bool {
 	return false
 }
 
+// QueryClientUpdateBountiesRequest defines the request type for the ClientUpdateBounties rpc
+type QueryClientUpdateBountiesRequest struct {
+	// unique client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+}
+
+func (m *QueryClientUpdateBountiesRequest) Reset()         { *m = QueryClientUpdateBountiesRequest{} }
+func (m *QueryClientUpdateBountiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientUpdateBountiesRequest) ProtoMessage()    {}
+func (*QueryClientUpdateBountiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{22}
+}
+func (m *QueryClientUpdateBountiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientUpdateBountiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientUpdateBountiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientUpdateBountiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientUpdateBountiesRequest.Merge(m, src)
+}
+func (m *QueryClientUpdateBountiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientUpdateBountiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientUpdateBountiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientUpdateBountiesRequest proto.InternalMessageInfo
+
+func (m *QueryClientUpdateBountiesRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryClientUpdateBountiesResponse defines the response type for the ClientUpdateBounties rpc
+type QueryClientUpdateBountiesResponse struct {
+	// list of bounties escrowed for the client
+	Bounties []ClientUpdateBounty `protobuf:"bytes,1,rep,name=bounties,proto3" json:"bounties"`
+}
+
+func (m *QueryClientUpdateBountiesResponse) Reset()         { *m = QueryClientUpdateBountiesResponse{} }
+func (m *QueryClientUpdateBountiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientUpdateBountiesResponse) ProtoMessage()    {}
+func (*QueryClientUpdateBountiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{23}
+}
+func (m *QueryClientUpdateBountiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientUpdateBountiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientUpdateBountiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientUpdateBountiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientUpdateBountiesResponse.Merge(m, src)
+}
+func (m *QueryClientUpdateBountiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientUpdateBountiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientUpdateBountiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientUpdateBountiesResponse proto.InternalMessageInfo
+
+func (m *QueryClientUpdateBountiesResponse) GetBounties() []ClientUpdateBounty {
+	if m != nil {
+		return m.Bounties
+	}
+	return nil
+}
+
+// QueryHandshakeBountiesRequest defines the request type for the HandshakeBounties rpc
+type QueryHandshakeBountiesRequest struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *QueryHandshakeBountiesRequest) Reset()         { *m = QueryHandshakeBountiesRequest{} }
+func (m *QueryHandshakeBountiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHandshakeBountiesRequest) ProtoMessage()    {}
+func (*QueryHandshakeBountiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{24}
+}
+func (m *QueryHandshakeBountiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHandshakeBountiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHandshakeBountiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHandshakeBountiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHandshakeBountiesRequest.Merge(m, src)
+}
+func (m *QueryHandshakeBountiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHandshakeBountiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHandshakeBountiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHandshakeBountiesRequest proto.InternalMessageInfo
+
+func (m *QueryHandshakeBountiesRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryHandshakeBountiesRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryHandshakeBountiesResponse defines the response type for the HandshakeBounties rpc
+type QueryHandshakeBountiesResponse struct {
+	// list of bounties escrowed for the channel handshake
+	Bounties []HandshakeBounty `protobuf:"bytes,1,rep,name=bounties,proto3" json:"bounties"`
+}
+
+func (m *QueryHandshakeBountiesResponse) Reset()         { *m = QueryHandshakeBountiesResponse{} }
+func (m *QueryHandshakeBountiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHandshakeBountiesResponse) ProtoMessage()    {}
+func (*QueryHandshakeBountiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{25}
+}
+func (m *QueryHandshakeBountiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHandshakeBountiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHandshakeBountiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHandshakeBountiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHandshakeBountiesResponse.Merge(m, src)
+}
+func (m *QueryHandshakeBountiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHandshakeBountiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHandshakeBountiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHandshakeBountiesResponse proto.InternalMessageInfo
+
+func (m *QueryHandshakeBountiesResponse) GetBounties() []HandshakeBounty {
+	if m != nil {
+		return m.Bounties
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryIncentivizedPacketsRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsRequest")
 	proto.RegisterType((*QueryIncentivizedPacketsResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsResponse")
@@ -955,10 +1332,18 @@ func init() {
 	proto.RegisterType((*QueryTotalTimeoutFeesResponse)(nil), "ibc.applications.fee.v1.QueryTotalTimeoutFeesResponse")
 	proto.RegisterType((*QueryCounterpartyAddressRequest)(nil), "ibc.applications.fee.v1.QueryCounterpartyAddressRequest")
 	proto.RegisterType((*QueryCounterpartyAddressResponse)(nil), "ibc.applications.fee.v1.QueryCounterpartyAddressResponse")
+	proto.RegisterType((*QueryPayeeRequest)(nil), "ibc.applications.fee.v1.QueryPayeeRequest")
+	proto.RegisterType((*QueryPayeeResponse)(nil), "ibc.applications.fee.v1.QueryPayeeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.fee.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.fee.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryFeeEnabledChannelsRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsRequest")
 	proto.RegisterType((*QueryFeeEnabledChannelsResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsResponse")
 	proto.RegisterType((*QueryFeeEnabledChannelRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelRequest")
 	proto.RegisterType((*QueryFeeEnabledChannelResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelResponse")
+	proto.RegisterType((*QueryClientUpdateBountiesRequest)(nil), "ibc.applications.fee.v1.QueryClientUpdateBountiesRequest")
+	proto.RegisterType((*QueryClientUpdateBountiesResponse)(nil), "ibc.applications.fee.v1.QueryClientUpdateBountiesResponse")
+	proto.RegisterType((*QueryHandshakeBountiesRequest)(nil), "ibc.applications.fee.v1.QueryHandshakeBountiesRequest")
+	proto.RegisterType((*QueryHandshakeBountiesResponse)(nil), "ibc.applications.fee.v1.QueryHandshakeBountiesResponse")
 }
 
 func init() {
@@ -966,88 +1351,107 @@ func init() {
 }
 
 var fileDescriptor_0638a8a78ca2503c = []byte{
-	// 1292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xc1, 0x6f, 0x1b, 0xc5,
-	0x17, 0xce, 0xa4, 0xf9, 0xb5, 0xc9, 0x24, 0xbf, 0x36, 0x1d, 0x87, 0x36, 0x35, 0x89, 0x9d, 0x4e,
-	0x29, 0x84, 0xa0, 0xec, 0x2a, 0x0e, 0x6d, 0x5a, 0x84, 0x50, 0x6b, 0x97, 0xd0, 0x48, 0x08, 0xca,
-	0x2a, 0x17, 0x10, 0xc8, 0x5d, 0xef, 0x8e, 0x9d, 0x55, 0x9c, 0x9d, 0xed, 0xee, 0xda, 0xe0, 0xa6,
-	0x41, 0x6a, 0xa4, 0x08, 0x09, 0x2a, 0x84, 0x84, 0xc4, 0x01, 0x71, 0xe1, 0x80, 0x90, 0x90, 0xf8,
-	0x03, 0xf8, 0x0f, 0x7a, 0xaa, 0x2a, 0x71, 0xe1, 0x64, 0xaa, 0x84, 0x33, 0x07, 0x8b, 0x03, 0x47,
-	0xb4, 0x33, 0xb3, 0xeb, 0x75, 0x77, 0x37, 0xf1, 0x96, 0x44, 0x9c, 0x62, 0xcf, 0xbc, 0xf7, 0xe6,
-	0xfb, 0xbe, 0xf7, 0x3c, 0xf3, 0x29, 0xf0, 0x82, 0x51, 0xd1, 0x64, 0xd5, 0xb2, 0xea, 0x86, 0xa6,
-	0xba, 0x06, 0x35, 0x1d, 0xb9, 0x4a, 0x88, 0xdc, 0x5c, 0x90, 0xef, 0x34, 0x88, 0xdd, 0x92, 0x2c,
-	0x9b, 0xba, 0x14, 0x9d, 0x35, 0x2a, 0x9a, 0x14, 0x0e, 0x92, 0xaa, 0x84, 0x48, 0xcd, 0x85, 0xec,
-	0x44, 0x8d, 0xd6, 0x28, 0x8b, 0x91, 0xbd, 0x4f, 0x3c, 0x3c, 0x3b, 0x55, 0xa3, 0xb4, 0x56, 0x27,
-	0xb2, 0x6a, 0x19, 0xb2, 0x6a, 0x9a, 0xd4, 0x15, 0x49, 0x7c, 0x37, 0xa7, 0x51, 0x67, 0x83, 0x3a,
-	0x72, 0x45, 0x75, 0xbc, 0x83, 0x2a, 0xc4, 0x55, 0x17, 0x64, 0x8d, 0x1a, 0xa6, 0xd8, 0x9f, 0x0b,
-	0xef, 0x33, 0x14, 0x41, 0x94, 0xa5, 0xd6, 0x0c, 0x93, 0x15, 0x13, 0xb1, 0xe7, 0x93, 0xd0, 0x7b,
-	0xf8, 0x78, 0xc8, 0xc5, 0xa4, 0x90, 0x1a, 0x31, 0x89, 0x63, 0x38, 0xe1, 0x4a, 0x1a, 0xb5, 0x89,
-	0xac, 0xad, 0xa9, 0xa6, 0x49, 0xea, 0x5e, 0x88, 0xf8, 0xc8, 0x43, 0xf0, 0x03, 0x00, 0xf3, 0xef,
-	0x79, 0x78, 0x56, 0x4c, 0x8d, 0x98, 0xae, 0xd1, 0x34, 0xee, 0x12, 0xfd, 0x96, 0xaa, 0xad, 0x13,
-	0xd7, 0x51, 0xc8, 0x9d, 0x06, 0x71, 0x5c, 0xb4, 0x0c, 0x61, 0x17, 0xe4, 0x24, 0x98, 0x01, 0xb3,
-	0xa3, 0x85, 0x17, 0x25, 0xce, 0x48, 0xf2, 0x18, 0x49, 0x5c, 0x57, 0xc1, 0x48, 0xba, 0xa5, 0xd6,
-	0x88, 0xc8, 0x55, 0x42, 0x99, 0xe8, 0x3c, 0x1c, 0x63, 0x81, 0xe5, 0x35, 0x62, 0xd4, 0xd6, 0xdc,
-	0xc9, 0xc1, 0x19, 0x30, 0x3b, 0xa4, 0x8c, 0xb2, 0xb5, 0x9b, 0x6c, 0x09, 0x7f, 0x0e, 0xe0, 0x4c,
-	0x32, 0x1c, 0xc7, 0xa2, 0xa6, 0x43, 0x50, 0x15, 0x4e, 0x18, 0xa1, 0xed, 0xb2, 0xc5, 0xf7, 0x27,
-	0xc1, 0xcc, 0xb1, 0xd9, 0xd1, 0xc2, 0xbc, 0x94, 0xd0, 0x58, 0x69, 0x45, 0xf7, 0x72, 0xaa, 0x86,
-	0x5f, 0x71, 0x99, 0x10, 0xa7, 0x38, 0xf4, 0xb0, 0x9d, 0x1f, 0x50, 0x32, 0x46, 0xf4, 0x3c, 0xbc,
-	0x03, 0x60, 0x2e, 0x01, 0x8c, 0x2f, 0xcd, 0x35, 0x38, 0xc2, 0x4f, 0x2f, 0x1b, 0xba, 0x50, 0x66,
-	0x9a, 0x9d, 0xef, 0xa9, 0x2e, 0xf9, 0x52, 0x37, 0x3d, 0x4d, 0xbc, 0xa8, 0x15, 0x5d, 0x9c, 0x37,
-	0x6c, 0x89, 0xef, 0xfd, 0x88, 0xf2, 0x59, 0x72, 0x8f, 0x02, 0x4d, 0x74, 0x98, 0x89, 0xd1, 0x44,
-	0x40, 0x7a, 0x26, 0x49, 0x50, 0x54, 0x12, 0xfc, 0x08, 0xc0, 0x97, 0x93, 0xda, 0xb3, 0x4c, 0xed,
-	0x12, 0xe7, 0x7b, 0xd8, 0x73, 0x73, 0x16, 0x9e, 0xb0, 0xa8, 0xcd, 0x24, 0xf6, 0xd4, 0x19, 0x51,
-	0x8e, 0x7b, 0x5f, 0x57, 0x74, 0x34, 0x0d, 0xa1, 0x90, 0xd8, 0xdb, 0x3b, 0xc6, 0xf6, 0x46, 0xc4,
-	0x4a, 0x8c, 0xb4, 0x43, 0x51, 0x69, 0xbf, 0x04, 0x70, 0xae, 0x1f, 0x42, 0x42, 0xe5, 0xdb, 0x87,
-	0x38, 0x79, 0xf1, 0x33, 0xf7, 0x11, 0x3c, 0xc7, 0xf0, 0xac, 0x52, 0x57, 0xad, 0x2b, 0x44, 0x6b,
-	0xb2, 0xd0, 0xc3, 0x9a, 0x36, 0xfc, 0x2d, 0x80, 0xd9, 0xb8, 0xfa, 0x82, 0xdf, 0x3d, 0x38, 0x62,
-	0x13, 0xad, 0x59, 0xae, 0x12, 0xe2, 0x93, 0x3a, 0xd7, 0xd3, 0x30, 0xbf, 0x55, 0x25, 0x6a, 0x98,
-	0xc5, 0x1b, 0x5e, 0xf1, 0x4e, 0x3b, 0x3f, 0xde, 0x52, 0x37, 0xea, 0xaf, 0xe1, 0x20, 0x13, 0xff,
-	0xf4, 0x7b, 0x7e, 0xb6, 0x66, 0xb8, 0x6b, 0x8d, 0x8a, 0xa4, 0xd1, 0x0d, 0x59, 0xdc, 0x7d, 0xfc,
-	0xcf, 0xbc, 0xa3, 0xaf, 0xcb, 0x6e, 0xcb, 0x22, 0x0e, 0x2b, 0xe2, 0x28, 0xc3, 0xb6, 0x40, 0x81,
-	0x3f, 0x84, 0x93, 0x5d, 0x6c, 0xd7, 0xb5, 0xf5, 0xc3, 0xa5, 0xfe, 0x0d, 0x08, 0x4b, 0x1b, 0x94,
-	0x17, 0xcc, 0x5b, 0x70, 0x58, 0xd5, 0xd6, 0xfb, 0x24, 0x5e, 0x12, 0xc4, 0x4f, 0x71, 0xe2, 0x7e,
-	0x62, 0x3a, 0xde, 0x27, 0x54, 0x0e, 0x01, 0xdf, 0x86, 0x53, 0x5d, 0x5c, 0xab, 0xc6, 0x06, 0xa1,
-	0x0d, 0xf7, 0x70, 0xa9, 0xff, 0x08, 0xe0, 0x74, 0xc2, 0x11, 0x82, 0xfe, 0x0e, 0x80, 0x63, 0x2e,
-	0x5f, 0xef, 0x53, 0x83, 0xb7, 0x84, 0x06, 0x19, 0xae, 0x41, 0x38, 0x39, 0x9d, 0x0e, 0xa3, 0x6e,
-	0x17, 0x0f, 0xfe, 0xce, 0xbf, 0xea, 0x4a, 0xb4, 0x61, 0xba, 0xc4, 0xb6, 0x54, 0xdb, 0x6d, 0x5d,
-	0xd7, 0x75, 0x9b, 0x38, 0x81, 0x1e, 0xaf, 0xf6, 0xfc, 0xea, 0x3d, 0x41, 0x46, 0x8a, 0xcf, 0x75,
-	0xda, 0xf9, 0xd3, 0x1c, 0x49, 0x77, 0x0f, 0x87, 0x2f, 0x83, 0x12, 0x3c, 0x65, 0x93, 0xba, 0xda,
-	0x22, 0x76, 0x59, 0xe5, 0xf5, 0xf8, 0x65, 0x52, 0xcc, 0x76, 0xda, 0xf9, 0x33, 0xfe, 0x04, 0xf7,
-	0x04, 0x60, 0xe5, 0xa4, 0x58, 0x11, 0x08, 0x70, 0x53, 0xbc, 0x4e, 0xb1, 0xe8, 0x84, 0x94, 0x0a,
-	0x9c, 0xd0, 0x42, 0xdb, 0xc1, 0x69, 0x1c, 0x68, 0xbe, 0xd3, 0xce, 0x3f, 0x2f, 0x80, 0xc6, 0x44,
-	0x61, 0x25, 0xa3, 0x45, 0x6b, 0xe3, 0x2f, 0xfc, 0x97, 0x68, 0x99, 0x90, 0x37, 0x4d, 0xb5, 0x52,
-	0x27, 0xba, 0xb8, 0x9a, 0xfe, 0x8b, 0x47, 0xfa, 0x07, 0xbf, 0x49, 0x71, 0x68, 0x84, 0x0a, 0xf7,
-	0x01, 0x9c, 0xa8, 0x12, 0x52, 0x26, 0x7c, 0xbf, 0x2c, 0x1a, 0xe1, 0x0f, 0xd6, 0x5c, 0xe2, 0x55,
-	0x19, 0xa9, 0x59, 0xbc, 0x20, 0x26, 0x4d, 0xc8, 0x16, 0x57, 0x15, 0x2b, 0xa8, 0x1a, 0xc1, 0x82,
-	0xb7, 0xfd, 0xb1, 0x8f, 0xd4, 0xf4, 0x45, 0x7b, 0xa5, 0xfb, 0xb2, 0xf0, 0xf6, 0xa0, 0x4e, 0x3b,
-	0x7f, 0x92, 0x9f, 0x23, 0x36, 0x70, 0xf0, 0xda, 0xf4, 0xce, 0xdd, 0x60, 0x7f, 0x73, 0x87, 0xdf,
-	0x4f, 0xea, 0x5c, 0x20, 0xd5, 0x12, 0x1c, 0x0d, 0x71, 0x62, 0x40, 0x86, 0x8b, 0x67, 0x3a, 0xed,
-	0x3c, 0x8a, 0x10, 0xc6, 0x0a, 0xec, 0xf2, 0x2c, 0x7c, 0x3f, 0x0e, 0xff, 0xc7, 0x6a, 0xa3, 0x5f,
-	0x00, 0xcc, 0xc4, 0xbc, 0x60, 0xe8, 0x4a, 0xa2, 0xcc, 0x07, 0x78, 0xbe, 0xec, 0xd5, 0x67, 0xc8,
-	0xe4, 0x7c, 0xf0, 0xfc, 0xf6, 0xaf, 0x7f, 0x7c, 0x3d, 0xf8, 0x12, 0xba, 0x28, 0x0b, 0x97, 0x1a,
-	0xb8, 0xd3, 0xb8, 0xb7, 0x13, 0x3d, 0x18, 0x84, 0x28, 0x5a, 0x0e, 0x2d, 0xa5, 0x05, 0xe0, 0x23,
-	0xbf, 0x92, 0x3e, 0x51, 0x00, 0xdf, 0x06, 0x0c, 0xf9, 0x3d, 0x74, 0xb7, 0x1f, 0xe4, 0xb2, 0x37,
-	0x16, 0xf2, 0x66, 0x70, 0x35, 0x4b, 0x62, 0x60, 0xb6, 0x02, 0xb3, 0x1d, 0xda, 0xeb, 0x0e, 0xc7,
-	0x96, 0xec, 0x78, 0x40, 0x4d, 0x8d, 0x84, 0xf7, 0xfd, 0xb5, 0x2d, 0xf4, 0x27, 0x80, 0xd3, 0xfb,
-	0x9a, 0x11, 0x54, 0x4c, 0xdd, 0x9a, 0x88, 0x35, 0xcb, 0x96, 0xfe, 0x55, 0x0d, 0xa1, 0xd7, 0x0d,
-	0x26, 0xd7, 0x1b, 0xe8, 0xf5, 0xbe, 0x1a, 0x2d, 0x6f, 0x06, 0x02, 0x6d, 0x86, 0xe4, 0x40, 0x7f,
-	0x03, 0xf8, 0xff, 0x1e, 0x37, 0x82, 0x0a, 0xfb, 0x83, 0x8b, 0xb3, 0x46, 0xd9, 0xc5, 0x54, 0x39,
-	0x82, 0xc0, 0xa7, 0x8c, 0xc0, 0x27, 0xa8, 0x19, 0x21, 0xe0, 0x7a, 0xf1, 0xe5, 0xc0, 0xd1, 0x1c,
-	0x51, 0xaf, 0xff, 0x02, 0x70, 0x2c, 0xec, 0x46, 0xd0, 0x42, 0x1f, 0x2c, 0x7a, 0x8d, 0x51, 0xb6,
-	0x90, 0x26, 0x45, 0xf0, 0xde, 0x62, 0xbc, 0x3f, 0x46, 0x8d, 0x04, 0xde, 0xbe, 0xa1, 0x39, 0x22,
-	0xda, 0x3b, 0x83, 0x70, 0xfc, 0x69, 0x27, 0x82, 0x2e, 0xf5, 0xc1, 0x23, 0x6a, 0x8e, 0xb2, 0x97,
-	0xd3, 0xa6, 0x09, 0x09, 0xee, 0xf3, 0xdf, 0xfa, 0x26, 0x6a, 0x25, 0x68, 0x10, 0x36, 0x34, 0x47,
-	0xa4, 0xc3, 0x13, 0x00, 0x33, 0x31, 0x4e, 0xe2, 0xa0, 0x5b, 0x3b, 0xd9, 0x1a, 0x1d, 0x74, 0x6b,
-	0xef, 0x63, 0x5b, 0xf0, 0x2a, 0xd3, 0xe3, 0x1d, 0xf4, 0x76, 0x44, 0x8f, 0x38, 0x9f, 0x22, 0x6f,
-	0x3e, 0xe5, 0x95, 0x42, 0x52, 0x84, 0x7f, 0xdc, 0x3f, 0x03, 0x88, 0xa2, 0x2e, 0xe1, 0xa0, 0xcb,
-	0x3d, 0xd1, 0xe5, 0x1c, 0x74, 0xb9, 0x27, 0x1b, 0x12, 0xfc, 0x02, 0xe3, 0x97, 0x43, 0x53, 0x11,
-	0x7e, 0xa1, 0xf7, 0x15, 0x3d, 0x02, 0xf0, 0x74, 0xa4, 0x08, 0xba, 0x9c, 0xf2, 0x54, 0x1f, 0xed,
-	0x52, 0xea, 0x3c, 0x01, 0xf6, 0x26, 0x03, 0x5b, 0x44, 0xd7, 0xf6, 0x03, 0xeb, 0x4f, 0x65, 0x64,
-	0x16, 0x43, 0x0d, 0x28, 0xbe, 0xfb, 0x70, 0x37, 0x07, 0x1e, 0xef, 0xe6, 0xc0, 0x93, 0xdd, 0x1c,
-	0xf8, 0x6a, 0x2f, 0x37, 0xf0, 0x78, 0x2f, 0x37, 0xf0, 0xdb, 0x5e, 0x6e, 0xe0, 0x83, 0x4b, 0x51,
-	0x87, 0x6e, 0x54, 0xb4, 0xf9, 0x1a, 0x95, 0x9b, 0x8b, 0xf2, 0x06, 0xd5, 0x1b, 0x75, 0xe2, 0xf0,
-	0xa3, 0x0b, 0x57, 0xe7, 0xbd, 0xd3, 0x99, 0x69, 0xaf, 0x1c, 0x67, 0xff, 0x37, 0x5a, 0xfc, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0x50, 0xbb, 0x05, 0x64, 0x13, 0x00, 0x00,
+	// 1596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0xce, 0xa4, 0x6d, 0x9a, 0x4c, 0xd2, 0x8f, 0x4c, 0xb6, 0x6d, 0xe2, 0x5f, 0xb3, 0x9b, 0x4e,
+	0x7f, 0x85, 0x90, 0x92, 0xb5, 0x92, 0x52, 0xd2, 0x56, 0x7c, 0xb4, 0x9b, 0x92, 0x36, 0x08, 0x4a,
+	0x31, 0xed, 0x01, 0x04, 0xda, 0x7a, 0xed, 0xc9, 0xc6, 0xca, 0xc6, 0x76, 0x6d, 0x67, 0x61, 0x9b,
+	0x06, 0xa9, 0x15, 0x15, 0x12, 0x54, 0x08, 0x09, 0x89, 0x03, 0xe2, 0x8a, 0x10, 0x48, 0xfc, 0x01,
+	0xdc, 0x39, 0xf4, 0x42, 0x55, 0x89, 0x0b, 0xa7, 0xa5, 0x6a, 0xb9, 0x21, 0x71, 0x88, 0x38, 0x70,
+	0x44, 0x9e, 0x79, 0xed, 0xf5, 0xd6, 0x76, 0x76, 0x37, 0x4d, 0xe1, 0xb4, 0xeb, 0x79, 0x3f, 0xe6,
+	0x79, 0x9e, 0x77, 0x66, 0xfc, 0x8e, 0xf1, 0x61, 0xa3, 0xa4, 0xc9, 0xaa, 0x6d, 0x57, 0x0c, 0x4d,
+	0xf5, 0x0c, 0xcb, 0x74, 0xe5, 0x05, 0xc6, 0xe4, 0xea, 0x94, 0x7c, 0x75, 0x85, 0x39, 0xb5, 0xbc,
+	0xed, 0x58, 0x9e, 0x45, 0x0e, 0x18, 0x25, 0x2d, 0x1f, 0x75, 0xca, 0x2f, 0x30, 0x96, 0xaf, 0x4e,
+	0x49, 0x99, 0xb2, 0x55, 0xb6, 0xb8, 0x8f, 0xec, 0xff, 0x13, 0xee, 0xd2, 0xc1, 0xb2, 0x65, 0x95,
+	0x2b, 0x4c, 0x56, 0x6d, 0x43, 0x56, 0x4d, 0xd3, 0xf2, 0x20, 0x48, 0x58, 0xb3, 0x9a, 0xe5, 0x2e,
+	0x5b, 0xae, 0x5c, 0x52, 0x5d, 0x7f, 0xa2, 0x12, 0xf3, 0xd4, 0x29, 0x59, 0xb3, 0x0c, 0x13, 0xec,
+	0x13, 0x51, 0x3b, 0x47, 0x11, 0x7a, 0xd9, 0x6a, 0xd9, 0x30, 0x79, 0x32, 0xf0, 0x3d, 0x94, 0x86,
+	0xde, 0xc7, 0x27, 0x5c, 0x8e, 0xa4, 0xb9, 0x94, 0x99, 0xc9, 0x5c, 0xc3, 0x8d, 0x66, 0xd2, 0x2c,
+	0x87, 0xc9, 0xda, 0xa2, 0x6a, 0x9a, 0xac, 0xe2, 0xbb, 0xc0, 0x5f, 0xe1, 0x42, 0x6f, 0x23, 0x9c,
+	0x7b, 0xd3, 0xc7, 0x33, 0x6f, 0x6a, 0xcc, 0xf4, 0x8c, 0xaa, 0x71, 0x8d, 0xe9, 0x17, 0x55, 0x6d,
+	0x89, 0x79, 0xae, 0xc2, 0xae, 0xae, 0x30, 0xd7, 0x23, 0x73, 0x18, 0x37, 0x40, 0x0e, 0xa3, 0x31,
+	0x34, 0xde, 0x3f, 0xfd, 0x54, 0x5e, 0x30, 0xca, 0xfb, 0x8c, 0xf2, 0x42, 0x57, 0x60, 0x94, 0xbf,
+	0xa8, 0x96, 0x19, 0xc4, 0x2a, 0x91, 0x48, 0x72, 0x08, 0x0f, 0x70, 0xc7, 0xe2, 0x22, 0x33, 0xca,
+	0x8b, 0xde, 0x70, 0xf7, 0x18, 0x1a, 0xdf, 0xae, 0xf4, 0xf3, 0xb1, 0xf3, 0x7c, 0x88, 0x7e, 0x82,
+	0xf0, 0x58, 0x3a, 0x1c, 0xd7, 0xb6, 0x4c, 0x97, 0x91, 0x05, 0x9c, 0x31, 0x22, 0xe6, 0xa2, 0x2d,
+	0xec, 0xc3, 0x68, 0x6c, 0xdb, 0x78, 0xff, 0xf4, 0x64, 0x3e, 0xa5, 0xb0, 0xf9, 0x79, 0xdd, 0x8f,
+	0x59, 0x30, 0x82, 0x8c, 0x73, 0x8c, 0xb9, 0x85, 0xed, 0x77, 0xea, 0xb9, 0x2e, 0x65, 0xc8, 0x88,
+	0xcf, 0x47, 0x6f, 0x21, 0x9c, 0x4d, 0x01, 0x13, 0x48, 0x73, 0x1a, 0xf7, 0x89, 0xd9, 0x8b, 0x86,
+	0x0e, 0xca, 0x8c, 0xf2, 0xf9, 0x7d, 0xd5, 0xf3, 0x81, 0xd4, 0x55, 0x5f, 0x13, 0xdf, 0x6b, 0x5e,
+	0x87, 0xf9, 0x7a, 0x6d, 0x78, 0x6e, 0x47, 0x94, 0x8f, 0xd3, 0x6b, 0x14, 0x6a, 0xa2, 0xe3, 0xa1,
+	0x04, 0x4d, 0x00, 0xd2, 0xa6, 0x24, 0x21, 0x71, 0x49, 0xe8, 0x5d, 0x84, 0x9f, 0x49, 0x2b, 0xcf,
+	0x9c, 0xe5, 0xcc, 0x0a, 0xbe, 0x5b, 0xbd, 0x6e, 0x0e, 0xe0, 0x9d, 0xb6, 0xe5, 0x70, 0x89, 0x7d,
+	0x75, 0xfa, 0x94, 0x1e, 0xff, 0x71, 0x5e, 0x27, 0xa3, 0x18, 0x83, 0xc4, 0xbe, 0x6d, 0x1b, 0xb7,
+	0xf5, 0xc1, 0x48, 0x82, 0xb4, 0xdb, 0xe3, 0xd2, 0x7e, 0x86, 0xf0, 0x44, 0x3b, 0x84, 0x40, 0xe5,
+	0x2b, 0x5b, 0xb8, 0xf2, 0x92, 0xd7, 0xdc, 0x7b, 0x78, 0x84, 0xe3, 0xb9, 0x64, 0x79, 0x6a, 0x45,
+	0x61, 0x5a, 0x95, 0xbb, 0x6e, 0xd5, 0x6a, 0xa3, 0x5f, 0x21, 0x2c, 0x25, 0xe5, 0x07, 0x7e, 0xd7,
+	0x71, 0x9f, 0xc3, 0xb4, 0x6a, 0x71, 0x81, 0xb1, 0x80, 0xd4, 0x48, 0x53, 0xc1, 0x82, 0x52, 0xcd,
+	0x5a, 0x86, 0x59, 0x38, 0xeb, 0x27, 0x5f, 0xaf, 0xe7, 0xf6, 0xd6, 0xd4, 0xe5, 0xca, 0x29, 0x1a,
+	0x46, 0xd2, 0xef, 0x7f, 0xcb, 0x8d, 0x97, 0x0d, 0x6f, 0x71, 0xa5, 0x94, 0xd7, 0xac, 0x65, 0x19,
+	0xce, 0x3e, 0xf1, 0x33, 0xe9, 0xea, 0x4b, 0xb2, 0x57, 0xb3, 0x99, 0xcb, 0x93, 0xb8, 0x4a, 0xaf,
+	0x03, 0x28, 0xe8, 0xbb, 0x78, 0xb8, 0x81, 0xed, 0x8c, 0xb6, 0xb4, 0xb5, 0xd4, 0xbf, 0x44, 0x78,
+	0x24, 0x21, 0x3d, 0x30, 0xaf, 0xe1, 0x5e, 0x55, 0x5b, 0x6a, 0x93, 0xf8, 0x2c, 0x10, 0xdf, 0x23,
+	0x88, 0x07, 0x81, 0x9d, 0xf1, 0xde, 0xa9, 0x0a, 0x08, 0xf4, 0x0a, 0x3e, 0xd8, 0xc0, 0x75, 0xc9,
+	0x58, 0x66, 0xd6, 0x8a, 0xb7, 0xb5, 0xd4, 0xbf, 0x45, 0x78, 0x34, 0x65, 0x0a, 0xa0, 0x7f, 0x0b,
+	0xe1, 0x01, 0x4f, 0x8c, 0xb7, 0xa9, 0xc1, 0x39, 0xd0, 0x60, 0x48, 0x68, 0x10, 0x0d, 0xee, 0x4c,
+	0x87, 0x7e, 0xaf, 0x81, 0x87, 0x7e, 0x1d, 0x1c, 0x75, 0xb3, 0xd6, 0x8a, 0xe9, 0x31, 0xc7, 0x56,
+	0x1d, 0xaf, 0x76, 0x46, 0xd7, 0x1d, 0xe6, 0x86, 0x7a, 0x3c, 0xd7, 0xb4, 0xeb, 0x7d, 0x41, 0xfa,
+	0x0a, 0xfb, 0xd6, 0xeb, 0xb9, 0x41, 0x81, 0xa4, 0x61, 0xa3, 0xd1, 0xc3, 0x60, 0x16, 0xef, 0x71,
+	0x58, 0x45, 0xad, 0x31, 0xa7, 0xa8, 0x8a, 0x7c, 0xe2, 0x30, 0x29, 0x48, 0xeb, 0xf5, 0xdc, 0xfe,
+	0x60, 0x05, 0x37, 0x39, 0x50, 0x65, 0x37, 0x8c, 0x00, 0x02, 0x5a, 0xc5, 0x63, 0xe9, 0xe8, 0x40,
+	0x4a, 0x05, 0x67, 0xb4, 0x88, 0x39, 0x9c, 0x4d, 0x00, 0xcd, 0xad, 0xd7, 0x73, 0xff, 0x03, 0xa0,
+	0x09, 0x5e, 0x54, 0x19, 0xd2, 0xe2, 0xb9, 0xa9, 0x86, 0x07, 0xf9, 0xbc, 0x17, 0xd5, 0x1a, 0x63,
+	0x8f, 0xa7, 0xc3, 0x30, 0xde, 0x09, 0xa4, 0xe0, 0x30, 0x0d, 0x1e, 0xe9, 0x5b, 0x98, 0x44, 0x27,
+	0x01, 0x3a, 0x2f, 0xe2, 0x5d, 0xb6, 0x3f, 0xf0, 0x08, 0x8f, 0xe1, 0xf5, 0x7a, 0x2e, 0x23, 0x26,
+	0x6a, 0x32, 0x53, 0x65, 0x80, 0x3f, 0x07, 0xc8, 0x33, 0x61, 0x52, 0x47, 0x5d, 0x0e, 0x4a, 0x48,
+	0x2f, 0xe0, 0xa1, 0xa6, 0x51, 0x98, 0x6b, 0x06, 0xf7, 0xd8, 0x7c, 0x04, 0x96, 0x79, 0x2e, 0xf5,
+	0x40, 0x85, 0x40, 0x70, 0xa7, 0x9f, 0x06, 0x6f, 0xea, 0x39, 0xc6, 0x5e, 0x31, 0xd5, 0x52, 0x85,
+	0xe9, 0x70, 0x74, 0xff, 0x17, 0x4d, 0xcc, 0x37, 0xc1, 0x22, 0x4e, 0x42, 0x03, 0x54, 0x6f, 0x20,
+	0x9c, 0x59, 0x60, 0xac, 0xc8, 0x84, 0xbd, 0x08, 0x05, 0x0a, 0x36, 0xde, 0x44, 0x2a, 0xf3, 0x58,
+	0xce, 0xc2, 0x61, 0xd8, 0x89, 0xb0, 0xac, 0x92, 0xb2, 0x52, 0x85, 0x2c, 0xc4, 0xb0, 0xd0, 0x9b,
+	0xc1, 0xb1, 0x10, 0xcb, 0x19, 0x88, 0x76, 0xb4, 0xf1, 0xe6, 0x15, 0x65, 0x27, 0xeb, 0xf5, 0xdc,
+	0x6e, 0x28, 0xbb, 0x30, 0xd0, 0xf0, 0x6d, 0xdc, 0xbc, 0x1e, 0xbb, 0xdb, 0x5b, 0x8f, 0xf4, 0xed,
+	0xb4, 0xca, 0x45, 0x56, 0x45, 0x7f, 0x84, 0x13, 0x07, 0xd2, 0x5b, 0xd8, 0xbf, 0x5e, 0xcf, 0x91,
+	0x18, 0x61, 0xaa, 0xe0, 0x06, 0x4f, 0x7a, 0x39, 0xd8, 0xad, 0x15, 0x83, 0x99, 0xde, 0x65, 0x5b,
+	0x57, 0x3d, 0x56, 0xf0, 0x77, 0x97, 0xd1, 0x38, 0x5c, 0xa7, 0x70, 0x9f, 0xc6, 0xcd, 0x0d, 0x8e,
+	0x99, 0xc6, 0x2b, 0x2d, 0x34, 0x51, 0xa5, 0x57, 0xfc, 0x9f, 0xd7, 0xa9, 0x83, 0x0f, 0x6d, 0x90,
+	0x16, 0x40, 0xbf, 0x8e, 0x7b, 0x4b, 0x30, 0x06, 0x25, 0x3d, 0x9a, 0x5a, 0xd2, 0x58, 0xa2, 0x5a,
+	0x70, 0x82, 0x07, 0x29, 0x1a, 0xa5, 0x3a, 0xaf, 0x9a, 0xba, 0xbb, 0xa8, 0x2e, 0xc5, 0x88, 0xfc,
+	0x0b, 0xa5, 0xaa, 0xe0, 0x6c, 0x1a, 0x06, 0x60, 0xfd, 0x6a, 0x8c, 0xf5, 0x78, 0x2a, 0xeb, 0xe6,
+	0x2c, 0x31, 0xca, 0xd3, 0x7f, 0xec, 0xc3, 0x3b, 0xf8, 0x74, 0xe4, 0x47, 0x84, 0x87, 0x12, 0xfa,
+	0x33, 0x72, 0x22, 0x35, 0x77, 0x8b, 0x1b, 0x8d, 0x74, 0x72, 0x13, 0x91, 0x82, 0x22, 0x9d, 0xbc,
+	0xf9, 0xcb, 0xef, 0x5f, 0x74, 0x3f, 0x4d, 0x8e, 0xc8, 0x70, 0x07, 0x0b, 0xef, 0x5e, 0x49, 0x9d,
+	0x21, 0xb9, 0xdd, 0x8d, 0x49, 0x3c, 0x1d, 0x99, 0xe9, 0x14, 0x40, 0x80, 0xfc, 0x44, 0xe7, 0x81,
+	0x00, 0xfc, 0x26, 0xe2, 0xc8, 0xaf, 0x93, 0x6b, 0xed, 0x20, 0x97, 0xfd, 0x95, 0x22, 0xaf, 0x86,
+	0x8d, 0x47, 0x1e, 0xd6, 0xd0, 0x5a, 0x78, 0x95, 0x8c, 0xd8, 0x1a, 0xeb, 0x65, 0x4d, 0x76, 0x7d,
+	0xa0, 0xa6, 0xc6, 0xa2, 0xf6, 0x60, 0x6c, 0x8d, 0xfc, 0x89, 0xf0, 0xe8, 0x86, 0xad, 0x36, 0x29,
+	0x74, 0x5c, 0x9a, 0xd8, 0xc5, 0x43, 0x9a, 0x7d, 0xac, 0x1c, 0xa0, 0xd7, 0x59, 0x2e, 0xd7, 0x4b,
+	0xe4, 0x85, 0xb6, 0x0a, 0x2d, 0xaf, 0x86, 0x02, 0xad, 0x46, 0xe4, 0x20, 0x7f, 0x23, 0xbc, 0xab,
+	0xa9, 0xd7, 0x26, 0xd3, 0x1b, 0x83, 0x4b, 0x6a, 0xfc, 0xa5, 0x63, 0x1d, 0xc5, 0x00, 0x81, 0x0f,
+	0x39, 0x81, 0x0f, 0x48, 0x35, 0x46, 0xc0, 0xf3, 0xfd, 0x8b, 0x61, 0xbf, 0xfe, 0x84, 0x6a, 0xfd,
+	0x17, 0xc2, 0x03, 0xd1, 0x5e, 0x9b, 0x4c, 0xb5, 0xc1, 0xa2, 0xb9, 0xed, 0x97, 0xa6, 0x3b, 0x09,
+	0x01, 0xde, 0x6b, 0x9c, 0xf7, 0xfb, 0x64, 0x25, 0x85, 0x77, 0xd0, 0xae, 0x3f, 0x21, 0xda, 0xb7,
+	0xba, 0xf1, 0xde, 0x47, 0xfb, 0x6c, 0x72, 0xbc, 0x0d, 0x1e, 0xf1, 0xd6, 0x5f, 0x7a, 0xbe, 0xd3,
+	0x30, 0x90, 0xe0, 0x86, 0xd8, 0xeb, 0xab, 0xa4, 0x96, 0xa2, 0x41, 0xb4, 0x5d, 0x7f, 0x42, 0x3a,
+	0xdc, 0x47, 0x78, 0x28, 0xa1, 0x4f, 0x6e, 0x75, 0x6a, 0xa7, 0x37, 0xfe, 0xd2, 0xc9, 0x4d, 0x44,
+	0x82, 0x20, 0x97, 0xb8, 0x1e, 0x17, 0xc8, 0x6b, 0x31, 0x3d, 0x92, 0xba, 0x70, 0x79, 0xf5, 0x91,
+	0x9b, 0x40, 0x44, 0x8a, 0xe8, 0xe6, 0xfe, 0x0e, 0xe1, 0x1d, 0xbc, 0x5b, 0x26, 0x13, 0x1b, 0x43,
+	0x8b, 0xf6, 0xed, 0xd2, 0xd1, 0xb6, 0x7c, 0x01, 0xf8, 0x39, 0x0e, 0xfc, 0x0c, 0x79, 0x39, 0x0e,
+	0x5c, 0x00, 0x71, 0x9b, 0x20, 0xc9, 0x00, 0xbc, 0x41, 0x61, 0x4d, 0xe6, 0xfd, 0x38, 0xf9, 0x08,
+	0xe1, 0x1e, 0xd1, 0x35, 0x93, 0x96, 0x00, 0x22, 0xad, 0xba, 0xf4, 0x6c, 0x7b, 0xce, 0x00, 0x37,
+	0xc7, 0xe1, 0x8e, 0x90, 0x03, 0x31, 0xb8, 0xa2, 0x53, 0x27, 0x3f, 0x21, 0x9c, 0x49, 0x6a, 0x9c,
+	0x48, 0xab, 0xe2, 0xa6, 0xf7, 0x70, 0xd2, 0xa9, 0xcd, 0x84, 0x02, 0xe0, 0xe3, 0x1c, 0xb0, 0x4c,
+	0x26, 0xe3, 0xfa, 0xf2, 0x30, 0x5f, 0xde, 0xa0, 0x09, 0x5c, 0x93, 0x83, 0xe6, 0x84, 0xfc, 0x8c,
+	0xf0, 0x60, 0xac, 0x0d, 0x22, 0x2d, 0xb6, 0x6b, 0x5a, 0xef, 0x26, 0xcd, 0x74, 0x1c, 0xb7, 0xc9,
+	0xd5, 0xe1, 0xef, 0xec, 0xe8, 0xab, 0x2a, 0xe4, 0xf3, 0x03, 0xc2, 0x24, 0x7e, 0x5b, 0x69, 0xd5,
+	0xa6, 0xa4, 0xde, 0xb6, 0xa4, 0x13, 0x9d, 0x07, 0x02, 0xa5, 0xff, 0x73, 0x4a, 0x59, 0x72, 0x30,
+	0x46, 0x29, 0xd2, 0xe7, 0x93, 0xbb, 0x08, 0x0f, 0xc6, 0x92, 0xb4, 0xd2, 0x3f, 0xed, 0x9a, 0x23,
+	0xcd, 0x74, 0x1c, 0x07, 0x60, 0xcf, 0x73, 0xb0, 0x05, 0x72, 0x7a, 0x23, 0xb0, 0xc1, 0xf9, 0x1a,
+	0x3b, 0x55, 0x23, 0x95, 0x29, 0xbc, 0x71, 0xe7, 0x41, 0x16, 0xdd, 0x7b, 0x90, 0x45, 0xf7, 0x1f,
+	0x64, 0xd1, 0xe7, 0x0f, 0xb3, 0x5d, 0xf7, 0x1e, 0x66, 0xbb, 0x7e, 0x7d, 0x98, 0xed, 0x7a, 0xe7,
+	0x78, 0xfc, 0x4b, 0x8a, 0x51, 0xd2, 0x26, 0xcb, 0x96, 0x5c, 0x3d, 0x26, 0x2f, 0x5b, 0xfa, 0x4a,
+	0x85, 0xb9, 0x62, 0xea, 0xe9, 0x93, 0x93, 0xfe, 0xec, 0xfc, 0xe3, 0x4a, 0xa9, 0x87, 0x7f, 0xdf,
+	0x3f, 0xf6, 0xcf, 0x00, 0xa5, 0x07, 0x70, 0xe0, 0x0c, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1076,6 +1480,14 @@ type QueryClient interface {
 	TotalTimeoutFees(ctx context.Context, in *QueryTotalTimeoutFeesRequest, opts ...grpc.CallOption) (*QueryTotalTimeoutFeesResponse, error)
 	// CounterpartyAddress returns the registered counterparty address for forward relaying
 	CounterpartyAddress(ctx context.Context, in *QueryCounterpartyAddressRequest, opts ...grpc.CallOption) (*QueryCounterpartyAddressResponse, error)
+	// Payee returns the registered payee address for a specific channel given the relayer address
+	Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error)
+	// Params queries all parameters of the ICS29 fee middleware
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ClientUpdateBounties returns the bounties escrowed for the update of a client
+	ClientUpdateBounties(ctx context.Context, in *QueryClientUpdateBountiesRequest, opts ...grpc.CallOption) (*QueryClientUpdateBountiesResponse, error)
+	// HandshakeBounties returns the bounties escrowed for the completion of a channel handshake
+	HandshakeBounties(ctx context.Context, in *QueryHandshakeBountiesRequest, opts ...grpc.CallOption) (*QueryHandshakeBountiesResponse, error)
 	// FeeEnabledChannels returns a list of all fee enabled channels
 	FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error)
 	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
@@ -1153,6 +1565,42 @@ func (c *queryClient) CounterpartyAddress(ctx context.Context, in *QueryCounterp
 	return out, nil
 }
 
+func (c *queryClient) Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error) {
+	out := new(QueryPayeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/Payee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientUpdateBounties(ctx context.Context, in *QueryClientUpdateBountiesRequest, opts ...grpc.CallOption) (*QueryClientUpdateBountiesResponse, error) {
+	out := new(QueryClientUpdateBountiesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/ClientUpdateBounties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HandshakeBounties(ctx context.Context, in *QueryHandshakeBountiesRequest, opts ...grpc.CallOption) (*QueryHandshakeBountiesResponse, error) {
+	out := new(QueryHandshakeBountiesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/HandshakeBounties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error) {
 	out := new(QueryFeeEnabledChannelsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/FeeEnabledChannels", in, out, opts...)
@@ -1187,6 +1635,14 @@ type QueryServer interface {
 	TotalTimeoutFees(context.Context, *QueryTotalTimeoutFeesRequest) (*QueryTotalTimeoutFeesResponse, error)
 	// CounterpartyAddress returns the registered counterparty address for forward relaying
 	CounterpartyAddress(context.Context, *QueryCounterpartyAddressRequest) (*QueryCounterpartyAddressResponse, error)
+	// Payee returns the registered payee address for a specific channel given the relayer address
+	Payee(context.Context, *QueryPayeeRequest) (*QueryPayeeResponse, error)
+	// Params queries all parameters of the ICS29 fee middleware
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ClientUpdateBounties returns the bounties escrowed for the update of a client
+	ClientUpdateBounties(context.Context, *QueryClientUpdateBountiesRequest) (*QueryClientUpdateBountiesResponse, error)
+	// HandshakeBounties returns the bounties escrowed for the completion of a channel handshake
+	HandshakeBounties(context.Context, *QueryHandshakeBountiesRequest) (*QueryHandshakeBountiesResponse, error)
 	// FeeEnabledChannels returns a list of all fee enabled channels
 	FeeEnabledChannels(context.Context, *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error)
 	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
@@ -1218,8 +1674,20 @@ func (*UnimplementedQueryServer) TotalTimeoutFees(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) CounterpartyAddress(ctx context.Context, req *QueryCounterpartyAddressRequest) (*QueryCounterpartyAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CounterpartyAddress not implemented")
 }
-func (*UnimplementedQueryServer) FeeEnabledChannels(ctx context.Context, req *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannels not implemented")
+func (*UnimplementedQueryServer) Payee(ctx context.Context, req *QueryPayeeRequest) (*QueryPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Payee not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ClientUpdateBounties(ctx context.Context, req *QueryClientUpdateBountiesRequest) (*QueryClientUpdateBountiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientUpdateBounties not implemented")
+}
+func (*UnimplementedQueryServer) HandshakeBounties(ctx context.Context, req *QueryHandshakeBountiesRequest) (*QueryHandshakeBountiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandshakeBounties not implemented")
+}
+func (*UnimplementedQueryServer) FeeEnabledChannels(ctx context.Context, req *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannels not implemented")
 }
 func (*UnimplementedQueryServer) FeeEnabledChannel(ctx context.Context, req *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannel not implemented")
@@ -1355,6 +1823,78 @@ func _Query_CounterpartyAddress_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Payee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Payee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/Payee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Payee(ctx, req.(*QueryPayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientUpdateBounties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientUpdateBountiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientUpdateBounties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/ClientUpdateBounties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientUpdateBounties(ctx, req.(*QueryClientUpdateBountiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HandshakeBounties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHandshakeBountiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HandshakeBounties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/HandshakeBounties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HandshakeBounties(ctx, req.(*QueryHandshakeBountiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FeeEnabledChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFeeEnabledChannelsRequest)
 	if err := dec(in); err != nil {
@@ -1423,6 +1963,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CounterpartyAddress",
 			Handler:    _Query_CounterpartyAddress_Handler,
 		},
+		{
+			MethodName: "Payee",
+			Handler:    _Query_Payee_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ClientUpdateBounties",
+			Handler:    _Query_ClientUpdateBounties_Handler,
+		},
+		{
+			MethodName: "HandshakeBounties",
+			Handler:    _Query_HandshakeBounties_Handler,
+		},
 		{
 			MethodName: "FeeEnabledChannels",
 			Handler:    _Query_FeeEnabledChannels_Handler,
@@ -1952,6 +2508,131 @@ func (m *QueryCounterpartyAddressResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPayeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayeeAddress) > 0 {
+		i -= len(m.PayeeAddress)
+		copy(dAtA[i:], m.PayeeAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PayeeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryFeeEnabledChannelsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2099,64 +2780,205 @@ func (m *QueryFeeEnabledChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryClientUpdateBountiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryClientUpdateBountiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientUpdateBountiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClientUpdateBountiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientUpdateBountiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientUpdateBountiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IncentivizedPackets) > 0 {
-		for _, e := range m.IncentivizedPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Bounties) > 0 {
+		for iNdEx := len(m.Bounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+func (m *QueryHandshakeBountiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIncentivizedPacketResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryHandshakeBountiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHandshakeBountiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHandshakeBountiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHandshakeBountiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHandshakeBountiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for iNdEx := len(m.Bounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -2313,6 +3135,58 @@ func (m *QueryCounterpartyAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPayeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PayeeAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryFeeEnabledChannelsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2370,16 +3244,786 @@ func (m *QueryFeeEnabledChannelResponse) Size() (n int) {
 	if m.FeeEnabled {
 		n += 2
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryClientUpdateBountiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientUpdateBountiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for _, e := range m.Bounties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHandshakeBountiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHandshakeBountiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounties) > 0 {
+		for _, e := range m.Bounties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivizedPackets = append(m.IncentivizedPackets, IdentifiedPacketFees{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivizedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivizedPackets = append(m.IncentivizedPackets, &IdentifiedPacketFees{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2402,15 +4046,15 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2437,32 +4081,11 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecvFees = append(m.RecvFees, types1.Coin{})
+			if err := m.RecvFees[len(m.RecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
-			}
-			m.QueryHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2484,7 +4107,7 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2507,15 +4130,15 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalAckFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalAckFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2542,8 +4165,7 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentivizedPackets = append(m.IncentivizedPackets, IdentifiedPacketFees{})
-			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2568,7 +4190,7 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2591,15 +4213,15 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalAckFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalAckFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2626,29 +4248,11 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AckFees = append(m.AckFees, types1.Coin{})
+			if err := m.AckFees[len(m.AckFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
-			}
-			m.QueryHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2670,7 +4274,7 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2693,15 +4297,15 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2728,7 +4332,7 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncentivizedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2753,7 +4357,7 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2776,15 +4380,15 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2811,16 +4415,64 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimeoutFees = append(m.TimeoutFees, types1.Coin{})
+			if err := m.TimeoutFees[len(m.TimeoutFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCounterpartyAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCounterpartyAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCounterpartyAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2848,11 +4500,11 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2880,27 +4532,8 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
-			}
-			m.QueryHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2922,7 +4555,7 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCounterpartyAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2945,17 +4578,17 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCounterpartyAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCounterpartyAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2965,25 +4598,23 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentivizedPackets = append(m.IncentivizedPackets, &IdentifiedPacketFees{})
-			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CounterpartyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3006,7 +4637,7 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3016,30 +4647,62 @@ func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPayeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3049,24 +4712,23 @@ func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3089,7 +4751,7 @@ func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3112,17 +4774,17 @@ func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3132,25 +4794,23 @@ func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvFees = append(m.RecvFees, types1.Coin{})
-			if err := m.RecvFees[len(m.RecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PayeeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3173,7 +4833,7 @@ func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3196,45 +4856,12 @@ func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalAckFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalAckFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3256,7 +4883,7 @@ func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3279,15 +4906,15 @@ func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalAckFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalAckFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3314,8 +4941,10 @@ func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckFees = append(m.AckFees, types1.Coin{})
-			if err := m.AckFees[len(m.AckFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3340,7 +4969,7 @@ func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3363,15 +4992,15 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3398,10 +5027,32 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3423,7 +5074,7 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3446,15 +5097,15 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3481,8 +5132,8 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutFees = append(m.TimeoutFees, types1.Coin{})
-			if err := m.TimeoutFees[len(m.TimeoutFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
+			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3507,7 +5158,7 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCounterpartyAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3530,15 +5181,15 @@ func (m *QueryCounterpartyAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCounterpartyAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCounterpartyAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3566,11 +5217,11 @@ func (m *QueryCounterpartyAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3598,7 +5249,7 @@ func (m *QueryCounterpartyAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3621,7 +5272,7 @@ func (m *QueryCounterpartyAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCounterpartyAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3644,17 +5295,17 @@ func (m *QueryCounterpartyAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCounterpartyAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCounterpartyAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3664,24 +5315,12 @@ func (m *QueryCounterpartyAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CounterpartyAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.FeeEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3703,7 +5342,7 @@ func (m *QueryCounterpartyAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientUpdateBountiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3726,17 +5365,17 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientUpdateBountiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientUpdateBountiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3746,47 +5385,24 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
-			}
-			m.QueryHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3808,7 +5424,7 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClientUpdateBountiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3831,15 +5447,15 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientUpdateBountiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientUpdateBountiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3866,8 +5482,8 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
-			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bounties = append(m.Bounties, ClientUpdateBounty{})
+			if err := m.Bounties[len(m.Bounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3892,7 +5508,7 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHandshakeBountiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3915,10 +5531,10 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHandshakeBountiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHandshakeBountiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4006,7 +5622,7 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHandshakeBountiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4029,17 +5645,17 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHandshakeBountiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHandshakeBountiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4049,12 +5665,26 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FeeEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounties = append(m.Bounties, HandshakeBounty{})
+			if err := m.Bounties[len(m.Bounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,371 @@
+package callbacks
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ porttypes.Middleware = IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the callbacks middleware given the underlying
+// application, the ICS4Wrapper and the contract keeper executing the callbacks.
+type IBCMiddleware struct {
+	app         types.CallbacksCompatibleModule
+	ics4Wrapper porttypes.ICS4Wrapper
+
+	contractKeeper types.ContractKeeper
+
+	// maxCallbackGas is the maximum amount of gas a callback may consume
+	maxCallbackGas uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the underlying application, the ICS4Wrapper,
+// the contract keeper and the maximum callback gas. It panics if the underlying application does not
+// implement CallbacksCompatibleModule.
+func NewIBCMiddleware(
+	app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper,
+	contractKeeper types.ContractKeeper, maxCallbackGas uint64,
+) IBCMiddleware {
+	packetDataUnmarshalerApp, ok := app.(types.CallbacksCompatibleModule)
+	if !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*types.CallbacksCompatibleModule)(nil)))
+	}
+
+	if ics4Wrapper == nil {
+		panic(fmt.Errorf("ICS4Wrapper cannot be nil"))
+	}
+
+	if contractKeeper == nil {
+		panic(fmt.Errorf("contract keeper cannot be nil"))
+	}
+
+	if maxCallbackGas == 0 {
+		panic(fmt.Errorf("maximum callback gas cannot be zero"))
+	}
+
+	return IBCMiddleware{
+		app:            packetDataUnmarshalerApp,
+		ics4Wrapper:    ics4Wrapper,
+		contractKeeper: contractKeeper,
+		maxCallbackGas: maxCallbackGas,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// The destination callback registered in the packet data, if any, is executed once the underlying
+// application returns a successful acknowledgement. For asynchronous acknowledgements the callback
+// is executed when the acknowledgement is written through WriteAcknowledgement. A failing callback
+// does not affect the acknowledgement.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	im.processDestCallback(ctx, packet, ack)
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// The source callback registered in the packet data, if any, is executed once the underlying
+// application has processed the acknowledgement. A failing callback does not affect the packet lifecycle.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.processSourceCallback(ctx, packet, types.CallbackTypeAcknowledgementPacket, func(cachedCtx sdk.Context, callbackData types.CallbackData) error {
+		return im.contractKeeper.IBCOnAcknowledgementPacketCallback(
+			cachedCtx, packet, acknowledgement, relayer, callbackData.CallbackAddress, callbackData.SenderAddress,
+		)
+	})
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// The source callback registered in the packet data, if any, is executed once the underlying
+// application has processed the timeout. A failing callback does not affect the packet lifecycle.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.processSourceCallback(ctx, packet, types.CallbackTypeTimeoutPacket, func(cachedCtx sdk.Context, callbackData types.CallbackData) error {
+		return im.contractKeeper.IBCOnTimeoutPacketCallback(
+			cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress,
+		)
+	})
+
+	return nil
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	return im.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface.
+// The destination callback registered in the packet data, if any, is executed once a successful
+// asynchronous acknowledgement is written.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	if err := im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+
+	if ack.Success() {
+		im.processDestCallback(ctx, packet, ack)
+	}
+
+	return nil
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// processSourceCallback executes the source callback registered in the data of the given packet, if any.
+func (im IBCMiddleware) processSourceCallback(
+	ctx sdk.Context, packet channeltypes.Packet, callbackType types.CallbackType,
+	callbackExecutor func(sdk.Context, types.CallbackData) error,
+) {
+	packetData, err := im.getCallbackPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return
+	}
+
+	callbackData, found, err := types.GetSourceCallbackData(packetData, packet.GetSourcePort(), types.GetRemainingGas(ctx.GasMeter()), im.maxCallbackGas)
+	if err != nil {
+		im.logger(ctx).Error("failed to parse source callback data", "port-id", packet.GetSourcePort(), "channel-id", packet.GetSourceChannel(), "sequence", packet.GetSequence(), "error", err.Error())
+		return
+	}
+
+	if !found {
+		return
+	}
+
+	err = im.processCallback(ctx, callbackType, callbackData, func(cachedCtx sdk.Context) error {
+		return callbackExecutor(cachedCtx, callbackData)
+	})
+
+	emitCallbackEvent(ctx, types.EventTypeSourceCallback, packet, callbackType, callbackData, err)
+}
+
+// processDestCallback executes the destination callback registered in the data of the given packet, if any.
+func (im IBCMiddleware) processDestCallback(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) {
+	packetData, err := im.getCallbackPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
+		return
+	}
+
+	callbackData, found, err := types.GetDestCallbackData(packetData, types.GetRemainingGas(ctx.GasMeter()), im.maxCallbackGas)
+	if err != nil {
+		im.logger(ctx).Error("failed to parse destination callback data", "port-id", packet.GetDestPort(), "channel-id", packet.GetDestChannel(), "sequence", packet.GetSequence(), "error", err.Error())
+		return
+	}
+
+	if !found {
+		return
+	}
+
+	err = im.processCallback(ctx, types.CallbackTypeReceivePacket, callbackData, func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress)
+	})
+
+	emitCallbackEvent(ctx, types.EventTypeDestinationCallback, packet, types.CallbackTypeReceivePacket, callbackData, err)
+}
+
+// processCallback executes the callback in a cached context with a gas meter limited to the execution gas
+// limit of the callback. The state changes and events of the callback are only written if it succeeds.
+// The gas consumed by the callback is charged to the given context. Panics of the callback are recovered
+// and returned as errors, unless the callback ran out of gas and may be retried with more gas, in which
+// case the out of gas panic is propagated to fail the transaction.
+func (im IBCMiddleware) processCallback(
+	ctx sdk.Context, callbackType types.CallbackType, callbackData types.CallbackData,
+	callbackExecutor func(sdk.Context) error,
+) (err error) {
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithGasMeter(sdk.NewGasMeter(callbackData.ExecutionGasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				if callbackData.AllowRetry() {
+					panic(sdk.ErrorOutOfGas{Descriptor: fmt.Sprintf(
+						"ibc %s callback out of gas; commit gas limit: %d, execution gas limit: %d",
+						callbackType, callbackData.CommitGasLimit, callbackData.ExecutionGasLimit,
+					)})
+				}
+
+				err = sdkerrors.Wrapf(types.ErrCallbackOutOfGas, "ibc %s callback out of gas; gas limit: %d", callbackType, callbackData.ExecutionGasLimit)
+			} else {
+				err = sdkerrors.Wrapf(types.ErrCallbackPanic, "ibc %s callback panicked: %v", callbackType, r)
+			}
+		}
+
+		// the gas consumed by the callback is charged, whether or not it succeeded
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback", callbackType))
+
+		if err != nil {
+			im.logger(ctx).Info("ibc callback failed", "callback-type", callbackType, "callback-address", callbackData.CallbackAddress, "error", err.Error())
+			return
+		}
+
+		writeFn()
+		// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}()
+
+	return callbackExecutor(cachedCtx)
+}
+
+// getCallbackPacketData decodes the packet data of the underlying application and returns it if it
+// supports callbacks.
+func (im IBCMiddleware) getCallbackPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (types.CallbackPacketData, error) {
+	data, err := im.app.UnmarshalPacketData(ctx, portID, channelID, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	packetData, ok := data.(types.CallbackPacketData)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotCallbackPacketData, "expected %T, got %T", (*types.CallbackPacketData)(nil), data)
+	}
+
+	return packetData, nil
+}
+
+// logger returns a module-specific logger.
+func (im IBCMiddleware) logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// emitCallbackEvent emits an event recording the result of the execution of a callback
+func emitCallbackEvent(
+	ctx sdk.Context, eventType string, packet ibcexported.PacketI, callbackType types.CallbackType,
+	callbackData types.CallbackData, err error,
+) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyCallbackType, string(callbackType)),
+		sdk.NewAttribute(types.AttributeKeyCallbackAddress, callbackData.CallbackAddress),
+		sdk.NewAttribute(types.AttributeKeyCallbackExecGasLimit, fmt.Sprintf("%d", callbackData.ExecutionGasLimit)),
+		sdk.NewAttribute(types.AttributeKeyCallbackCommitGasLimit, fmt.Sprintf("%d", callbackData.CommitGasLimit)),
+		sdk.NewAttribute(types.AttributeKeyCallbackSourcePortID, packet.GetSourcePort()),
+		sdk.NewAttribute(types.AttributeKeyCallbackSourceChannelID, packet.GetSourceChannel()),
+		sdk.NewAttribute(types.AttributeKeyCallbackDestPortID, packet.GetDestPort()),
+		sdk.NewAttribute(types.AttributeKeyCallbackDestChannelID, packet.GetDestChannel()),
+		sdk.NewAttribute(types.AttributeKeyCallbackSequence, fmt.Sprintf("%d", packet.GetSequence())),
+	}
+
+	if err == nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCallbackResult, types.AttributeValueCallbackSuccess))
+	} else {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyCallbackResult, types.AttributeValueCallbackFailure),
+			sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+}
@@ -0,0 +1,493 @@
+package callbacks_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v3/modules/apps/29-fee"
+	feetypes "github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks"
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+type CallbacksTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *CallbacksTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestCallbacksTestSuite(t *testing.T) {
+	suite.Run(t, new(CallbacksTestSuite))
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+func NewICAPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	return path
+}
+
+// SetupICAPath registers an interchain account for the owner and completes the channel handshake
+// over the connections of the path, which must already be set up.
+func SetupICAPath(path *ibctesting.Path, owner string) error {
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: path.EndpointA.ConnectionID,
+		HostConnectionId:       path.EndpointB.ConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	channelSequence := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(path.EndpointA.Chain.GetContext())
+
+	if err := path.EndpointA.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(path.EndpointA.Chain.GetContext(), path.EndpointA.ConnectionID, owner); err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	path.EndpointA.Chain.NextBlock()
+
+	// update port/channel ids
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
+// sendTransfer sends a transfer from chainA to chainB with the provided memo and timeout timestamp,
+// returning the packet sent.
+func (suite *CallbacksTestSuite) sendTransfer(memo string, timeoutTimestamp uint64) channeltypes.Packet {
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	if timeoutTimestamp != 0 {
+		timeoutHeight = clienttypes.ZeroHeight()
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, ibctesting.TestCoin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight, timeoutTimestamp, memo,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// callbackMemo returns a memo registering a callback with the given address and gas limit under the given key
+func callbackMemo(key, address, gasLimit string) string {
+	if gasLimit == "" {
+		return fmt.Sprintf(`{"%s":{"address":"%s"}}`, key, address)
+	}
+
+	return fmt.Sprintf(`{"%s":{"address":"%s","gas_limit":"%s"}}`, key, address, gasLimit)
+}
+
+// callbackResult returns the result attribute of the callback event of the given type, or an empty string
+// if no such event was emitted.
+func callbackResult(events sdk.Events, eventType string) string {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyCallbackResult {
+				return string(attr.Value)
+			}
+		}
+	}
+
+	return ""
+}
+
+func (suite *CallbacksTestSuite) TestTransferCallbacks() {
+	receiverVoucher := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, ibctesting.TestCoin.Denom),
+	).IBCDenom()
+
+	testCases := []struct {
+		name          string
+		memo          string
+		srcAddress    string
+		destAddress   string
+		expDestResult string
+	}{
+		{
+			"no callbacks registered", "", "", "", "",
+		},
+		{
+			"memo is not a json object", "memo", "", "", "",
+		},
+		{
+			"source callback succeeds",
+			callbackMemo(types.SourceCallbackKey, ibcmock.SuccessContract, ""),
+			ibcmock.SuccessContract, "", "",
+		},
+		{
+			"destination callback succeeds",
+			callbackMemo(types.DestinationCallbackKey, ibcmock.SuccessContract, ""),
+			"", ibcmock.SuccessContract, types.AttributeValueCallbackSuccess,
+		},
+		{
+			"source and destination callbacks succeed",
+			fmt.Sprintf(`{"%s":{"address":"%s"},"%s":{"address":"%s"}}`, types.SourceCallbackKey, ibcmock.SuccessContract, types.DestinationCallbackKey, ibcmock.SuccessContract),
+			ibcmock.SuccessContract, ibcmock.SuccessContract, types.AttributeValueCallbackSuccess,
+		},
+		{
+			"source callback returns an error",
+			callbackMemo(types.SourceCallbackKey, ibcmock.ErrorContract, ""),
+			ibcmock.ErrorContract, "", "",
+		},
+		{
+			"destination callback returns an error",
+			callbackMemo(types.DestinationCallbackKey, ibcmock.ErrorContract, ""),
+			"", ibcmock.ErrorContract, types.AttributeValueCallbackFailure,
+		},
+		{
+			"source callback panics",
+			callbackMemo(types.SourceCallbackKey, ibcmock.PanicContract, ""),
+			ibcmock.PanicContract, "", "",
+		},
+		{
+			"destination callback panics",
+			callbackMemo(types.DestinationCallbackKey, ibcmock.PanicContract, ""),
+			"", ibcmock.PanicContract, types.AttributeValueCallbackFailure,
+		},
+		{
+			"source callback runs out of gas",
+			callbackMemo(types.SourceCallbackKey, ibcmock.OutOfGasContract, ""),
+			ibcmock.OutOfGasContract, "", "",
+		},
+		{
+			"destination callback runs out of its gas limit",
+			callbackMemo(types.DestinationCallbackKey, ibcmock.SuccessContract, "50000"),
+			"", ibcmock.SuccessContract, types.AttributeValueCallbackFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packet := suite.sendTransfer(tc.memo, 0)
+
+			suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+
+			res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			// the acknowledgement is successful regardless of the result of the callback
+			suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), receiverVoucher)
+			suite.Require().Equal(ibctesting.TestCoin.Amount, balance.Amount)
+
+			suite.Require().Equal(tc.expDestResult, callbackResult(res.GetEvents(), types.EventTypeDestinationCallback))
+
+			// the events of the mock callback are only emitted if the callback succeeded
+			suite.Require().Equal(tc.expDestResult == types.AttributeValueCallbackSuccess, containsEvent(res.GetEvents(), ibcmock.EventTypeMockCallback))
+
+			if tc.destAddress != "" {
+				suite.Require().Equal(1, suite.chainB.GetSimApp().MockContractKeeper.Counters[tc.destAddress])
+			}
+
+			suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+			suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ack))
+
+			// the packet lifecycle is completed regardless of the result of the callback
+			commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Empty(commitment)
+
+			if tc.srcAddress != "" {
+				suite.Require().Equal(1, suite.chainA.GetSimApp().MockContractKeeper.Counters[tc.srcAddress])
+			}
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestTransferTimeoutCallback() {
+	timeoutTimestamp := uint64(suite.chainB.LastHeader.GetTime().Add(time.Minute).UnixNano())
+	packet := suite.sendTransfer(callbackMemo(types.SourceCallbackKey, ibcmock.SuccessContract, ""), timeoutTimestamp)
+
+	// advance time on chainB beyond the timeout of the packet and time out the packet on chainA
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().Equal(1, suite.chainA.GetSimApp().MockContractKeeper.Counters[ibcmock.SuccessContract])
+
+	commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().Empty(commitment)
+}
+
+func (suite *CallbacksTestSuite) TestCallbackOutOfGasRetry() {
+	packet := suite.sendTransfer(callbackMemo(types.SourceCallbackKey, ibcmock.OutOfGasContract, ""), 0)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	middleware := callbacks.NewIBCMiddleware(
+		transfer.NewIBCModule(suite.chainA.GetSimApp().TransferKeeper), suite.chainA.GetSimApp().RateLimitingKeeper,
+		suite.chainA.GetSimApp().MockContractKeeper, simapp.MaxCallbackGas,
+	)
+
+	// the gas remaining in the transaction is lower than the maximum callback gas, so that
+	// the transaction fails and the packet may be relayed again with more gas
+	ctx := suite.chainA.GetContext().WithGasMeter(sdk.NewGasMeter(simapp.MaxCallbackGas / 2))
+	func() {
+		defer func() {
+			_, ok := recover().(sdk.ErrorOutOfGas)
+			suite.Require().True(ok, "expected out of gas panic")
+		}()
+
+		_ = middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), suite.chainA.SenderAccount.GetAddress())
+	}()
+
+	// with enough gas remaining, the failed callback is committed
+	ctx = suite.chainA.GetContext().WithGasMeter(sdk.NewGasMeter(2 * simapp.MaxCallbackGas))
+	err := middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), suite.chainA.SenderAccount.GetAddress())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.AttributeValueCallbackFailure, callbackResult(ctx.EventManager().Events(), types.EventTypeSourceCallback))
+
+	// the gas consumed by the callback is charged up to its gas limit
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), simapp.MaxCallbackGas)
+}
+
+func (suite *CallbacksTestSuite) TestWriteAcknowledgementCallback() {
+	packet := suite.sendTransfer(callbackMemo(types.DestinationCallbackKey, ibcmock.SuccessContract, ""), 0)
+
+	middleware := callbacks.NewIBCMiddleware(
+		transfer.NewIBCModule(suite.chainB.GetSimApp().TransferKeeper), suite.chainB.GetSimApp().IBCFeeKeeper,
+		suite.chainB.GetSimApp().MockContractKeeper, simapp.MaxCallbackGas,
+	)
+
+	// set the packet receipt as done by core IBC before an asynchronous acknowledgement is written
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+	chanCap, ok := suite.chainB.GetSimApp().ScopedTransferKeeper.GetCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel()))
+	suite.Require().True(ok)
+
+	ctx := suite.chainB.GetContext()
+	err := middleware.WriteAcknowledgement(ctx, chanCap, packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.AttributeValueCallbackSuccess, callbackResult(ctx.EventManager().Events(), types.EventTypeDestinationCallback))
+	suite.Require().Equal(1, suite.chainB.GetSimApp().MockContractKeeper.Counters[ibcmock.SuccessContract])
+}
+
+// asyncTransferModule wraps the transfer application, acknowledging received packets asynchronously. The
+// acknowledgement of the transfer application is stored, to be written with WriteAcknowledgement later on.
+type asyncTransferModule struct {
+	transfer.IBCModule
+
+	ack *ibcexported.Acknowledgement
+}
+
+// OnRecvPacket stores the acknowledgement of the transfer application and returns no acknowledgement.
+func (im asyncTransferModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	*im.ack = im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	return nil
+}
+
+func (suite *CallbacksTestSuite) TestAsyncAcknowledgementCallback() {
+	// open a fee enabled transfer channel
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	feeTransferVersion := string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: transfertypes.Version}))
+	path.EndpointA.ChannelConfig.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.Version = feeTransferVersion
+	suite.coordinator.Setup(path)
+	suite.path = path
+
+	packet := suite.sendTransfer(callbackMemo(types.DestinationCallbackKey, ibcmock.SuccessContract, ""), 0)
+
+	// the fee and callbacks middleware stack of the chain, with the callbacks middleware writing acknowledgements
+	// through the fee middleware
+	var ack ibcexported.Acknowledgement
+	middleware := callbacks.NewIBCMiddleware(
+		asyncTransferModule{IBCModule: transfer.NewIBCModule(suite.chainB.GetSimApp().TransferKeeper), ack: &ack},
+		suite.chainB.GetSimApp().IBCFeeKeeper, suite.chainB.GetSimApp().MockContractKeeper, simapp.MaxCallbackGas,
+	)
+	feeModule := ibcfee.NewIBCModule(suite.chainB.GetSimApp().IBCFeeKeeper, middleware)
+
+	// receive the packet, which is acknowledged asynchronously
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	proof, proofHeight := suite.chainA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	chanCap, ok := suite.chainB.GetSimApp().ScopedTransferKeeper.GetCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel()))
+	suite.Require().True(ok)
+
+	err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainB.GetContext(), chanCap, packet, proof, proofHeight)
+	suite.Require().NoError(err)
+
+	suite.Require().Nil(feeModule.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress()))
+	suite.Require().NotNil(ack)
+	suite.Require().Zero(suite.chainB.GetSimApp().MockContractKeeper.Counters[ibcmock.SuccessContract])
+
+	// the destination callback is executed once the asynchronous acknowledgement is written
+	ctx := suite.chainB.GetContext()
+	err = middleware.WriteAcknowledgement(ctx, chanCap, packet, ack)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.AttributeValueCallbackSuccess, callbackResult(ctx.EventManager().Events(), types.EventTypeDestinationCallback))
+	suite.Require().Equal(1, suite.chainB.GetSimApp().MockContractKeeper.Counters[ibcmock.SuccessContract])
+
+	// the acknowledgement is wrapped by the fee middleware, as expected by the fee middleware of the counterparty
+	ackBz, err := ibctesting.ParseAckFromEvents(ctx.EventManager().Events())
+	suite.Require().NoError(err)
+
+	var incentivizedAck feetypes.IncentivizedAcknowledgement
+	suite.Require().NoError(feetypes.ModuleCdc.UnmarshalJSON(ackBz, &incentivizedAck))
+	suite.Require().Equal(ack.Acknowledgement(), incentivizedAck.Result)
+
+	suite.chainB.NextBlock()
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ackBz))
+}
+
+func (suite *CallbacksTestSuite) TestInterchainAccountsCallbacks() {
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICAPath(path, owner))
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	// fund the interchain account and allow bank sends on the host
+	addr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+	suite.Require().NoError(simapp.FundAccount(suite.chainB.GetSimApp(), suite.chainB.GetContext(), addr, sdk.NewCoins(ibctesting.TestCoin)))
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(ibctesting.TestCoin),
+	}
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, nil, icahosttypes.DefaultMaxGasPerPacket))
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: fmt.Sprintf(`{"%s":{"address":"%s"},"%s":{"address":"%s"}}`, types.SourceCallbackKey, ibcmock.SuccessContract, types.DestinationCallbackKey, ibcmock.SuccessContract),
+	}
+
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	timeoutTimestamp := uint64(suite.chainB.LastHeader.GetTime().Add(time.Hour).UnixNano())
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+	suite.Require().NoError(err)
+	suite.chainA.NextBlock()
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp,
+	)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	// the destination callback is executed on the host and the source callback on the controller
+	suite.Require().Equal(1, suite.chainB.GetSimApp().MockContractKeeper.Counters[ibcmock.SuccessContract])
+	suite.Require().Equal(1, suite.chainA.GetSimApp().MockContractKeeper.Counters[ibcmock.SuccessContract])
+
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), addr).Empty())
+}
+
+func (suite *CallbacksTestSuite) TestNewIBCMiddlewarePanics() {
+	mockModule := ibcmock.NewIBCModule(&ibcmock.AppModule{}, ibcmock.NewMockIBCApp(ibcmock.ModuleName, suite.chainA.GetSimApp().ScopedIBCMockKeeper))
+
+	suite.Require().Panics(func() {
+		callbacks.NewIBCMiddleware(mockModule, suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper, suite.chainA.GetSimApp().MockContractKeeper, simapp.MaxCallbackGas)
+	})
+
+	transferModule := transfer.NewIBCModule(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().Panics(func() {
+		callbacks.NewIBCMiddleware(transferModule, suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper, nil, simapp.MaxCallbackGas)
+	})
+
+	suite.Require().Panics(func() {
+		callbacks.NewIBCMiddleware(transferModule, suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper, suite.chainA.GetSimApp().MockContractKeeper, 0)
+	})
+}
+
+// containsEvent returns true if an event of the given type is contained in the events
+func containsEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+
+	return false
+}
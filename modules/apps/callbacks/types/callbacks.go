@@ -0,0 +1,129 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CallbackMetadata defines the structure of a callback registered in the packet memo under the
+// source or destination callback key.
+//
+// Example memo registering a callback on both the source and destination chain:
+//
+// {"src_callback":{"address":"cosmos1...","gas_limit":"200000"},"dest_callback":{"address":"cosmos1..."}}
+type CallbackMetadata struct {
+	// the address of the callback target
+	Address string `json:"address"`
+	// the gas limit of the callback, as a decimal string. The maximum callback gas of the
+	// middleware is used if omitted, zero or greater than the maximum.
+	GasLimit string `json:"gas_limit,omitempty"`
+}
+
+// CallbackData defines the callback to be executed by the middleware
+type CallbackData struct {
+	// the address of the callback target
+	CallbackAddress string
+	// the gas limit the callback is executed with, which is bounded by the gas remaining in the transaction
+	ExecutionGasLimit uint64
+	// the address of the packet sender, only set for source callbacks
+	SenderAddress string
+	// the gas limit up to which a callback running out of gas is committed as failed
+	CommitGasLimit uint64
+}
+
+// AllowRetry returns true if the callback was executed with less gas than its commit gas limit, as
+// the gas remaining in the transaction was insufficient. A callback running out of gas must then fail
+// the transaction, so that the packet may be relayed again with more gas, rather than being committed
+// as failed.
+func (c CallbackData) AllowRetry() bool {
+	return c.ExecutionGasLimit < c.CommitGasLimit
+}
+
+// GetSourceCallbackData parses the source callback registered in the memo of the packet data, if any.
+// The boolean returned indicates whether a callback is registered.
+func GetSourceCallbackData(packetData CallbackPacketData, srcPortID string, remainingGas, maxGas uint64) (CallbackData, bool, error) {
+	return getCallbackData(packetData, srcPortID, remainingGas, maxGas, SourceCallbackKey)
+}
+
+// GetDestCallbackData parses the destination callback registered in the memo of the packet data, if any.
+// The boolean returned indicates whether a callback is registered.
+func GetDestCallbackData(packetData CallbackPacketData, remainingGas, maxGas uint64) (CallbackData, bool, error) {
+	return getCallbackData(packetData, "", remainingGas, maxGas, DestinationCallbackKey)
+}
+
+// getCallbackData parses the callback registered under the given key of the memo of the packet data.
+// Memos which are not json objects, or which do not contain the key, do not register a callback.
+func getCallbackData(packetData CallbackPacketData, srcPortID string, remainingGas, maxGas uint64, callbackKey string) (CallbackData, bool, error) {
+	memo := packetData.GetMemo()
+	if strings.TrimSpace(memo) == "" {
+		return CallbackData{}, false, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return CallbackData{}, false, nil
+	}
+
+	rawCallback, ok := raw[callbackKey]
+	if !ok {
+		return CallbackData{}, false, nil
+	}
+
+	var metadata *CallbackMetadata
+	if err := json.Unmarshal(rawCallback, &metadata); err != nil {
+		return CallbackData{}, false, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s: %s", callbackKey, err)
+	}
+
+	if metadata == nil || strings.TrimSpace(metadata.Address) == "" {
+		return CallbackData{}, false, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s: callback address cannot be blank", callbackKey)
+	}
+
+	commitGasLimit := maxGas
+	if metadata.GasLimit != "" {
+		userGasLimit, err := strconv.ParseUint(metadata.GasLimit, 10, 64)
+		if err != nil {
+			return CallbackData{}, false, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s: invalid gas limit %s", callbackKey, metadata.GasLimit)
+		}
+
+		if userGasLimit != 0 && userGasLimit < maxGas {
+			commitGasLimit = userGasLimit
+		}
+	}
+
+	executionGasLimit := commitGasLimit
+	if remainingGas < executionGasLimit {
+		executionGasLimit = remainingGas
+	}
+
+	var senderAddress string
+	if callbackKey == SourceCallbackKey {
+		senderAddress = packetData.GetPacketSender(srcPortID)
+	}
+
+	return CallbackData{
+		CallbackAddress:   metadata.Address,
+		ExecutionGasLimit: executionGasLimit,
+		SenderAddress:     senderAddress,
+		CommitGasLimit:    commitGasLimit,
+	}, true, nil
+}
+
+// GetRemainingGas returns the gas remaining in the given gas meter. The maximum amount of gas is
+// returned for infinite gas meters.
+func GetRemainingGas(gasMeter sdk.GasMeter) uint64 {
+	// infinite gas meters have no limit
+	if gasMeter.Limit() == 0 {
+		return math.MaxUint64
+	}
+
+	if gasMeter.IsPastLimit() {
+		return 0
+	}
+
+	return gasMeter.Limit() - gasMeter.GasConsumed()
+}
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+const (
+	sender   = "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+	callback = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	maxGas   = uint64(1000000)
+)
+
+func TestGetSourceCallbackData(t *testing.T) {
+	testCases := []struct {
+		name         string
+		memo         string
+		remainingGas uint64
+		expFound     bool
+		expData      types.CallbackData
+		expError     bool
+	}{
+		{"empty memo", "", maxGas, false, types.CallbackData{}, false},
+		{"memo is not a json object", "memo", maxGas, false, types.CallbackData{}, false},
+		{"no source callback", `{"dest_callback":{"address":"` + callback + `"}}`, maxGas, false, types.CallbackData{}, false},
+		{
+			"callback without gas limit", `{"src_callback":{"address":"` + callback + `"}}`, 2 * maxGas, true,
+			types.CallbackData{CallbackAddress: callback, ExecutionGasLimit: maxGas, SenderAddress: sender, CommitGasLimit: maxGas}, false,
+		},
+		{
+			"callback with zero gas limit", `{"src_callback":{"address":"` + callback + `","gas_limit":"0"}}`, 2 * maxGas, true,
+			types.CallbackData{CallbackAddress: callback, ExecutionGasLimit: maxGas, SenderAddress: sender, CommitGasLimit: maxGas}, false,
+		},
+		{
+			"callback with gas limit", `{"src_callback":{"address":"` + callback + `","gas_limit":"50000"}}`, 2 * maxGas, true,
+			types.CallbackData{CallbackAddress: callback, ExecutionGasLimit: 50000, SenderAddress: sender, CommitGasLimit: 50000}, false,
+		},
+		{
+			"callback with gas limit greater than the maximum", `{"src_callback":{"address":"` + callback + `","gas_limit":"5000000"}}`, 2 * maxGas, true,
+			types.CallbackData{CallbackAddress: callback, ExecutionGasLimit: maxGas, SenderAddress: sender, CommitGasLimit: maxGas}, false,
+		},
+		{
+			"remaining gas lower than the gas limit", `{"src_callback":{"address":"` + callback + `"}}`, 50000, true,
+			types.CallbackData{CallbackAddress: callback, ExecutionGasLimit: 50000, SenderAddress: sender, CommitGasLimit: maxGas}, false,
+		},
+		{"callback is not a json object", `{"src_callback":"` + callback + `"}`, maxGas, false, types.CallbackData{}, true},
+		{"null callback", `{"src_callback":null}`, maxGas, false, types.CallbackData{}, true},
+		{"blank callback address", `{"src_callback":{"address":" "}}`, maxGas, false, types.CallbackData{}, true},
+		{"invalid gas limit", `{"src_callback":{"address":"` + callback + `","gas_limit":"-1"}}`, maxGas, false, types.CallbackData{}, true},
+	}
+
+	for _, tc := range testCases {
+		packetData := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, "receiver", tc.memo).ToMultiDenom()
+
+		callbackData, found, err := types.GetSourceCallbackData(&packetData, transfertypes.PortID, tc.remainingGas, maxGas)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expFound, found, tc.name)
+		require.Equal(t, tc.expData, callbackData, tc.name)
+	}
+}
+
+func TestGetDestCallbackData(t *testing.T) {
+	packetData := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, "receiver", `{"dest_callback":{"address":"`+callback+`"}}`).ToMultiDenom()
+
+	callbackData, found, err := types.GetDestCallbackData(&packetData, maxGas, maxGas)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// the sender address is not set for destination callbacks
+	require.Equal(t, types.CallbackData{CallbackAddress: callback, ExecutionGasLimit: maxGas, CommitGasLimit: maxGas}, callbackData)
+	require.False(t, callbackData.AllowRetry())
+
+	_, found, err = types.GetSourceCallbackData(&packetData, transfertypes.PortID, maxGas, maxGas)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestGetSourceCallbackDataInterchainAccounts(t *testing.T) {
+	portID, err := icatypes.NewControllerPortID(sender)
+	require.NoError(t, err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Memo: `{"src_callback":{"address":"` + callback + `"}}`,
+	}
+
+	callbackData, found, err := types.GetSourceCallbackData(&packetData, portID, maxGas, maxGas)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// the owner of the interchain account is the packet sender
+	require.Equal(t, sender, callbackData.SenderAddress)
+}
+
+func TestGetRemainingGas(t *testing.T) {
+	require.Equal(t, uint64(math.MaxUint64), types.GetRemainingGas(sdk.NewInfiniteGasMeter()))
+
+	gasMeter := sdk.NewGasMeter(100)
+	gasMeter.ConsumeGas(40, "test")
+	require.Equal(t, uint64(60), types.GetRemainingGas(gasMeter))
+
+	require.Panics(t, func() { gasMeter.ConsumeGas(100, "test") })
+	require.Equal(t, uint64(0), types.GetRemainingGas(gasMeter))
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// callbacks middleware sentinel errors
+var (
+	ErrInvalidCallbackData   = sdkerrors.Register(ModuleName, 2, "invalid callback data")
+	ErrNotCallbackPacketData = sdkerrors.Register(ModuleName, 3, "packet data does not support callbacks")
+	ErrCallbackPanic         = sdkerrors.Register(ModuleName, 4, "callback panicked")
+	ErrCallbackOutOfGas      = sdkerrors.Register(ModuleName, 5, "callback out of gas")
+)
@@ -0,0 +1,22 @@
+package types
+
+// callbacks middleware events
+const (
+	EventTypeSourceCallback      = "ibc_src_callback"
+	EventTypeDestinationCallback = "ibc_dest_callback"
+
+	AttributeKeyCallbackType            = "callback_type"
+	AttributeKeyCallbackAddress         = "callback_address"
+	AttributeKeyCallbackExecGasLimit    = "callback_exec_gas_limit"
+	AttributeKeyCallbackCommitGasLimit  = "callback_commit_gas_limit"
+	AttributeKeyCallbackSourcePortID    = "packet_src_port"
+	AttributeKeyCallbackSourceChannelID = "packet_src_channel"
+	AttributeKeyCallbackDestPortID      = "packet_dest_port"
+	AttributeKeyCallbackDestChannelID   = "packet_dest_channel"
+	AttributeKeyCallbackSequence        = "packet_sequence"
+	AttributeKeyCallbackResult          = "callback_result"
+	AttributeKeyCallbackError           = "callback_error"
+
+	AttributeValueCallbackSuccess = "success"
+	AttributeValueCallbackFailure = "failure"
+)
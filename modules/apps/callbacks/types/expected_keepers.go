@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ContractKeeper defines the entry points of the callback targets, such as modules or smart contracts,
+// which are called by the callbacks middleware. The callback target is identified by the address
+// registered in the packet data. Implementations may dispatch to different modules or contracts
+// based on this address.
+//
+// An error returned by a callback, or a panic, reverts the state changes of the callback but does
+// not affect the packet lifecycle.
+type ContractKeeper interface {
+	// IBCOnAcknowledgementPacketCallback is called on the source chain once a packet is acknowledged.
+	// The packet sender address is provided so that the callback target can verify that the callback
+	// was registered by an authorized sender.
+	IBCOnAcknowledgementPacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCOnTimeoutPacketCallback is called on the source chain once a packet times out.
+	// The packet sender address is provided so that the callback target can verify that the callback
+	// was registered by an authorized sender.
+	IBCOnTimeoutPacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCReceivePacketCallback is called on the destination chain once a packet is received and
+	// successfully acknowledged by the underlying application.
+	IBCReceivePacketCallback(
+		ctx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+		contractAddress string,
+	) error
+}
+
+// PacketDataUnmarshaler defines the interface the underlying application of the callbacks
+// middleware must implement to decode its packet data.
+type PacketDataUnmarshaler interface {
+	// UnmarshalPacketData decodes the packet data sent or received on the given channel end.
+	UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (interface{}, error)
+}
+
+// CallbacksCompatibleModule defines the interface the underlying application of the callbacks
+// middleware must implement.
+type CallbacksCompatibleModule interface {
+	porttypes.IBCModule
+	PacketDataUnmarshaler
+}
+
+// CallbackPacketData defines the interface the decoded packet data of applications supporting
+// callbacks must implement.
+type CallbackPacketData interface {
+	// GetMemo returns the memo of the packet data, in which callbacks are registered.
+	GetMemo() string
+	// GetPacketSender returns the address of the sender of the packet sent on the given source port.
+	// An empty string is returned if the sender cannot be determined.
+	GetPacketSender(sourcePortID string) string
+}
@@ -0,0 +1,26 @@
+package types
+
+const (
+	// ModuleName defines the callbacks middleware name
+	ModuleName = "ibccallbacks"
+
+	// SourceCallbackKey is the key of the memo entry containing the callback executed on the
+	// source chain once the packet is acknowledged or timed out
+	SourceCallbackKey = "src_callback"
+
+	// DestinationCallbackKey is the key of the memo entry containing the callback executed on
+	// the destination chain once the packet is received
+	DestinationCallbackKey = "dest_callback"
+
+	// CallbackTypeAcknowledgementPacket is the callback type executed when a packet is acknowledged
+	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
+
+	// CallbackTypeTimeoutPacket is the callback type executed when a packet times out
+	CallbackTypeTimeoutPacket CallbackType = "timeout_packet"
+
+	// CallbackTypeReceivePacket is the callback type executed when a packet is received
+	CallbackTypeReceivePacket CallbackType = "receive_packet"
+)
+
+// CallbackType defines the packet lifecycle event a callback is executed on
+type CallbackType string
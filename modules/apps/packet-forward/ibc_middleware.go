@@ -0,0 +1,235 @@
+package packetforward
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ porttypes.Middleware = IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward middleware given the
+// packet forward keeper and the underlying ICS-20 application.
+type IBCMiddleware struct {
+	keeper keeper.Keeper
+	app    porttypes.IBCModule
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying ICS-20 application
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		keeper: k,
+		app:    app,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the ICS-20 packet memo names a next hop, the tokens are received by an intermediate forward address
+// and sent onwards to the next hop. Only packets transferring a single token can be forwarded. The acknowledgement is then written asynchronously once the forwarded
+// packet is acknowledged or timed out. All other packets are passed on to the underlying application.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	version, found := im.keeper.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(packet.GetData(), version)
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, forward, err := types.ParseForwardMetadata(packetData.Memo)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	if !forward {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if len(packetData.Tokens) != 1 {
+		return transfertypes.NewErrorAcknowledgement(
+			sdkerrors.Wrapf(types.ErrInvalidForwardMetadata, "only packets transferring a single token can be forwarded, got %d tokens", len(packetData.Tokens)),
+		)
+	}
+
+	data := transfertypes.NewFungibleTokenPacketData(
+		packetData.Tokens[0].Denom, packetData.Tokens[0].Amount, packetData.Sender, packetData.Receiver, packetData.Memo,
+	)
+
+	// the tokens are received by the forward address, which is the sender of the forwarded transfer
+	forwardAddress := types.GetForwardAddress(packet.GetDestChannel(), data.Sender)
+
+	overrideData := packetData
+	overrideData.Receiver = forwardAddress.String()
+	overrideData.Memo = ""
+
+	overridePacket := packet
+	if version == transfertypes.Version {
+		overridePacket.Data = transfertypes.NewFungibleTokenPacketData(
+			data.Denom, data.Amount, overrideData.Sender, overrideData.Receiver, overrideData.Memo,
+		).GetBytes()
+	} else {
+		overridePacket.Data = overrideData.GetBytes()
+	}
+
+	ack := im.app.OnRecvPacket(ctx, overridePacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if err := im.keeper.ForwardTransferPacket(ctx, packet, data, forwardAddress, metadata); err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	// NOTE: acknowledgement will be written asynchronously once the forwarded packet is acknowledged or timed out
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// If the acknowledged packet was forwarded by this middleware, the acknowledgement of the original packet
+// is written once the underlying application has processed the acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	// the underlying application refunds the forward address upon an error acknowledgement
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnForwardedPacketCompleted(ctx, inFlightPacket, ack.Success())
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// If the timed out packet was forwarded by this middleware, an error acknowledgement is written for the
+// original packet once the underlying application has refunded the forward address.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnForwardedPacketCompleted(ctx, inFlightPacket, false)
+}
+
+// SendPacket implements the ICS4Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	return im.keeper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.keeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.keeper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,250 @@
+package packetforward_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type PacketForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	pathAToB *ibctesting.Path
+	pathBToC *ibctesting.Path
+}
+
+func (suite *PacketForwardTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathAToB = NewTransferPath(suite.chainA, suite.chainB)
+	suite.pathBToC = NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathAToB)
+	suite.coordinator.Setup(suite.pathBToC)
+}
+
+func TestPacketForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(PacketForwardTestSuite))
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+// forwardMemo returns the memo forwarding a transfer received on chainB to the receiver on chainC
+func (suite *PacketForwardTestSuite) forwardMemo(timeout uint64) string {
+	metadata := types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: suite.chainC.SenderAccount.GetAddress().String(),
+			Port:     suite.pathBToC.EndpointA.ChannelConfig.PortID,
+			Channel:  suite.pathBToC.EndpointA.ChannelID,
+			Timeout:  timeout,
+		},
+	}
+
+	bz, err := json.Marshal(metadata)
+	suite.Require().NoError(err)
+
+	return string(bz)
+}
+
+// sendTransfer sends a transfer from chainA to chainB with the provided memo and receives it on chainB,
+// returning the packet sent by chainA and the result of the receive on chainB.
+func (suite *PacketForwardTestSuite) sendTransfer(coin sdk.Coin, memo string) (channeltypes.Packet, *sdk.Result) {
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathAToB.EndpointA.ChannelConfig.PortID, suite.pathAToB.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(0, 110), 0, memo,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.pathAToB.EndpointB.UpdateClient())
+
+	res, err = suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	return packet, res
+}
+
+func (suite *PacketForwardTestSuite) TestForwardTransfer() {
+	coin := ibctesting.TestCoin
+	balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom)
+
+	packet, res := suite.sendTransfer(coin, suite.forwardMemo(0))
+
+	// the acknowledgement is held back until the forwarded packet is acknowledged
+	_, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	inFlightPacket, found := suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet, inFlightPacket.Packet)
+
+	// relay the forwarded packet to chainC and its acknowledgement back to chainB
+	suite.Require().NoError(suite.pathBToC.RelayPacket(forwardPacket))
+
+	_, found = suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().False(found)
+
+	// relay the acknowledgement written by chainB to chainA
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().NoError(suite.pathAToB.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+
+	// the tokens are received on chainC
+	fullDenomPath := transfertypes.GetPrefixedDenom(suite.pathBToC.EndpointB.ChannelConfig.PortID, suite.pathBToC.EndpointB.ChannelID,
+		transfertypes.GetPrefixedDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, coin.Denom))
+	voucherDenom := transfertypes.ParseDenomTrace(fullDenomPath).IBCDenom()
+	balance := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().Equal(coin.Amount, balance.Amount)
+
+	// no tokens remain on chainB
+	forwardAddress := types.GetForwardAddress(packet.GetDestChannel(), suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), forwardAddress).Empty())
+
+	balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom)
+	suite.Require().Equal(balanceBefore.Sub(coin), balanceAfter)
+}
+
+func (suite *PacketForwardTestSuite) TestForwardTransferTimeout() {
+	coin := ibctesting.TestCoin
+	balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom)
+
+	packet, res := suite.sendTransfer(coin, suite.forwardMemo(uint64(time.Minute.Nanoseconds())))
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// advance time on chainC beyond the timeout of the forwarded packet and time out the packet on chainB
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathBToC.EndpointA.TimeoutPacket(forwardPacket))
+
+	_, found := suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().False(found)
+
+	// the vouchers minted on chainB are burned
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), coin.Denom)).IBCDenom()
+	supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom)
+	suite.Require().True(supply.IsZero())
+
+	// relay the error acknowledgement written by chainB to chainA
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	ack := transfertypes.NewErrorAcknowledgement(types.ErrForwardTransferFailed)
+	suite.Require().NoError(suite.pathAToB.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+
+	// the sender is refunded on chainA
+	balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom)
+	suite.Require().Equal(balanceBefore, balanceAfter)
+}
+
+func (suite *PacketForwardTestSuite) TestForwardTransferReturnsToSource() {
+	// send vouchers from chainB to chainA and forward the native tokens unescrowed on chainA back to chainB
+	coin := ibctesting.TestCoin
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, coin,
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(0, 110), 0, "",
+	)
+
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.pathAToB.RelayPacket(packet))
+
+	escrowAddress := transfertypes.GetEscrowAddress(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID)
+	escrowBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, coin.Denom)
+	suite.Require().Equal(coin, escrowBalance)
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), coin.Denom)).IBCDenom()
+	packet, res = suite.sendTransfer(sdk.NewCoin(voucherDenom, coin.Amount), suite.forwardMemo(uint64(time.Minute.Nanoseconds())))
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the tokens unescrowed on chainB are escrowed for the forwarded transfer
+	escrowBalance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, coin.Denom)
+	suite.Require().True(escrowBalance.IsZero())
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathBToC.EndpointA.TimeoutPacket(forwardPacket))
+
+	// the tokens are returned to the escrow account of the channel they were received on
+	escrowBalance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, coin.Denom)
+	suite.Require().Equal(coin, escrowBalance)
+
+	totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.Denom)
+	suite.Require().Equal(coin, totalEscrow)
+
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	ack := transfertypes.NewErrorAcknowledgement(types.ErrForwardTransferFailed)
+	suite.Require().NoError(suite.pathAToB.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().Equal(coin.Amount, balance.Amount)
+}
+
+func (suite *PacketForwardTestSuite) TestForwardTransferInvalidMetadata() {
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":""}}`, suite.chainC.SenderAccount.GetAddress())
+	packet, res := suite.sendTransfer(ibctesting.TestCoin, memo)
+
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+	suite.Require().False(ack.Success())
+
+	suite.Require().NoError(suite.pathAToB.EndpointA.AcknowledgePacket(packet, ackBz))
+}
+
+func (suite *PacketForwardTestSuite) TestNonForwardMemo() {
+	coin := ibctesting.TestCoin
+	_, res := suite.sendTransfer(coin, "not a forward memo")
+
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ackBz)
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, coin.Denom)).IBCDenom()
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().Equal(coin.Amount, balance.Amount)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ForwardTransferPacket forwards the tokens received by the forward address for the given packet to the
+// next hop described by the forward metadata. The received packet is stored as an in-flight packet so its
+// acknowledgement may be written once the forwarded packet is acknowledged or timed out.
+func (k Keeper) ForwardTransferPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	forwardAddress sdk.AccAddress,
+	metadata types.ForwardMetadata,
+) error {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	token := sdk.NewCoin(types.GetReceivedDenom(packet, data.Denom), amount)
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", metadata.Port, metadata.Channel,
+		)
+	}
+
+	memo, err := metadata.GetNextMemo()
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidForwardMetadata, err.Error())
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + metadata.GetTimeout()
+	if err := k.transferKeeper.SendTransfer(
+		ctx, metadata.Port, metadata.Channel, token, forwardAddress, metadata.Receiver, clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	); err != nil {
+		return sdkerrors.Wrap(types.ErrForwardTransferFailed, err.Error())
+	}
+
+	forwardPacketID := channeltypes.NewPacketId(metadata.Port, metadata.Channel, sequence)
+	k.SetInFlightPacket(ctx, types.NewInFlightPacket(packet, forwardAddress.String(), forwardPacketID))
+
+	k.Logger(ctx).Info("forwarded ICS-20 transfer", "token", token.Denom, "amount", token.Amount.String(), "receiver", metadata.Receiver, "port", metadata.Port, "channel", metadata.Channel, "sequence", sequence)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardPacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardAddress, forwardAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyForwardReceiver, metadata.Receiver),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, metadata.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return nil
+}
+
+// OnForwardedPacketCompleted is called once the forwarded packet has been acknowledged or timed out and the
+// underlying ICS-20 application has processed the outcome. A successful acknowledgement is written for the
+// original packet if the forwarded packet succeeded. Otherwise, the tokens refunded to the forward address
+// are returned to the state they were in prior to receiving the original packet and an error acknowledgement
+// is written, causing the previous hop to refund the sender.
+func (k Keeper) OnForwardedPacketCompleted(ctx sdk.Context, inFlightPacket types.InFlightPacket, success bool) error {
+	forwardPacketID := inFlightPacket.ForwardPacketId
+	k.DeleteInFlightPacket(ctx, forwardPacketID.PortId, forwardPacketID.ChannelId, forwardPacketID.Sequence)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardPacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, forwardPacketID.PortId),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, forwardPacketID.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(forwardPacketID.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardAckResult, fmt.Sprintf("%t", success)),
+		),
+	)
+
+	var ack ibcexported.Acknowledgement
+	if success {
+		ack = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	} else {
+		if err := k.revertReceivedTokens(ctx, inFlightPacket); err != nil {
+			return err
+		}
+
+		ack = transfertypes.NewErrorAcknowledgement(types.ErrForwardTransferFailed)
+	}
+
+	return k.writeAcknowledgement(ctx, inFlightPacket.Packet, ack)
+}
+
+// revertReceivedTokens reverts the receipt of the tokens held by the forward address. Tokens which were
+// unescrowed upon receipt are sent back to the escrow account of the receiving channel, while vouchers
+// which were minted upon receipt are burned.
+func (k Keeper) revertReceivedTokens(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	packet := inFlightPacket.Packet
+
+	version, found := k.ics4Wrapper.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(packet.GetData(), version)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	if len(packetData.Tokens) != 1 {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "forwarded packet must transfer a single token, got %d tokens", len(packetData.Tokens))
+	}
+
+	data := packetData.Tokens[0]
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	forwardAddress, err := sdk.AccAddressFromBech32(inFlightPacket.ForwardAddress)
+	if err != nil {
+		return err
+	}
+
+	token := sdk.NewCoin(types.GetReceivedDenom(packet, data.Denom), amount)
+	coins := sdk.NewCoins(token)
+
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		escrowAddress := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, forwardAddress, escrowAddress, coins); err != nil {
+			return err
+		}
+
+		// the tokens are escrowed again, so the total escrow is restored
+		totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, token.Denom)
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, totalEscrow.Add(token))
+
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwardAddress, transfertypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balance
+		// to burn.
+		panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// packet forward middleware sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardTransferFailed  = sdkerrors.Register(ModuleName, 3, "failed to forward transfer to the next hop")
+	ErrInFlightPacketNotFound = sdkerrors.Register(ModuleName, 4, "in-flight packet not found")
+)
@@ -0,0 +1,13 @@
+package types
+
+// packet forward middleware events
+const (
+	EventTypeForwardPacket = "forward_packet"
+
+	AttributeKeyForwardAddress   = "forward_address"
+	AttributeKeyForwardPort      = "forward_port"
+	AttributeKeyForwardChannel   = "forward_channel"
+	AttributeKeyForwardSequence  = "forward_sequence"
+	AttributeKeyForwardReceiver  = "forward_receiver"
+	AttributeKeyForwardAckResult = "forward_ack_success"
+)
@@ -0,0 +1,204 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// MaxRelativeTimeoutTimestamp is the maximum timeout of a forwarded packet, in nanoseconds relative to the
+// current block time, which may be requested in the forward metadata
+const MaxRelativeTimeoutTimestamp = uint64(30 * 24 * time.Hour)
+
+// PacketMetadata defines the structure of the ICS-20 packet memo understood by the packet
+// forward middleware. A memo which does not contain a forward entry is ignored by the middleware.
+//
+// Example memo forwarding over two hops:
+//
+// {"forward":{"receiver":"cosmos1...","port":"transfer","channel":"channel-1","next":{"forward":{...}}}}
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward,omitempty"`
+
+	// the remaining top-level memo entries, such as entries intended for other middleware. They are
+	// preserved when the metadata is passed along as the memo of a forwarded packet.
+	entries map[string]json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The top-level memo entries other than the forward
+// metadata are retained so that they are passed along to the subsequent hop.
+func (p *PacketMetadata) UnmarshalJSON(bz []byte) error {
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return err
+	}
+
+	p.Forward = nil
+	if forward, ok := entries["forward"]; ok {
+		if err := json.Unmarshal(forward, &p.Forward); err != nil {
+			return err
+		}
+
+		delete(entries, "forward")
+	}
+
+	p.entries = entries
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The forward metadata is marshaled together with the
+// retained top-level memo entries.
+func (p PacketMetadata) MarshalJSON() ([]byte, error) {
+	entries := make(map[string]json.RawMessage, len(p.entries)+1)
+	for key, value := range p.entries {
+		entries[key] = value
+	}
+
+	if p.Forward != nil {
+		bz, err := json.Marshal(p.Forward)
+		if err != nil {
+			return nil, err
+		}
+
+		entries["forward"] = bz
+	}
+
+	return json.Marshal(entries)
+}
+
+// ForwardMetadata defines the next hop an ICS-20 transfer should be forwarded to
+type ForwardMetadata struct {
+	// the recipient address on the next hop
+	Receiver string `json:"receiver"`
+	// the port over which the transfer is forwarded
+	Port string `json:"port"`
+	// the channel over which the transfer is forwarded
+	Channel string `json:"channel"`
+	// the timeout timestamp of the forwarded packet, in nanoseconds relative to the
+	// current block time. The default relative ICS-20 timeout is used when set to 0.
+	Timeout uint64 `json:"timeout,omitempty"`
+	// optional metadata for the subsequent hop, passed along as the memo of the forwarded packet
+	// including any entries which are not intended for this middleware
+	Next *PacketMetadata `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata attempts to parse the forward metadata from the provided ICS-20 memo.
+// The boolean returned indicates whether the memo requests the transfer to be forwarded.
+// An error is returned if the memo contains forward metadata which is invalid.
+func ParseForwardMetadata(memo string) (ForwardMetadata, bool, error) {
+	if strings.TrimSpace(memo) == "" {
+		return ForwardMetadata{}, false, nil
+	}
+
+	// memos which are not json objects are not intended for this middleware
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return ForwardMetadata{}, false, nil
+	}
+
+	if _, ok := raw["forward"]; !ok {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return ForwardMetadata{}, false, sdkerrors.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	if metadata.Forward == nil {
+		return ForwardMetadata{}, false, sdkerrors.Wrap(ErrInvalidForwardMetadata, "forward metadata cannot be null")
+	}
+
+	if err := metadata.Forward.Validate(); err != nil {
+		return ForwardMetadata{}, false, err
+	}
+
+	return *metadata.Forward, true, nil
+}
+
+// Validate performs a basic validation of the forward metadata fields
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver address cannot be blank")
+	}
+
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid port ID: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid channel ID: %s", err)
+	}
+
+	if m.Timeout > MaxRelativeTimeoutTimestamp {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "timeout %d exceeds the maximum relative timeout %d", m.Timeout, MaxRelativeTimeoutTimestamp)
+	}
+
+	if m.Next != nil {
+		// the next hop metadata may only contain entries for other middleware on the next chain,
+		// in which case the transfer is not forwarded any further
+		if m.Next.Forward == nil {
+			if len(m.Next.entries) == 0 {
+				return sdkerrors.Wrap(ErrInvalidForwardMetadata, "next hop metadata cannot be empty")
+			}
+
+			return nil
+		}
+
+		return m.Next.Forward.Validate()
+	}
+
+	return nil
+}
+
+// GetTimeout returns the relative timeout timestamp of the forwarded packet
+func (m ForwardMetadata) GetTimeout() uint64 {
+	if m.Timeout == 0 {
+		return transfertypes.DefaultRelativePacketTimeoutTimestamp
+	}
+
+	return m.Timeout
+}
+
+// GetNextMemo returns the memo to be set on the forwarded packet. An empty memo is returned
+// if the transfer does not need to be forwarded any further.
+func (m ForwardMetadata) GetNextMemo() (string, error) {
+	if m.Next == nil {
+		return "", nil
+	}
+
+	bz, err := json.Marshal(m.Next)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// GetReceivedDenom returns the denomination of the tokens received on this chain for the
+// provided packet and the denomination contained in its ICS-20 packet data. This mirrors
+// the denomination handling of the ICS-20 OnRecvPacket logic.
+func GetReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// remove prefix added by sender chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := denom[len(voucherPrefix):]
+
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+
+		return unprefixedDenom
+	}
+
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + denom
+
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		memo       string
+		expForward bool
+		expPass    bool
+	}{
+		{"empty memo", "", false, true},
+		{"memo is not json", "hello world", false, true},
+		{"memo without forward metadata", `{"wasm":{}}`, false, true},
+		{"valid forward metadata", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}}`, true, true},
+		{"valid forward metadata with next hop", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"cosmos2","port":"transfer","channel":"channel-2"}}}}`, true, true},
+		{"null forward metadata", `{"forward":null}`, false, false},
+		{"forward metadata is not an object", `{"forward":"channel-1"}`, false, false},
+		{"empty receiver", `{"forward":{"receiver":"","port":"transfer","channel":"channel-1"}}`, false, false},
+		{"invalid port", `{"forward":{"receiver":"cosmos1","port":"","channel":"channel-1"}}`, false, false},
+		{"invalid channel", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"(invalid)"}}`, false, false},
+		{"invalid next hop", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"cosmos2"}}}}`, false, false},
+		{"valid forward metadata with next hop entries for other middleware", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"wasm":{}}}}`, true, true},
+		{"valid forward metadata with maximum timeout", fmt.Sprintf(`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":%d}}`, types.MaxRelativeTimeoutTimestamp), true, true},
+		{"empty next hop metadata", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{}}}`, false, false},
+		{"null next hop forward metadata", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"forward":null}}}`, false, false},
+		{"timeout exceeds the maximum", fmt.Sprintf(`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":%d}}`, types.MaxRelativeTimeoutTimestamp+1), false, false},
+		{"next hop timeout exceeds the maximum", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"cosmos2","port":"transfer","channel":"channel-2","timeout":18446744073709551615}}}}`, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, forward, err := types.ParseForwardMetadata(tc.memo)
+			require.Equal(t, tc.expForward, forward)
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestForwardMetadataGetNextMemo(t *testing.T) {
+	memo := `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":100,"next":{"forward":{"receiver":"cosmos2","port":"transfer","channel":"channel-2"}}}}`
+
+	metadata, forward, err := types.ParseForwardMetadata(memo)
+	require.NoError(t, err)
+	require.True(t, forward)
+	require.Equal(t, uint64(100), metadata.GetTimeout())
+
+	nextMemo, err := metadata.GetNextMemo()
+	require.NoError(t, err)
+	require.Equal(t, `{"forward":{"receiver":"cosmos2","port":"transfer","channel":"channel-2"}}`, nextMemo)
+
+	next, forward, err := types.ParseForwardMetadata(nextMemo)
+	require.NoError(t, err)
+	require.True(t, forward)
+	require.Equal(t, transfertypes.DefaultRelativePacketTimeoutTimestamp, next.GetTimeout())
+
+	nextMemo, err = next.GetNextMemo()
+	require.NoError(t, err)
+	require.Empty(t, nextMemo)
+}
+
+func TestForwardMetadataGetNextMemoPreservesEntries(t *testing.T) {
+	memo := `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"cosmos2","port":"transfer","channel":"channel-2","next":{"callbacks":{"address":"cosmos3"}}},"wasm":{"contract":"cosmos4"}}}}`
+
+	metadata, forward, err := types.ParseForwardMetadata(memo)
+	require.NoError(t, err)
+	require.True(t, forward)
+
+	nextMemo, err := metadata.GetNextMemo()
+	require.NoError(t, err)
+	require.Equal(t, `{"forward":{"receiver":"cosmos2","port":"transfer","channel":"channel-2","next":{"callbacks":{"address":"cosmos3"}}},"wasm":{"contract":"cosmos4"}}`, nextMemo)
+
+	next, forward, err := types.ParseForwardMetadata(nextMemo)
+	require.NoError(t, err)
+	require.True(t, forward)
+
+	nextMemo, err = next.GetNextMemo()
+	require.NoError(t, err)
+	require.Equal(t, `{"callbacks":{"address":"cosmos3"}}`, nextMemo)
+}
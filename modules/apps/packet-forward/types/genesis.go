@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// NewGenesisState creates a packet forward middleware GenesisState instance.
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no in-flight packets.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: []InFlightPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, inFlightPacket := range gs.InFlightPackets {
+		if err := inFlightPacket.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewInFlightPacket creates and returns a new InFlightPacket instance
+func NewInFlightPacket(packet channeltypes.Packet, forwardAddress string, forwardPacketID channeltypes.PacketId) InFlightPacket {
+	return InFlightPacket{
+		Packet:          packet,
+		ForwardAddress:  forwardAddress,
+		ForwardPacketId: forwardPacketID,
+	}
+}
+
+// Validate performs a stateless validation of the InFlightPacket fields
+func (p InFlightPacket) Validate() error {
+	if err := p.Packet.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.ForwardAddress); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert forward address into sdk.AccAddress")
+	}
+
+	return p.ForwardPacketId.Validate()
+}
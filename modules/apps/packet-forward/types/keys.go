@@ -0,0 +1,42 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the packet forward middleware name
+	ModuleName = "packetforward"
+
+	// StoreKey is the store key string for the packet forward middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the packet forward middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the packet forward middleware
+	QuerierRoute = ModuleName
+
+	// InFlightPacketKeyPrefix is the key prefix for in-flight packets stored by forwarded packet identifier
+	InFlightPacketKeyPrefix = "inFlightPacket"
+)
+
+// KeyInFlightPacket returns the key under which the in-flight packet is stored for the forwarded packet
+// identified by the given port, channel and sequence
+func KeyInFlightPacket(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", InFlightPacketKeyPrefix, portID, channelID, sequence))
+}
+
+// GetForwardAddress returns the intermediate address which holds the tokens received on the given
+// channel on behalf of the given sender while they are forwarded to the next hop.
+func GetForwardAddress(channelID, sender string) sdk.AccAddress {
+	// the module name is used as a domain separator to prevent collisions with the
+	// ICS-20 escrow addresses, which are derived in a similar fashion
+	contents := fmt.Sprintf("%s/%s/%s", ModuleName, channelID, sender)
+
+	hash := sha256.Sum256([]byte(contents))
+	return hash[:20]
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+// NewCmdSubmitSetRateLimitProposal implements a command handler for submitting a set rate limit proposal transaction.
+func NewCmdSubmitSetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [port-id] [channel-id] [denom] [max-percent-send] [max-percent-recv] [duration-hours]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Submit a proposal to add or update the rate limit of a port, channel and denomination",
+		Long: strings.TrimSpace(`Submit a proposal to add or update the rate limit of a port, channel and denomination, along with
+an initial deposit. The maximum net outflow and inflow over a rolling window of the given duration are specified as
+a percentage of the total supply of the denomination. A percentage of zero disables the quota in that direction.
+The flows tracked by an existing rate limit are reset.`),
+		Example: fmt.Sprintf("%s tx gov submit-proposal set-rate-limit transfer channel-0 ibc/{hash} 10 10 24", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			maxPercentSend, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max percent send %s: %w", args[3], err)
+			}
+
+			maxPercentRecv, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max percent recv %s: %w", args[4], err)
+			}
+
+			durationHours, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid duration hours %s: %w", args[5], err)
+			}
+
+			path := types.NewPath(args[2], args[0], args[1])
+			quota := types.NewQuota(maxPercentSend, maxPercentRecv, durationHours)
+			content := types.NewSetRateLimitProposal(title, description, path, quota)
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for submitting a remove rate limit proposal transaction.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rate-limit [port-id] [channel-id] [denom]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Submit a proposal to remove the rate limit of a port, channel and denomination",
+		Long:    "Submit a proposal to remove the rate limit of a port, channel and denomination, along with an initial deposit.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal remove-rate-limit transfer channel-0 ibc/{hash}", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveRateLimitProposal(title, description, types.NewPath(args[2], args[0], args[1]))
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// submitProposal generates or broadcasts a MsgSubmitProposal for the given content
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content) error {
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// addProposalFlags adds the governance proposal flags to the given command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
@@ -0,0 +1,231 @@
+package ratelimiting_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	// channelValue is the channel value used by the rate limits set in the tests
+	channelValue = sdk.NewInt(1000)
+	// quota allows net flows of up to 10% of the channel value over 24 hours in both directions
+	quota = types.NewQuota(10, 10, 24)
+)
+
+type RateLimitingTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitingTestSuite))
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+// setRateLimit sets the test quota on the given chain for the given port, channel and denomination
+func (suite *RateLimitingTestSuite) setRateLimit(chain *ibctesting.TestChain, portID, channelID, denom string) {
+	ctx := chain.GetContext()
+	rateLimit := types.NewRateLimit(types.NewPath(denom, portID, channelID), quota, channelValue, uint64(ctx.BlockTime().UnixNano()))
+	chain.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+}
+
+// getFlow returns the flow of the rate limit on the given chain for the given port, channel and denomination
+func (suite *RateLimitingTestSuite) getFlow(chain *ibctesting.TestChain, portID, channelID, denom string) types.Flow {
+	rateLimit, found := chain.GetSimApp().RateLimitingKeeper.GetRateLimit(chain.GetContext(), portID, channelID, denom)
+	suite.Require().True(found)
+
+	return rateLimit.Flow
+}
+
+// sendTransfer sends the given amount of the staking denomination from chainA to chainB
+func (suite *RateLimitingTestSuite) sendTransfer(amount int64, timeoutTimestamp uint64) (channeltypes.Packet, error) {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(), timeoutTimestamp, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return ibctesting.ParsePacketFromEvents(res.GetEvents())
+}
+
+// sendTransferWithKeeper sends the given amount of the staking denomination from chainA to chainB
+// through the transfer keeper, without committing a block
+func (suite *RateLimitingTestSuite) sendTransferWithKeeper(amount int64) error {
+	return suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+		suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)),
+		suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.ZeroHeight(), disabledTimeoutTimestamp(),
+		"",
+	)
+}
+
+func (suite *RateLimitingTestSuite) TestSendWithinQuota() {
+	suite.setRateLimit(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+	packet, err := suite.sendTransfer(100, disabledTimeoutTimestamp())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewInt(100), suite.getFlow(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom).Outflow)
+
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingPacket(suite.chainA.GetContext(), true, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), sdk.DefaultBondDenom)
+	suite.Require().True(found)
+
+	// the outflow is kept once the packet is successfully acknowledged
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	_, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingPacket(suite.chainA.GetContext(), true, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), sdk.DefaultBondDenom)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(100), suite.getFlow(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom).Outflow)
+}
+
+func (suite *RateLimitingTestSuite) TestSendOverQuota() {
+	suite.setRateLimit(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+	_, err := suite.sendTransfer(60, disabledTimeoutTimestamp())
+	suite.Require().NoError(err)
+
+	err = suite.sendTransferWithKeeper(41)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	suite.Require().Equal(sdk.NewInt(60), suite.getFlow(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom).Outflow)
+
+	// other ports, channels and denominations are not rate limited
+	suite.chainA.GetSimApp().RateLimitingKeeper.DeleteRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.setRateLimit(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, "channel-1", sdk.DefaultBondDenom)
+	suite.setRateLimit(suite.chainA, "other-port", suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+	err = suite.sendTransferWithKeeper(41)
+	suite.Require().NoError(err)
+}
+
+func (suite *RateLimitingTestSuite) TestSendTimeoutUndoesOutflow() {
+	suite.setRateLimit(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+	timeout := uint64(suite.chainB.LastHeader.GetTime().Add(time.Minute).UnixNano())
+	packet, err := suite.sendTransfer(100, timeout)
+	suite.Require().NoError(err)
+
+	// the quota is exhausted until the packet times out
+	err = suite.sendTransferWithKeeper(1)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().True(suite.getFlow(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom).Outflow.IsZero())
+
+	_, err = suite.sendTransfer(100, disabledTimeoutTimestamp())
+	suite.Require().NoError(err)
+}
+
+func (suite *RateLimitingTestSuite) TestSendErrorAcknowledgementUndoesOutflow() {
+	suite.setRateLimit(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+	packet, err := suite.sendTransfer(100, disabledTimeoutTimestamp())
+	suite.Require().NoError(err)
+
+	// disable receiving on chainB so the packet is acknowledged with an error
+	suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), transfertypes.NewParams(true, false))
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ackBz))
+
+	suite.Require().True(suite.getFlow(suite.chainA, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom).Outflow.IsZero())
+
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingPacket(suite.chainA.GetContext(), true, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), sdk.DefaultBondDenom)
+	suite.Require().False(found)
+}
+
+func (suite *RateLimitingTestSuite) TestReceiveOverQuota() {
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	suite.setRateLimit(suite.chainB, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, voucherDenom)
+
+	// receive within quota
+	packet, err := suite.sendTransfer(100, disabledTimeoutTimestamp())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ackBz)
+	suite.Require().Equal(sdk.NewInt(100), suite.getFlow(suite.chainB, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, voucherDenom).Inflow)
+
+	// receive over quota
+	packet, err = suite.sendTransfer(1, disabledTimeoutTimestamp())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	res, err = suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ackBz, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+	suite.Require().False(ack.Success())
+
+	suite.Require().Equal(sdk.NewInt(100), suite.getFlow(suite.chainB, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, voucherDenom).Inflow)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+
+	// the sender is refunded on chainA
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ackBz))
+}
+
+// disabledTimeoutTimestamp returns a timeout timestamp far in the future
+func disabledTimeoutTimestamp() uint64 {
+	return uint64(time.Now().Add(24 * time.Hour).UnixNano())
+}
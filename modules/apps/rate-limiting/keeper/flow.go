@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// AddFlow records the given amount as sent, if send is true, or as received on the given port and channel
+// against the rate limit of the port, channel and denomination. An error is returned if the quota is exceeded.
+// If the port, channel and denomination are rate limited, the returned pending packet allows the flow to be
+// undone if the packet fails.
+func (k Keeper) AddFlow(ctx sdk.Context, send bool, portID, channelID string, sequence uint64, denom string, amount sdk.Int) (types.PendingPacket, bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, portID, channelID, denom)
+	if !found {
+		return types.PendingPacket{}, false, nil
+	}
+
+	now := uint64(ctx.BlockTime().UnixNano())
+	k.rollWindow(ctx, &rateLimit, now)
+
+	if err := rateLimit.AddFlow(send, amount, now); err != nil {
+		direction := types.AttributeValueRecv
+		if send {
+			direction = types.AttributeValueSend
+		}
+
+		k.Logger(ctx).Info("rate limit quota exceeded", "port-id", portID, "channel-id", channelID, "denom", denom, "amount", amount, "direction", direction)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRateLimitExceeded,
+				sdk.NewAttribute(types.AttributeKeyPortID, portID),
+				sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			),
+		)
+
+		return types.PendingPacket{}, false, err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+
+	return types.NewPendingPacket(portID, channelID, sequence, send, denom, amount, rateLimit.Flow.WindowStart), true, nil
+}
+
+// AddPacketFlows records the flow of every token of an ICS-20 packet sent, if send is true, or received on the
+// given port and channel using AddFlow. The denomination recorded for a token is returned by denomFn. Tokens with an
+// invalid amount are skipped as the packet is rejected by the transfer application. The pending packets of the
+// rate limited tokens are returned.
+func (k Keeper) AddPacketFlows(
+	ctx sdk.Context, send bool, portID, channelID string, sequence uint64,
+	tokens []transfertypes.Token, denomFn func(denom string) string,
+) ([]types.PendingPacket, error) {
+	var pendingPackets []types.PendingPacket
+	for _, token := range tokens {
+		amount, ok := sdk.NewIntFromString(token.Amount)
+		if !ok {
+			continue
+		}
+
+		pendingPacket, found, err := k.AddFlow(ctx, send, portID, channelID, sequence, denomFn(token.Denom), amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+			pendingPackets = append(pendingPackets, pendingPacket)
+		}
+	}
+
+	return pendingPackets, nil
+}
+
+// OnPacketCompleted deletes the pending packets of every denomination sent, if send is true, or received on
+// the given port and channel with the given sequence. If the packet failed, their flows are undone.
+func (k Keeper) OnPacketCompleted(ctx sdk.Context, send bool, portID, channelID string, sequence uint64, success bool) {
+	for _, pendingPacket := range k.GetPendingPacketsForSequence(ctx, send, portID, channelID, sequence) {
+		k.DeletePendingPacket(ctx, send, portID, channelID, sequence, pendingPacket.Denom)
+
+		if success {
+			continue
+		}
+
+		rateLimit, found := k.GetRateLimit(ctx, portID, channelID, pendingPacket.Denom)
+		if !found {
+			continue
+		}
+
+		k.rollWindow(ctx, &rateLimit, uint64(ctx.BlockTime().UnixNano()))
+		rateLimit.UndoFlow(send, pendingPacket.Amount, pendingPacket.WindowStart)
+
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// HandleSetRateLimitProposal adds or updates the rate limit contained in the given governance proposal.
+// The flows of the rate limit are reset and the channel value is set to the current supply of the denomination.
+func (k Keeper) HandleSetRateLimitProposal(ctx sdk.Context, p *types.SetRateLimitProposal) error {
+	supply := k.bankKeeper.GetSupply(ctx, p.Path.Denom)
+	if !supply.Amount.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrZeroChannelValue, "denom %s has no supply", p.Path.Denom)
+	}
+
+	rateLimit := types.NewRateLimit(p.Path, p.Quota, supply.Amount, uint64(ctx.BlockTime().UnixNano()))
+	k.SetRateLimit(ctx, rateLimit)
+
+	k.Logger(ctx).Info("rate limit set", "port-id", p.Path.PortId, "channel-id", p.Path.ChannelId, "denom", p.Path.Denom, "max-percent-send", p.Quota.MaxPercentSend,
+		"max-percent-recv", p.Quota.MaxPercentRecv, "duration-hours", p.Quota.DurationHours)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyPortID, p.Path.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, p.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, p.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentSend, fmt.Sprintf("%d", p.Quota.MaxPercentSend)),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentRecv, fmt.Sprintf("%d", p.Quota.MaxPercentRecv)),
+			sdk.NewAttribute(types.AttributeKeyDurationHours, fmt.Sprintf("%d", p.Quota.DurationHours)),
+		),
+	)
+
+	return nil
+}
+
+// HandleRemoveRateLimitProposal removes the rate limit of the port, channel and denomination contained in the
+// given governance proposal.
+func (k Keeper) HandleRemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	if _, found := k.GetRateLimit(ctx, p.Path.PortId, p.Path.ChannelId, p.Path.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "port ID (%s) channel ID (%s) denom (%s)", p.Path.PortId, p.Path.ChannelId, p.Path.Denom)
+	}
+
+	k.DeleteRateLimit(ctx, p.Path.PortId, p.Path.ChannelId, p.Path.Denom)
+
+	k.Logger(ctx).Info("rate limit removed", "port-id", p.Path.PortId, "channel-id", p.Path.ChannelId, "denom", p.Path.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveRateLimit,
+			sdk.NewAttribute(types.AttributeKeyPortID, p.Path.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, p.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, p.Path.Denom),
+		),
+	)
+
+	return nil
+}
+
+// rollWindow advances the rate limit to the window containing the given time. When a new window is
+// started, the channel value is updated to the current supply of the denomination, unless it is zero.
+func (k Keeper) rollWindow(ctx sdk.Context, rateLimit *types.RateLimit, now uint64) {
+	if !rateLimit.RollWindow(now) {
+		return
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom); supply.Amount.IsPositive() {
+		rateLimit.Flow.ChannelValue = supply.Amount
+	}
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Keeper defines the rate limiting middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper types.ICS4Wrapper
+	bankKeeper  types.BankKeeper
+}
+
+// NewKeeper creates a new rate limiting middleware Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, ics4Wrapper types.ICS4Wrapper, bankKeeper types.BankKeeper) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		ics4Wrapper: ics4Wrapper,
+		bankKeeper:  bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// SendPacket wraps IBC ChannelKeeper's SendPacket function. The outflow of every token of ICS-20 packets
+// is recorded against the rate limit of the source port, channel and denomination, and the packet is rejected
+// if the outflow quota of any token is exceeded.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	version, found := k.ics4Wrapper.GetAppVersion(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version)
+	if err != nil {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	pendingPackets, err := k.AddPacketFlows(ctx, true, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), data.Tokens, types.GetSentDenom)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	for _, pendingPacket := range pendingPackets {
+		k.SetPendingPacket(ctx, pendingPacket)
+	}
+
+	return nil
+}
+
+// WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function. The inflow of a packet
+// acknowledged asynchronously is undone if the acknowledgement is an error.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	k.OnPacketCompleted(ctx, false, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), acknowledgement.Success())
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// SetRateLimit stores the rate limit keyed by its port, channel and denomination
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRateLimit(rateLimit.Path.PortId, rateLimit.Path.ChannelId, rateLimit.Path.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// GetRateLimit retrieves the rate limit of the given port, channel and denomination
+func (k Keeper) GetRateLimit(ctx sdk.Context, portID, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(portID, channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// DeleteRateLimit deletes the rate limit of the given port, channel and denomination
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, portID, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(portID, channelID, denom))
+}
+
+// GetAllRateLimits returns all rate limits stored
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.RateLimitKeyPrefix))
+	defer iterator.Close()
+
+	var rateLimits []types.RateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// SetPendingPacket stores the pending packet keyed by its direction, port, channel, sequence and denomination
+func (k Keeper) SetPendingPacket(ctx sdk.Context, pendingPacket types.PendingPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPendingPacket(pendingPacket.Send, pendingPacket.PortId, pendingPacket.ChannelId, pendingPacket.Sequence, pendingPacket.Denom), k.cdc.MustMarshal(&pendingPacket))
+}
+
+// GetPendingPacket retrieves the pending packet of the given denomination sent, or received, on the given
+// port and channel with the given sequence
+func (k Keeper) GetPendingPacket(ctx sdk.Context, send bool, portID, channelID string, sequence uint64, denom string) (types.PendingPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingPacket(send, portID, channelID, sequence, denom))
+	if bz == nil {
+		return types.PendingPacket{}, false
+	}
+
+	var pendingPacket types.PendingPacket
+	k.cdc.MustUnmarshal(bz, &pendingPacket)
+
+	return pendingPacket, true
+}
+
+// DeletePendingPacket deletes the pending packet of the given denomination sent, or received, on the given
+// port and channel with the given sequence
+func (k Keeper) DeletePendingPacket(ctx sdk.Context, send bool, portID, channelID string, sequence uint64, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingPacket(send, portID, channelID, sequence, denom))
+}
+
+// GetPendingPacketsForSequence returns the pending packets of all the denominations sent, or received, on
+// the given port and channel with the given sequence
+func (k Keeper) GetPendingPacketsForSequence(ctx sdk.Context, send bool, portID, channelID string, sequence uint64) []types.PendingPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPendingPacketSequencePrefix(send, portID, channelID, sequence))
+	defer iterator.Close()
+
+	var pendingPackets []types.PendingPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingPacket types.PendingPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
+}
+
+// GetAllPendingPackets returns all pending packets stored
+func (k Keeper) GetAllPendingPackets(ctx sdk.Context) []types.PendingPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.PendingPacketKeyPrefix))
+	defer iterator.Close()
+
+	var pendingPackets []types.PendingPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingPacket types.PendingPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
+}
@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chain used for convenience and readability
+	chainA *ibctesting.TestChain
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().RateLimitingKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestHandleSetRateLimitProposal() {
+	var path types.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: existing rate limit is replaced",
+			func() {
+				rateLimit := types.NewRateLimit(path, types.NewQuota(50, 50, 1), sdk.NewInt(1), 0)
+				rateLimit.Flow.Outflow = sdk.NewInt(1)
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			},
+			true,
+		},
+		{
+			"denom has no supply",
+			func() {
+				path.Denom = "nosupply"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = types.NewPath(sdk.DefaultBondDenom, ibctesting.TransferPort, ibctesting.FirstChannelID)
+			quota := types.NewQuota(10, 20, 24)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().RateLimitingKeeper.HandleSetRateLimitProposal(ctx, types.NewSetRateLimitProposal("title", "description", path, quota).(*types.SetRateLimitProposal))
+
+			rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, path.PortId, path.ChannelId, path.Denom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, path.Denom)
+				suite.Require().Equal(types.NewRateLimit(path, quota, supply.Amount, uint64(ctx.BlockTime().UnixNano())), rateLimit)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleRemoveRateLimitProposal() {
+	path := types.NewPath(sdk.DefaultBondDenom, ibctesting.TransferPort, ibctesting.FirstChannelID)
+	proposal := types.NewRemoveRateLimitProposal("title", "description", path).(*types.RemoveRateLimitProposal)
+
+	ctx := suite.chainA.GetContext()
+	err := suite.chainA.GetSimApp().RateLimitingKeeper.HandleRemoveRateLimitProposal(ctx, proposal)
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, types.NewRateLimit(path, types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0))
+
+	err = suite.chainA.GetSimApp().RateLimitingKeeper.HandleRemoveRateLimitProposal(ctx, proposal)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, path.PortId, path.ChannelId, path.Denom)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	rateLimits := []types.RateLimit{
+		types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.TransferPort, "channel-0"), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0),
+		types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.TransferPort, "channel-1"), types.NewQuota(5, 0, 1), sdk.NewInt(1000), 0),
+	}
+	pendingPackets := []types.PendingPacket{
+		types.NewPendingPacket(ibctesting.TransferPort, "channel-0", 1, true, sdk.DefaultBondDenom, sdk.NewInt(10), 0),
+		types.NewPendingPacket(ibctesting.TransferPort, "channel-0", 1, false, sdk.DefaultBondDenom, sdk.NewInt(10), 0),
+	}
+
+	genesis := types.NewGenesisState(rateLimits, pendingPackets)
+
+	suite.Require().NotPanics(func() {
+		suite.chainA.GetSimApp().RateLimitingKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+	})
+
+	exported := suite.chainA.GetSimApp().RateLimitingKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(rateLimits, exported.RateLimits)
+	suite.Require().ElementsMatch(pendingPackets, exported.PendingPackets)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	var (
+		req           *types.QueryRateLimitsRequest
+		expRateLimits []types.RateLimit
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryRateLimitsRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expRateLimits = []types.RateLimit{
+					types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.TransferPort, "channel-0"), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0),
+					types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.TransferPort, "channel-1"), types.NewQuota(5, 0, 1), sdk.NewInt(1000), 0),
+				}
+
+				for _, rateLimit := range expRateLimits {
+					suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+				}
+
+				req = &types.QueryRateLimitsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expRateLimits = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.RateLimits(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRateLimits, res.RateLimits)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var (
+		req          *types.QueryRateLimitRequest
+		expRateLimit types.RateLimit
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expRateLimit = types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, ibctesting.TransferPort, "channel-0"), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0)
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), expRateLimit)
+
+				req = &types.QueryRateLimitRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "channel-0",
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			true,
+		},
+		{
+			"rate limit not found",
+			func() {
+				req = &types.QueryRateLimitRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "channel-0",
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			false,
+		},
+		{
+			"rate limit on another port",
+			func() {
+				rateLimit := types.NewRateLimit(types.NewPath(sdk.DefaultBondDenom, "other-port", "channel-0"), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0)
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+				req = &types.QueryRateLimitRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "channel-0",
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryRateLimitRequest{
+					PortId:    "(invalid)",
+					ChannelId: "channel-0",
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryRateLimitRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "(invalid)",
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.RateLimit(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRateLimit, res.RateLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
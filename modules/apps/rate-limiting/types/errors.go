@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// rate limiting middleware sentinel errors
+var (
+	ErrInvalidRateLimit  = sdkerrors.Register(ModuleName, 2, "invalid rate limit")
+	ErrRateLimitNotFound = sdkerrors.Register(ModuleName, 3, "rate limit not found")
+	ErrQuotaExceeded     = sdkerrors.Register(ModuleName, 4, "rate limit quota exceeded")
+	ErrZeroChannelValue  = sdkerrors.Register(ModuleName, 5, "channel value is zero")
+)
@@ -0,0 +1,20 @@
+package types
+
+// rate limiting middleware events
+const (
+	EventTypeRateLimitExceeded = "rate_limit_exceeded"
+	EventTypeSetRateLimit      = "set_rate_limit"
+	EventTypeRemoveRateLimit   = "remove_rate_limit"
+
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyDirection      = "direction"
+	AttributeKeyMaxPercentSend = "max_percent_send"
+	AttributeKeyMaxPercentRecv = "max_percent_recv"
+	AttributeKeyDurationHours  = "duration_hours"
+
+	AttributeValueSend = "send"
+	AttributeValueRecv = "recv"
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a rate limiting middleware GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingPackets []PendingPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:     rateLimits,
+		PendingPackets: pendingPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no rate limits.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:     []RateLimit{},
+		PendingPackets: []PendingPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenRateLimits := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		key := string(KeyRateLimit(rateLimit.Path.PortId, rateLimit.Path.ChannelId, rateLimit.Path.Denom))
+		if seenRateLimits[key] {
+			return sdkerrors.Wrapf(ErrInvalidRateLimit, "duplicated rate limit for port ID %s, channel ID %s and denom %s", rateLimit.Path.PortId, rateLimit.Path.ChannelId, rateLimit.Path.Denom)
+		}
+
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		seenRateLimits[key] = true
+	}
+
+	for _, pendingPacket := range gs.PendingPackets {
+		if err := pendingPacket.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the rate limiting middleware name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limiting middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limiting middleware
+	QuerierRoute = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for rate limits stored by port, channel and denomination
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingPacketKeyPrefix is the key prefix for pending packets stored by direction, port, channel, sequence and denomination
+	PendingPacketKeyPrefix = "pendingPacket"
+)
+
+// KeyRateLimit returns the key under which the rate limit of the given port, channel and denomination is stored
+func KeyRateLimit(portID, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", RateLimitKeyPrefix, portID, channelID, denom))
+}
+
+// KeyPendingPacket returns the key under which the pending packet of the given denomination sent or received
+// on the given port and channel with the given sequence is stored
+func KeyPendingPacket(send bool, portID, channelID string, sequence uint64, denom string) []byte {
+	return append(KeyPendingPacketSequencePrefix(send, portID, channelID, sequence), []byte(denom)...)
+}
+
+// KeyPendingPacketSequencePrefix returns the key prefix of the pending packets of all the denominations
+// sent or received on the given port and channel with the given sequence
+func KeyPendingPacketSequencePrefix(send bool, portID, channelID string, sequence uint64) []byte {
+	direction := "recv"
+	if send {
+		direction = "send"
+	}
+
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%d/", PendingPacketKeyPrefix, direction, portID, channelID, sequence))
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetRateLimit defines the type for a SetRateLimitProposal
+	ProposalTypeSetRateLimit = "SetRateLimit"
+	// ProposalTypeRemoveRateLimit defines the type for a RemoveRateLimitProposal
+	ProposalTypeRemoveRateLimit = "RemoveRateLimit"
+)
+
+var (
+	_ govtypes.Content = &SetRateLimitProposal{}
+	_ govtypes.Content = &RemoveRateLimitProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetRateLimit)
+	govtypes.RegisterProposalType(ProposalTypeRemoveRateLimit)
+}
+
+// NewSetRateLimitProposal creates a new set rate limit proposal.
+func NewSetRateLimitProposal(title, description string, path Path, quota Quota) govtypes.Content {
+	return &SetRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Path:        path,
+		Quota:       quota,
+	}
+}
+
+// GetTitle returns the title of a set rate limit proposal.
+func (p *SetRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set rate limit proposal.
+func (p *SetRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set rate limit proposal.
+func (p *SetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set rate limit proposal.
+func (p *SetRateLimitProposal) ProposalType() string { return ProposalTypeSetRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := p.Path.Validate(); err != nil {
+		return err
+	}
+
+	return p.Quota.Validate()
+}
+
+// NewRemoveRateLimitProposal creates a new remove rate limit proposal.
+func NewRemoveRateLimitProposal(title, description string, path Path) govtypes.Content {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Path:        path,
+	}
+}
+
+// GetTitle returns the title of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Path.Validate()
+}
@@ -0,0 +1,261 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewPath creates a new Path instance
+func NewPath(denom, portID, channelID string) Path {
+	return Path{
+		Denom:     denom,
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a stateless validation of the Path fields
+func (p Path) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid port ID %s", p.PortId)
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid channel ID %s", p.ChannelId)
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	if strings.HasPrefix(p.Denom, transfertypes.DenomPrefix+"/") {
+		if _, err := transfertypes.ParseHexHash(strings.TrimPrefix(p.Denom, transfertypes.DenomPrefix+"/")); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidRateLimit, "invalid IBC denomination %s: %s", p.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// NewQuota creates a new Quota instance
+func NewQuota(maxPercentSend, maxPercentRecv, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate performs a stateless validation of the Quota fields
+func (q Quota) Validate() error {
+	if q.MaxPercentSend > 100 || q.MaxPercentRecv > 100 {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "max percentages must not exceed 100 (send: %d, recv: %d)", q.MaxPercentSend, q.MaxPercentRecv)
+	}
+
+	if q.MaxPercentSend == 0 && q.MaxPercentRecv == 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "at least one of the send and recv max percentages must be set")
+	}
+
+	if q.DurationHours == 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "duration must be greater than zero")
+	}
+
+	return nil
+}
+
+// Duration returns the duration of the rolling window in nanoseconds
+func (q Quota) Duration() uint64 {
+	return q.DurationHours * uint64(time.Hour)
+}
+
+// NewFlow creates a new Flow instance for a window starting at the given time, in unix nanoseconds,
+// with no recorded flows
+func NewFlow(channelValue sdk.Int, windowStart uint64) Flow {
+	return Flow{
+		Inflow:          sdk.ZeroInt(),
+		Outflow:         sdk.ZeroInt(),
+		PreviousInflow:  sdk.ZeroInt(),
+		PreviousOutflow: sdk.ZeroInt(),
+		ChannelValue:    channelValue,
+		WindowStart:     windowStart,
+	}
+}
+
+// NewRateLimit creates a new RateLimit instance with no recorded flows
+func NewRateLimit(path Path, quota Quota, channelValue sdk.Int, windowStart uint64) RateLimit {
+	return RateLimit{
+		Path:  path,
+		Quota: quota,
+		Flow:  NewFlow(channelValue, windowStart),
+	}
+}
+
+// Validate performs a stateless validation of the RateLimit fields
+func (r RateLimit) Validate() error {
+	if err := r.Path.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.Quota.Validate(); err != nil {
+		return err
+	}
+
+	for _, amount := range []sdk.Int{r.Flow.Inflow, r.Flow.Outflow, r.Flow.PreviousInflow, r.Flow.PreviousOutflow, r.Flow.ChannelValue} {
+		if amount.IsNil() || amount.IsNegative() {
+			return sdkerrors.Wrap(ErrInvalidRateLimit, "flows and channel value must not be nil or negative")
+		}
+	}
+
+	return nil
+}
+
+// RollWindow advances the flow to the window containing the given time, in unix nanoseconds. The flows of
+// the current window become the flows of the previous window, or are discarded if more than one window
+// has passed. It returns true if a new window was started.
+func (r *RateLimit) RollWindow(now uint64) bool {
+	duration := r.Quota.Duration()
+	if now < r.Flow.WindowStart+duration {
+		return false
+	}
+
+	windows := (now - r.Flow.WindowStart) / duration
+	if windows == 1 {
+		r.Flow.PreviousInflow = r.Flow.Inflow
+		r.Flow.PreviousOutflow = r.Flow.Outflow
+	} else {
+		r.Flow.PreviousInflow = sdk.ZeroInt()
+		r.Flow.PreviousOutflow = sdk.ZeroInt()
+	}
+
+	r.Flow.Inflow = sdk.ZeroInt()
+	r.Flow.Outflow = sdk.ZeroInt()
+	r.Flow.WindowStart += windows * duration
+
+	return true
+}
+
+// NetOutflow estimates the net outflow over the rolling window ending at the given time, in unix
+// nanoseconds. The net flow of the previous window is weighted by the share of the rolling window
+// which overlaps with it. The returned value is negative if more tokens were received than sent.
+// RollWindow must be called beforehand.
+func (r RateLimit) NetOutflow(now uint64) sdk.Int {
+	duration := r.Quota.Duration()
+	current := r.Flow.Outflow.Sub(r.Flow.Inflow)
+	previous := r.Flow.PreviousOutflow.Sub(r.Flow.PreviousInflow)
+
+	remaining := duration - (now - r.Flow.WindowStart)
+
+	return current.Add(previous.Mul(sdk.NewIntFromUint64(remaining)).Quo(sdk.NewIntFromUint64(duration)))
+}
+
+// AddFlow records the given amount as sent if send is true, or as received otherwise, at the given time, in
+// unix nanoseconds. It returns an error if the estimated net flow in that direction exceeds the quota.
+// RollWindow must be called beforehand.
+func (r *RateLimit) AddFlow(send bool, amount sdk.Int, now uint64) error {
+	flow := *r
+	if send {
+		flow.Flow.Outflow = flow.Flow.Outflow.Add(amount)
+	} else {
+		flow.Flow.Inflow = flow.Flow.Inflow.Add(amount)
+	}
+
+	maxPercent := r.Quota.MaxPercentRecv
+	netFlow := flow.NetOutflow(now).Neg()
+	if send {
+		maxPercent = r.Quota.MaxPercentSend
+		netFlow = netFlow.Neg()
+	}
+
+	if maxPercent != 0 {
+		threshold := r.Flow.ChannelValue.Mul(sdk.NewIntFromUint64(maxPercent)).QuoRaw(100)
+		if netFlow.GT(threshold) {
+			return sdkerrors.Wrapf(ErrQuotaExceeded, "net flow (%s) exceeds threshold (%s) of %d%% of the channel value", netFlow, threshold, maxPercent)
+		}
+	}
+
+	r.Flow = flow.Flow
+	return nil
+}
+
+// UndoFlow removes the given amount, recorded as sent if send is true or as received otherwise, from the
+// window starting at the given time, in unix nanoseconds. Flows recorded before the previous window have
+// already expired and are ignored. RollWindow must be called beforehand.
+func (r *RateLimit) UndoFlow(send bool, amount sdk.Int, windowStart uint64) {
+	switch windowStart {
+	case r.Flow.WindowStart:
+		if send {
+			r.Flow.Outflow = subFloorZero(r.Flow.Outflow, amount)
+		} else {
+			r.Flow.Inflow = subFloorZero(r.Flow.Inflow, amount)
+		}
+	case r.Flow.WindowStart - r.Quota.Duration():
+		if send {
+			r.Flow.PreviousOutflow = subFloorZero(r.Flow.PreviousOutflow, amount)
+		} else {
+			r.Flow.PreviousInflow = subFloorZero(r.Flow.PreviousInflow, amount)
+		}
+	}
+}
+
+// subFloorZero returns a - b, or zero if b is greater than a
+func subFloorZero(a, b sdk.Int) sdk.Int {
+	if b.GT(a) {
+		return sdk.ZeroInt()
+	}
+
+	return a.Sub(b)
+}
+
+// NewPendingPacket creates a new PendingPacket instance
+func NewPendingPacket(portID, channelID string, sequence uint64, send bool, denom string, amount sdk.Int, windowStart uint64) PendingPacket {
+	return PendingPacket{
+		PortId:      portID,
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		Send:        send,
+		Denom:       denom,
+		Amount:      amount,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs a stateless validation of the PendingPacket fields
+func (p PendingPacket) Validate() error {
+	if err := NewPath(p.Denom, p.PortId, p.ChannelId).Validate(); err != nil {
+		return err
+	}
+
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "packet sequence cannot be 0")
+	}
+
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "pending packet amount must be positive")
+	}
+
+	return nil
+}
+
+// GetSentDenom returns the denomination on this chain of the tokens sent in the given ICS-20 packet data
+func GetSentDenom(denom string) string {
+	return transfertypes.ParseDenomTrace(denom).IBCDenom()
+}
+
+// GetReceivedDenom returns the denomination on this chain of the tokens received in an ICS-20 packet
+// sent from the given source port and channel to the given destination port and channel
+func GetReceivedDenom(sourcePort, sourceChannel, destPort, destChannel, denom string) string {
+	if transfertypes.ReceiverChainIsSource(sourcePort, sourceChannel, denom) {
+		// the tokens are returning to this chain and are unescrowed
+		voucherPrefix := transfertypes.GetDenomPrefix(sourcePort, sourceChannel)
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	// the tokens originate from another chain and vouchers are minted
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(destPort, destChannel, denom)).IBCDenom()
+}
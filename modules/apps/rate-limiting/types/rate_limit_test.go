@@ -0,0 +1,165 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+const (
+	portID    = "transfer"
+	channelID = "channel-0"
+	hour      = uint64(time.Hour)
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rateLimit types.RateLimit
+		expPass   bool
+	}{
+		{"valid rate limit", types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0), true},
+		{"valid rate limit with ibc denom", types.NewRateLimit(types.NewPath("ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", portID, channelID), types.NewQuota(10, 0, 24), sdk.NewInt(1000), 0), true},
+		{"invalid port", types.NewRateLimit(types.NewPath("stake", "(invalid)", channelID), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0), false},
+		{"invalid channel", types.NewRateLimit(types.NewPath("stake", portID, "(invalid)"), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0), false},
+		{"invalid denom", types.NewRateLimit(types.NewPath("", portID, channelID), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0), false},
+		{"invalid ibc denom hash", types.NewRateLimit(types.NewPath("ibc/abc", portID, channelID), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0), false},
+		{"send percentage over 100", types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(101, 10, 24), sdk.NewInt(1000), 0), false},
+		{"both percentages zero", types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(0, 0, 24), sdk.NewInt(1000), 0), false},
+		{"zero duration", types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(10, 10, 0), sdk.NewInt(1000), 0), false},
+		{"negative channel value", types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(10, 10, 24), sdk.NewInt(-1), 0), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rateLimit.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRollWindow(t *testing.T) {
+	rateLimit := types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(10, 10, 1), sdk.NewInt(1000), 0)
+	rateLimit.Flow.Inflow = sdk.NewInt(10)
+	rateLimit.Flow.Outflow = sdk.NewInt(20)
+
+	// the window is not rolled before its end
+	require.False(t, rateLimit.RollWindow(hour-1))
+	require.Equal(t, sdk.NewInt(20), rateLimit.Flow.Outflow)
+
+	// the current flows become the previous flows
+	require.True(t, rateLimit.RollWindow(hour+1))
+	require.Equal(t, hour, rateLimit.Flow.WindowStart)
+	require.Equal(t, sdk.NewInt(10), rateLimit.Flow.PreviousInflow)
+	require.Equal(t, sdk.NewInt(20), rateLimit.Flow.PreviousOutflow)
+	require.True(t, rateLimit.Flow.Inflow.IsZero())
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+
+	// the flows are discarded if more than one window has passed
+	rateLimit.Flow.Outflow = sdk.NewInt(30)
+	require.True(t, rateLimit.RollWindow(3*hour+1))
+	require.Equal(t, 3*hour, rateLimit.Flow.WindowStart)
+	require.True(t, rateLimit.Flow.PreviousOutflow.IsZero())
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+}
+
+func TestAddFlow(t *testing.T) {
+	rateLimit := types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(10, 5, 1), sdk.NewInt(1000), 0)
+
+	// send up to the threshold of 100
+	require.NoError(t, rateLimit.AddFlow(true, sdk.NewInt(100), 0))
+	require.ErrorIs(t, rateLimit.AddFlow(true, sdk.NewInt(1), 0), types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(100), rateLimit.Flow.Outflow)
+
+	// receiving offsets the net outflow
+	require.NoError(t, rateLimit.AddFlow(false, sdk.NewInt(50), 0))
+	require.NoError(t, rateLimit.AddFlow(true, sdk.NewInt(50), 0))
+
+	// receive up to the threshold of 50
+	require.NoError(t, rateLimit.AddFlow(false, sdk.NewInt(150), 0))
+	require.ErrorIs(t, rateLimit.AddFlow(false, sdk.NewInt(1), 0), types.ErrQuotaExceeded)
+
+	// the previous window is weighted by its overlap with the rolling window
+	require.True(t, rateLimit.RollWindow(hour+hour/2))
+	require.Equal(t, sdk.NewInt(-25), rateLimit.NetOutflow(hour+hour/2))
+	require.NoError(t, rateLimit.AddFlow(true, sdk.NewInt(125), hour+hour/2))
+	require.ErrorIs(t, rateLimit.AddFlow(true, sdk.NewInt(1), hour+hour/2), types.ErrQuotaExceeded)
+
+	// a zero percentage disables the quota in that direction
+	rateLimit = types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(0, 5, 1), sdk.NewInt(1000), 0)
+	require.NoError(t, rateLimit.AddFlow(true, sdk.NewInt(1000000), 0))
+}
+
+func TestUndoFlow(t *testing.T) {
+	rateLimit := types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(10, 10, 1), sdk.NewInt(1000), 0)
+	require.NoError(t, rateLimit.AddFlow(true, sdk.NewInt(60), 0))
+	require.NoError(t, rateLimit.AddFlow(false, sdk.NewInt(20), 0))
+
+	rateLimit.UndoFlow(true, sdk.NewInt(10), 0)
+	require.Equal(t, sdk.NewInt(50), rateLimit.Flow.Outflow)
+
+	// flows are undone from the previous window
+	require.True(t, rateLimit.RollWindow(hour))
+	rateLimit.UndoFlow(false, sdk.NewInt(5), 0)
+	require.Equal(t, sdk.NewInt(15), rateLimit.Flow.PreviousInflow)
+
+	// flows are floored at zero
+	rateLimit.UndoFlow(true, sdk.NewInt(100), 0)
+	require.True(t, rateLimit.Flow.PreviousOutflow.IsZero())
+
+	// expired flows are ignored
+	require.True(t, rateLimit.RollWindow(2*hour))
+	require.NoError(t, rateLimit.AddFlow(false, sdk.NewInt(10), 2*hour))
+	rateLimit.UndoFlow(false, sdk.NewInt(5), 0)
+	require.Equal(t, sdk.NewInt(10), rateLimit.Flow.Inflow)
+}
+
+func TestGetReceivedDenom(t *testing.T) {
+	// native tokens returning to this chain are unescrowed
+	require.Equal(t, "stake", types.GetReceivedDenom("transfer", "channel-1", "transfer", channelID, "transfer/channel-1/stake"))
+
+	// tokens originating from another chain are received as vouchers
+	voucher := types.GetReceivedDenom("transfer", "channel-1", "transfer", channelID, "stake")
+	require.Equal(t, types.GetSentDenom("transfer/channel-0/stake"), voucher)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	rateLimit := types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"valid genesis", types.NewGenesisState([]types.RateLimit{rateLimit}, []types.PendingPacket{types.NewPendingPacket(portID, channelID, 1, true, "stake", sdk.NewInt(10), 0)}), true},
+		{"same channel and denom on different ports", types.NewGenesisState([]types.RateLimit{rateLimit, types.NewRateLimit(types.NewPath("stake", "other-port", channelID), types.NewQuota(10, 10, 24), sdk.NewInt(1000), 0)}, nil), true},
+		{"duplicated rate limit", types.NewGenesisState([]types.RateLimit{rateLimit, rateLimit}, nil), false},
+		{"invalid rate limit", types.NewGenesisState([]types.RateLimit{types.NewRateLimit(types.NewPath("stake", portID, channelID), types.NewQuota(0, 0, 24), sdk.NewInt(1000), 0)}, nil), false},
+		{"pending packet with zero sequence", types.NewGenesisState(nil, []types.PendingPacket{types.NewPendingPacket(portID, channelID, 0, true, "stake", sdk.NewInt(10), 0)}), false},
+		{"pending packet with zero amount", types.NewGenesisState(nil, []types.PendingPacket{types.NewPendingPacket(portID, channelID, 1, true, "stake", sdk.ZeroInt(), 0)}), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
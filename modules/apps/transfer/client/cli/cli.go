@@ -21,6 +21,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
+		GetCmdQueryTransferEnabled(),
+		GetCmdQueryTransferEnabledOverrides(),
+		GetCmdQueryTotalEscrowForDenom(),
 	)
 
 	return queryCmd
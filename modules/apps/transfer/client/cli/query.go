@@ -167,3 +167,116 @@ func GetCmdQueryDenomHash() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTransferEnabled defines the command to query whether sending and receiving over a
+// channel is enabled.
+func GetCmdQueryTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-enabled [port-id] [channel-id]",
+		Short:   "Query whether sending and receiving over a channel is enabled",
+		Long:    "Query whether sending and receiving over a channel is enabled, taking into account the parameters and overrides. Use the denom flag to take into account the overrides of a single denomination.",
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-enabled [port-id] [channel-id] --denom [denom]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTransferEnabledRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Denom:     denom,
+			}
+
+			res, err := queryClient.TransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "denomination on this chain, either a native denomination or an ibc/{hash} voucher denomination")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTransferEnabledOverrides defines the command to query all the send and receive
+// enabled overrides.
+func GetCmdQueryTransferEnabledOverrides() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-enabled-overrides",
+		Short:   "Query all the send and receive enabled overrides",
+		Long:    "Query all the send and receive enabled overrides",
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-enabled-overrides", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTransferEnabledOverridesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TransferEnabledOverrides(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfer enabled overrides")
+
+	return cmd
+}
+
+// GetCmdQueryTotalEscrowForDenom defines the command to query the total amount of tokens
+// escrowed for a denomination.
+func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-escrow [denom]",
+		Short:   "Query the total amount of tokens of a denomination in escrow",
+		Long:    "Query the total amount of tokens of a denomination escrowed by the transfer module over all channels",
+		Example: fmt.Sprintf("%s query ibc-transfer total-escrow uosmo", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalEscrowForDenomRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.TotalEscrowForDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
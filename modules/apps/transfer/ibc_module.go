@@ -29,8 +29,8 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 }
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
-// channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
-// supported version. Only 2^32 channels are allowed to be created.
+// channel must be UNORDERED and use the correct port (by default 'transfer'). Only 2^32 channels
+// are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx sdk.Context,
 	keeper keeper.Keeper,
@@ -75,8 +75,8 @@ func (im IBCModule) OnChanOpenInit(
 		return err
 	}
 
-	if version != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !types.IsSupportedVersion(version) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s or %s", version, types.Version, types.MultiDenomVersion)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -102,8 +102,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s or %s", counterpartyVersion, types.Version, types.MultiDenomVersion)
 	}
 
 	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
@@ -117,7 +117,8 @@ func (im IBCModule) OnChanOpenTry(
 		}
 	}
 
-	return types.Version, nil
+	// accept the version proposed by the counterparty as both versions are supported
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -128,8 +129,8 @@ func (im IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s or %s", counterpartyVersion, types.Version, types.MultiDenomVersion)
 	}
 	return nil
 }
@@ -164,7 +165,8 @@ func (im IBCModule) OnChanCloseConfirm(
 
 // OnRecvPacket implements the IBCModule interface. A successful acknowledgement
 // is returned if the packet data is successfully decoded and the receive application
-// logic returns without error.
+// logic returns without error. The packet data is decoded according to the ICS-20
+// version of the channel.
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -172,29 +174,32 @@ func (im IBCModule) OnRecvPacket(
 ) ibcexported.Acknowledgement {
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.unmarshalPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
 		ack = channeltypes.NewErrorAcknowledgement("cannot unmarshal ICS-20 transfer packet data")
 	}
 
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
-		err := im.keeper.OnRecvPacket(ctx, packet, data)
+		err := im.keeper.OnRecvMultiDenomPacket(ctx, packet, data)
 		if err != nil {
 			ack = types.NewErrorAcknowledgement(err)
 		}
 	}
 
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	attributes = append(attributes, tokenAttributes(types.AttributeKeyDenom, types.AttributeKeyAmount, data.Tokens)...)
+	attributes = append(attributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+	)
+
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
-		),
+		sdk.NewEvent(types.EventTypePacket, attributes...),
 	)
 
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
@@ -212,24 +217,27 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.unmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
-	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
+	if err := im.keeper.OnAcknowledgementMultiDenomPacket(ctx, packet, data, ack); err != nil {
 		return err
 	}
 
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	attributes = append(attributes, tokenAttributes(types.AttributeKeyDenom, types.AttributeKeyAmount, data.Tokens)...)
+	attributes = append(attributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+	)
+
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
-		),
+		sdk.NewEvent(types.EventTypePacket, attributes...),
 	)
 
 	switch resp := ack.Response.(type) {
@@ -258,24 +266,60 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.unmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 	// refund tokens
-	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+	if err := im.keeper.OnTimeoutMultiDenomPacket(ctx, packet, data); err != nil {
 		return err
 	}
 
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+	}
+	attributes = append(attributes, tokenAttributes(types.AttributeKeyRefundDenom, types.AttributeKeyRefundAmount, data.Tokens)...)
+	attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeTimeout,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
-		),
+		sdk.NewEvent(types.EventTypeTimeout, attributes...),
 	)
 
 	return nil
 }
+
+// UnmarshalPacketData decodes the packet data sent or received on the given channel end according to
+// its ICS-20 version. It implements the PacketDataUnmarshaler interface of the callbacks middleware.
+func (im IBCModule) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (interface{}, error) {
+	data, err := im.unmarshalPacketData(ctx, portID, channelID, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// unmarshalPacketData decodes the packet data according to the ICS-20 version of the given channel.
+func (im IBCModule) unmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (types.MultiDenomFungibleTokenPacketData, error) {
+	version, found := im.keeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return types.MultiDenomFungibleTokenPacketData{}, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return types.UnmarshalPacketData(bz, version)
+}
+
+// tokenAttributes returns a denomination and an amount attribute, using the given keys,
+// for every token of a packet.
+func tokenAttributes(denomKey, amountKey string, tokens []types.Token) []sdk.Attribute {
+	attributes := make([]sdk.Attribute, 0, 2*len(tokens))
+	for _, token := range tokens {
+		attributes = append(attributes,
+			sdk.NewAttribute(denomKey, token.Denom),
+			sdk.NewAttribute(amountKey, token.Amount),
+		)
+	}
+
+	return attributes
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// GetTotalEscrowForDenom gets the total amount of source chain tokens that
+// are in escrow, keyed by the denomination.
+func (k Keeper) GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalEscrowKey)
+	bz := store.Get(types.GetTotalEscrowKey(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return sdk.NewCoin(denom, amount)
+}
+
+// SetTotalEscrowForDenom stores the total amount of source chain tokens that are in escrow.
+// An amount of zero removes the denomination from the store.
+func (k Keeper) SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalEscrowKey)
+	key := types.GetTotalEscrowKey(coin.Denom)
+
+	if coin.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(key, bz)
+}
+
+// GetAllTotalEscrowed returns the total amount of tokens escrowed for all denominations.
+func (k Keeper) GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins {
+	escrows := sdk.Coins{}
+	k.IterateTokensInEscrow(ctx, func(coin sdk.Coin) bool {
+		escrows = escrows.Add(coin)
+		return false
+	})
+
+	return escrows
+}
+
+// IterateTokensInEscrow iterates over the total amounts of tokens escrowed per denomination
+// in the store and performs a callback function.
+func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, cb func(coin sdk.Coin) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalEscrowKey)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(sdk.NewCoin(string(iterator.Key()), amount)) {
+			break
+		}
+	}
+}
+
+// escrowToken sends the given token from the sender to the escrow address and increases
+// the total amount escrowed for its denomination.
+func (k Keeper) escrowToken(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, token sdk.Coin) error {
+	// escrow source tokens. It fails if balance insufficient.
+	if err := k.bankKeeper.SendCoins(ctx, sender, escrowAddress, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
+	currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+	k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(token))
+
+	return nil
+}
+
+// unescrowToken sends the given token from the escrow address to the receiver and decreases
+// the total amount escrowed for its denomination.
+func (k Keeper) unescrowToken(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) error {
+	currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+	if currentTotalEscrow.IsLT(token) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "total escrow (%s) is less than the unescrowed amount (%s)", currentTotalEscrow, token)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
+		// NOTE: this error is only expected to occur given an unexpected bug or a malicious
+		// counterparty module. The bug may occur in bank or any part of the code that allows
+		// the escrow address to be drained. A malicious counterparty module could drain the
+		// escrow address by allowing more tokens to be sent back then were escrowed.
+		return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+	}
+
+	k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Sub(token))
+
+	return nil
+}
@@ -28,13 +28,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 
 	k.SetParams(ctx, state.Params)
+
+	for _, override := range state.TransferEnabledOverrides {
+		k.SetTransferEnabledOverride(ctx, override)
+	}
+
+	for _, escrow := range state.TotalEscrowed {
+		k.SetTotalEscrowForDenom(ctx, escrow)
+	}
 }
 
-// ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
+// ExportGenesis exports ibc-transfer module's portID, denom trace info, params, transfer enabled overrides and total escrowed amounts into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:      k.GetPort(ctx),
-		DenomTraces: k.GetAllDenomTraces(ctx),
-		Params:      k.GetParams(ctx),
+		PortId:                   k.GetPort(ctx),
+		DenomTraces:              k.GetAllDenomTraces(ctx),
+		Params:                   k.GetParams(ctx),
+		TransferEnabledOverrides: k.GetAllTransferEnabledOverrides(ctx),
+		TotalEscrowed:            k.GetAllTotalEscrowed(ctx),
 	}
 }
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 )
 
@@ -28,10 +30,18 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
 	}
 
+	override := types.NewTransferEnabledOverride(types.PortID, "channel-0", "uatom", false, true)
+	suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(), override)
+
+	escrow := sdk.NewCoin("uatom", sdk.NewInt(100))
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), escrow)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(traces.Sort(), genesis.DenomTraces)
+	suite.Require().Equal([]types.TransferEnabledOverride{override}, genesis.TransferEnabledOverrides)
+	suite.Require().Equal(sdk.NewCoins(escrow), genesis.TotalEscrowed)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -108,3 +109,73 @@ func (q Keeper) DenomHash(c context.Context, req *types.QueryDenomHashRequest) (
 		Hash: denomHash.String(),
 	}, nil
 }
+
+// TransferEnabled implements the Query/TransferEnabled gRPC method
+func (q Keeper) TransferEnabled(c context.Context, req *types.QueryTransferEnabledRequest) (*types.QueryTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTransferEnabledResponse{
+		SendEnabled:    q.IsSendEnabled(ctx, req.PortId, req.ChannelId, req.Denom),
+		ReceiveEnabled: q.IsReceiveEnabled(ctx, req.PortId, req.ChannelId, req.Denom),
+	}, nil
+}
+
+// TransferEnabledOverrides implements the Query/TransferEnabledOverrides gRPC method
+func (q Keeper) TransferEnabledOverrides(c context.Context, req *types.QueryTransferEnabledOverridesRequest) (*types.QueryTransferEnabledOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	overrides := []types.TransferEnabledOverride{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.TransferEnabledOverrideKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var override types.TransferEnabledOverride
+		if err := q.cdc.Unmarshal(value, &override); err != nil {
+			return err
+		}
+
+		overrides = append(overrides, override)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferEnabledOverridesResponse{
+		Overrides:  overrides,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TotalEscrowForDenom implements the Query/TotalEscrowForDenom gRPC method
+func (q Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscrowForDenomRequest) (*types.QueryTotalEscrowForDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalEscrowForDenomResponse{
+		Amount: q.GetTotalEscrowForDenom(ctx, req.Denom),
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryDenomTrace() {
@@ -208,3 +209,217 @@ func (suite *KeeperTestSuite) TestQueryDenomHash() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryTransferEnabled() {
+	var (
+		req               *types.QueryTransferEnabledRequest
+		expSendEnabled    bool
+		expReceiveEnabled bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no overrides",
+			func() {
+				req = &types.QueryTransferEnabledRequest{PortId: ibctesting.TransferPort, ChannelId: ibctesting.FirstChannelID}
+				expSendEnabled, expReceiveEnabled = true, true
+			},
+			true,
+		},
+		{
+			"success: disabled by denom override",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, false, true))
+
+				req = &types.QueryTransferEnabledRequest{PortId: ibctesting.TransferPort, ChannelId: ibctesting.FirstChannelID, Denom: sdk.DefaultBondDenom}
+				expSendEnabled, expReceiveEnabled = false, true
+			},
+			true,
+		},
+		{
+			"success: denom override ignored without denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, false, true))
+
+				req = &types.QueryTransferEnabledRequest{PortId: ibctesting.TransferPort, ChannelId: ibctesting.FirstChannelID}
+				expSendEnabled, expReceiveEnabled = true, true
+			},
+			true,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryTransferEnabledRequest{PortId: ibctesting.TransferPort, ChannelId: ""}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.TransferEnabled(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expSendEnabled, res.SendEnabled)
+				suite.Require().Equal(expReceiveEnabled, res.ReceiveEnabled)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTransferEnabledOverrides() {
+	var (
+		req          *types.QueryTransferEnabledOverridesRequest
+		expOverrides []types.TransferEnabledOverride
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryTransferEnabledOverridesRequest{}
+				expOverrides = nil
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expOverrides = []types.TransferEnabledOverride{
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, "", false, false),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, true, false),
+				}
+
+				for _, override := range expOverrides {
+					suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(), override)
+				}
+
+				req = &types.QueryTransferEnabledOverridesRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.TransferEnabledOverrides(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expOverrides, res.Overrides)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
+	var (
+		req             *types.QueryTotalEscrowForDenomRequest
+		expEscrowAmount sdk.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid native denom",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: sdk.DefaultBondDenom,
+				}
+
+				expEscrowAmount = sdk.NewInt(100)
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, expEscrowAmount))
+			},
+			true,
+		},
+		{
+			"valid ibc denom",
+			func() {
+				denomTrace := types.DenomTrace{
+					Path:      "transfer/channel-0",
+					BaseDenom: sdk.DefaultBondDenom,
+				}
+
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+
+				expEscrowAmount = sdk.NewInt(100)
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denomTrace.IBCDenom(), expEscrowAmount))
+			},
+			true,
+		},
+		{
+			"valid denom without escrow",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "uatom",
+				}
+
+				expEscrowAmount = sdk.ZeroInt()
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "??",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.TotalEscrowForDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoin(req.Denom, expEscrowAmount), res.Amount)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
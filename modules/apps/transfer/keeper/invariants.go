@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers all transfer invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom", TotalEscrowPerDenomInvariant(k))
+}
+
+// AllInvariants runs all invariants of the transfer module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TotalEscrowPerDenomInvariant(k)(ctx)
+	}
+}
+
+// TotalEscrowPerDenomInvariant checks that the total amount escrowed for each denomination is covered
+// by the balances of the escrow accounts. The balances may exceed the total escrow, as tokens can be
+// sent directly to an escrow account outside of the transfer module.
+func TotalEscrowPerDenomInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		escrowBalances := k.GetEscrowBalances(ctx)
+
+		k.IterateTokensInEscrow(ctx, func(expectedEscrow sdk.Coin) bool {
+			actualEscrow := sdk.NewCoin(expectedEscrow.Denom, escrowBalances.AmountOf(expectedEscrow.Denom))
+			if actualEscrow.IsLT(expectedEscrow) {
+				count++
+				msg += fmt.Sprintf("\tdenom: %s, actual escrow (%s) is smaller than expected total escrow (%s)\n", expectedEscrow.Denom, actualEscrow.Amount, expectedEscrow.Amount)
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-escrow-per-denom",
+			fmt.Sprintf("amount of denominations with an insufficient escrow found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// GetEscrowBalances returns the sum of the balances of the escrow accounts of all the channels bound
+// to the transfer port.
+func (k Keeper) GetEscrowBalances(ctx sdk.Context) sdk.Coins {
+	portID := k.GetPort(ctx)
+	balances := sdk.Coins{}
+
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		balances = balances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return balances
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	var (
+		path *ibctesting.Path
+		coin sdk.Coin
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"success: escrow account holds more tokens than the total escrow",
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			},
+			false,
+		},
+		{
+			"failure: total escrow is greater than the escrow balances",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin.Add(coin))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			coin = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.NewHeight(0, 110), 0, "",
+			)
+
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			_, broken := keeper.TotalEscrowPerDenomInvariant(suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
@@ -88,6 +88,12 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store.Set(types.PortKey, []byte(portID))
 }
 
+// GetAppVersion returns the ICS-20 version of the channel, as seen by the transfer
+// module underneath any middleware wrapping it.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
 // GetDenomTrace retreives the full identifiers trace and base denomination from the store.
 func (k Keeper) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
@@ -113,6 +119,24 @@ func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
 	store.Set(denomTrace.Hash(), bz)
 }
 
+// setDenomMetadata sets the bank denomination metadata of the voucher denomination of the given
+// denomination trace, unless metadata is already set for it. The metadata is not set if it fails
+// the bank metadata validation, which may be the case for unusual base denominations.
+func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
+	voucherDenom := denomTrace.IBCDenom()
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, voucherDenom); found {
+		return
+	}
+
+	metadata := denomTrace.GetDenomMetadata()
+	if err := metadata.Validate(); err != nil {
+		k.Logger(ctx).Error("failed to set denomination metadata", "denom", voucherDenom, "error", err.Error())
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+}
+
 // GetAllDenomTraces returns the trace information for all the denominations.
 func (k Keeper) GetAllDenomTraces(ctx sdk.Context) types.Traces {
 	traces := types.Traces{}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// This migration sets the total amount escrowed for each denomination to the
+// balances of the escrow accounts of all the channels bound to the transfer port.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, escrow := range m.keeper.GetEscrowBalances(ctx) {
+		m.keeper.SetTotalEscrowForDenom(ctx, escrow)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration sets the bank denomination metadata of the voucher denominations
+// of all the denomination traces which do not have metadata set yet.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, denomTrace := range m.keeper.GetAllDenomTraces(ctx) {
+		m.keeper.setDenomMetadata(ctx, denomTrace)
+	}
+
+	return nil
+}
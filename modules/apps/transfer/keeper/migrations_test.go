@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	pathAToB := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(pathAToB)
+
+	pathAToC := NewTransferPath(suite.chainA, suite.chainC)
+	suite.coordinator.Setup(pathAToC)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	voucher := sdk.NewCoin(types.ParseDenomTrace("transfer/channel-5/uatom").IBCDenom(), sdk.NewInt(50))
+
+	// fund the escrow accounts directly, as if the tokens were escrowed before the total escrow was tracked
+	escrowB := types.GetEscrowAddress(pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID)
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowB, sdk.NewCoins(coin)))
+
+	escrowC := types.GetEscrowAddress(pathAToC.EndpointA.ChannelConfig.PortID, pathAToC.EndpointA.ChannelID)
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowC, sdk.NewCoins(coin, voucher)))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().NoError(migrator.Migrate1to2(suite.chainA.GetContext()))
+
+	totalEscrowed := suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(suite.chainA.GetContext())
+	suite.Require().Equal(sdk.NewCoins(coin.Add(coin), voucher), totalEscrowed)
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	denomTraces := types.Traces{
+		types.ParseDenomTrace("transfer/channel-0/uatom"),
+		types.ParseDenomTrace("transfer/channel-0/transfer/channel-1/uosmo"),
+	}
+
+	// set the denomination traces directly, as if they were received before the metadata was set
+	for _, denomTrace := range denomTraces {
+		suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+	}
+
+	// metadata which is already set is not overwritten
+	existing := denomTraces[0].GetDenomMetadata()
+	existing.Name = "Cosmos Hub Atom"
+	suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), existing)
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().NoError(migrator.Migrate2to3(suite.chainA.GetContext()))
+
+	metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denomTraces[0].IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(existing, metadata)
+
+	metadata, found = suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denomTraces[1].IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(denomTraces[1].GetDenomMetadata(), metadata)
+	suite.Require().Equal(denomTraces[1].IBCDenom(), metadata.Display)
+	suite.Require().Equal("transfer/channel-0/transfer/channel-1/uosmo", metadata.Description)
+	suite.Require().NoError(metadata.Validate())
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// GetTransferEnabledOverride returns the send and receive enabled override for the given channel and
+// denomination. An empty denomination returns the override of the channel itself.
+func (k Keeper) GetTransferEnabledOverride(ctx sdk.Context, portID, channelID, denom string) (types.TransferEnabledOverride, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferEnabledOverrideKey)
+	bz := store.Get(types.GetTransferEnabledOverrideKey(portID, channelID, denom))
+	if bz == nil {
+		return types.TransferEnabledOverride{}, false
+	}
+
+	var override types.TransferEnabledOverride
+	k.cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+// SetTransferEnabledOverride stores the given send and receive enabled override. An override which
+// enables both sending and receiving has no effect and is removed from the store instead.
+func (k Keeper) SetTransferEnabledOverride(ctx sdk.Context, override types.TransferEnabledOverride) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferEnabledOverrideKey)
+	key := types.GetTransferEnabledOverrideKey(override.PortId, override.ChannelId, override.Denom)
+
+	if override.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&override))
+}
+
+// GetAllTransferEnabledOverrides returns all the send and receive enabled overrides.
+func (k Keeper) GetAllTransferEnabledOverrides(ctx sdk.Context) []types.TransferEnabledOverride {
+	overrides := []types.TransferEnabledOverride{}
+	k.IterateTransferEnabledOverrides(ctx, func(override types.TransferEnabledOverride) bool {
+		overrides = append(overrides, override)
+		return false
+	})
+
+	return overrides
+}
+
+// IterateTransferEnabledOverrides iterates over the send and receive enabled overrides in the store
+// and performs a callback function.
+func (k Keeper) IterateTransferEnabledOverrides(ctx sdk.Context, cb func(override types.TransferEnabledOverride) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TransferEnabledOverrideKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var override types.TransferEnabledOverride
+		k.cdc.MustUnmarshal(iterator.Value(), &override)
+
+		if cb(override) {
+			break
+		}
+	}
+}
+
+// IsSendEnabled returns true if sending the given denomination over the given channel is enabled.
+// Sending must be enabled by the module parameters, the channel override (if any) and the channel
+// denomination override (if any). An empty denomination only takes into account the module
+// parameters and the channel override.
+func (k Keeper) IsSendEnabled(ctx sdk.Context, portID, channelID, denom string) bool {
+	if !k.GetSendEnabled(ctx) {
+		return false
+	}
+
+	return k.isOverrideEnabled(ctx, portID, channelID, denom, func(override types.TransferEnabledOverride) bool {
+		return override.SendEnabled
+	})
+}
+
+// IsReceiveEnabled returns true if receiving the given denomination over the given channel is enabled.
+// Receiving must be enabled by the module parameters, the channel override (if any) and the channel
+// denomination override (if any). An empty denomination only takes into account the module
+// parameters and the channel override.
+func (k Keeper) IsReceiveEnabled(ctx sdk.Context, portID, channelID, denom string) bool {
+	if !k.GetReceiveEnabled(ctx) {
+		return false
+	}
+
+	return k.isOverrideEnabled(ctx, portID, channelID, denom, func(override types.TransferEnabledOverride) bool {
+		return override.ReceiveEnabled
+	})
+}
+
+// isOverrideEnabled returns false if either the channel override or the channel denomination
+// override exists and is disabled according to the provided enabled function.
+func (k Keeper) isOverrideEnabled(
+	ctx sdk.Context, portID, channelID, denom string,
+	enabled func(override types.TransferEnabledOverride) bool,
+) bool {
+	if override, found := k.GetTransferEnabledOverride(ctx, portID, channelID, ""); found && !enabled(override) {
+		return false
+	}
+
+	if denom == "" {
+		return true
+	}
+
+	if override, found := k.GetTransferEnabledOverride(ctx, portID, channelID, denom); found && !enabled(override) {
+		return false
+	}
+
+	return true
+}
+
+// HandleTransferEnabledProposal sets the send and receive enabled overrides contained in the
+// given governance proposal.
+func (k Keeper) HandleTransferEnabledProposal(ctx sdk.Context, p *types.TransferEnabledProposal) error {
+	for _, override := range p.Overrides {
+		k.SetTransferEnabledOverride(ctx, override)
+
+		k.Logger(ctx).Info("transfer enabled override set", "port-id", override.PortId, "channel-id", override.ChannelId,
+			"denom", override.Denom, "send-enabled", override.SendEnabled, "receive-enabled", override.ReceiveEnabled)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferEnabledOverride,
+				sdk.NewAttribute(types.AttributeKeyPortID, override.PortId),
+				sdk.NewAttribute(types.AttributeKeyChannelID, override.ChannelId),
+				sdk.NewAttribute(types.AttributeKeyDenom, override.Denom),
+				sdk.NewAttribute(types.AttributeKeySendEnabled, fmt.Sprintf("%t", override.SendEnabled)),
+				sdk.NewAttribute(types.AttributeKeyReceiveEnabled, fmt.Sprintf("%t", override.ReceiveEnabled)),
+			),
+		)
+	}
+
+	return nil
+}
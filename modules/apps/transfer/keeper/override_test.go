@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestSetTransferEnabledOverride() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	_, found := transferKeeper.GetTransferEnabledOverride(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, "")
+	suite.Require().False(found)
+
+	channelOverride := types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, "", false, true)
+	denomOverride := types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, true, false)
+	transferKeeper.SetTransferEnabledOverride(ctx, channelOverride)
+	transferKeeper.SetTransferEnabledOverride(ctx, denomOverride)
+
+	override, found := transferKeeper.GetTransferEnabledOverride(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, "")
+	suite.Require().True(found)
+	suite.Require().Equal(channelOverride, override)
+
+	override, found = transferKeeper.GetTransferEnabledOverride(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(denomOverride, override)
+
+	suite.Require().Equal([]types.TransferEnabledOverride{channelOverride, denomOverride}, transferKeeper.GetAllTransferEnabledOverrides(ctx))
+
+	// enabling both sending and receiving removes the override
+	transferKeeper.SetTransferEnabledOverride(ctx, types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, "", true, true))
+
+	_, found = transferKeeper.GetTransferEnabledOverride(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, "")
+	suite.Require().False(found)
+	suite.Require().Equal([]types.TransferEnabledOverride{denomOverride}, transferKeeper.GetAllTransferEnabledOverrides(ctx))
+}
+
+func (suite *KeeperTestSuite) TestIsTransferEnabled() {
+	var params types.Params
+
+	testCases := []struct {
+		msg               string
+		malleate          func()
+		expSendEnabled    bool
+		expReceiveEnabled bool
+	}{
+		{
+			"no overrides", func() {}, true, true,
+		},
+		{
+			"send disabled by params", func() {
+				params.SendEnabled = false
+			}, false, true,
+		},
+		{
+			"receive disabled by params", func() {
+				params.ReceiveEnabled = false
+			}, true, false,
+		},
+		{
+			"disabled by channel override", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, "", false, false))
+			}, false, false,
+		},
+		{
+			"disabled by denom override", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, false, false))
+			}, false, false,
+		},
+		{
+			"enabled with override for another channel", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, "channel-1", "", false, false))
+			}, true, true,
+		},
+		{
+			"enabled with override for another denom", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, "atom", false, false))
+			}, true, true,
+		},
+		{
+			"send disabled by channel override, receive disabled by denom override", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, "", false, true))
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, true, false))
+			}, false, false,
+		},
+		{
+			"overrides cannot enable transfers disabled by params", func() {
+				params = types.NewParams(false, false)
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, true, false))
+			}, false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			params = types.DefaultParams()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(ctx, params)
+
+			sendEnabled := suite.chainA.GetSimApp().TransferKeeper.IsSendEnabled(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+			receiveEnabled := suite.chainA.GetSimApp().TransferKeeper.IsReceiveEnabled(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+
+			suite.Require().Equal(tc.expSendEnabled, sendEnabled)
+			suite.Require().Equal(tc.expReceiveEnabled, receiveEnabled)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleTransferEnabledProposal() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	overrides := []types.TransferEnabledOverride{
+		types.NewTransferEnabledOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, "", false, false),
+		types.NewTransferEnabledOverride(ibctesting.TransferPort, "channel-1", sdk.DefaultBondDenom, true, false),
+	}
+
+	proposal, ok := types.NewTransferEnabledProposal(ibctesting.Title, ibctesting.Description, overrides).(*types.TransferEnabledProposal)
+	suite.Require().True(ok)
+
+	err := transferKeeper.HandleTransferEnabledProposal(ctx, proposal)
+	suite.Require().NoError(err)
+	suite.Require().Equal(overrides, transferKeeper.GetAllTransferEnabledOverrides(ctx))
+	suite.Require().False(transferKeeper.IsSendEnabled(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, ""))
+	suite.Require().True(transferKeeper.IsSendEnabled(ctx, ibctesting.TransferPort, "channel-1", sdk.DefaultBondDenom))
+	suite.Require().False(transferKeeper.IsReceiveEnabled(ctx, ibctesting.TransferPort, "channel-1", sdk.DefaultBondDenom))
+}
@@ -57,17 +57,75 @@ func (k Keeper) SendTransfer(
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
+) error {
+	return k.sendTransfer(ctx, sourcePort, sourceChannel, sdk.Coins{token}, sender, receiver, timeoutHeight, timeoutTimestamp, memo)
+}
+
+// SendMultiDenomTransfer handles the sending logic of a transfer of multiple tokens
+// in a single packet. Each token is escrowed or burned as described in SendTransfer.
+// The source channel must use the MultiDenomVersion of ICS-20, unless a single token
+// is transferred.
+func (k Keeper) SendMultiDenomTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	tokens sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) error {
+	return k.sendTransfer(ctx, sourcePort, sourceChannel, tokens, sender, receiver, timeoutHeight, timeoutTimestamp, memo)
+}
+
+func (k Keeper) sendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	tokens sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
 ) error {
 
 	if !k.GetSendEnabled(ctx) {
 		return types.ErrSendDisabled
 	}
 
+	if len(tokens) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "no tokens to transfer")
+	}
+
+	for _, token := range tokens {
+		if !k.IsSendEnabled(ctx, sourcePort, sourceChannel, token.Denom) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "port ID (%s) channel ID (%s) denom (%s)", sourcePort, sourceChannel, token.Denom)
+		}
+	}
+
 	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	switch appVersion {
+	case types.Version:
+		if len(tokens) != 1 {
+			return sdkerrors.Wrapf(types.ErrMultiDenomNotSupported, "channel version %s only supports a single token, got %d tokens", appVersion, len(tokens))
+		}
+	case types.MultiDenomVersion:
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "unsupported channel version %s", appVersion)
+	}
+
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
@@ -87,6 +145,57 @@ func (k Keeper) SendTransfer(
 		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	packetTokens := make([]types.Token, 0, len(tokens))
+	for _, token := range tokens {
+		fullDenomPath, err := k.sendToken(ctx, sourcePort, sourceChannel, destinationPort, destinationChannel, sender, token)
+		if err != nil {
+			return err
+		}
+
+		packetTokens = append(packetTokens, types.NewToken(fullDenomPath, token.Amount.String()))
+	}
+
+	// NOTE: SendTransfer simply sends the denomination as it exists on its own
+	// chain inside the packet data. The receiving chain will perform denom
+	// prefixing as necessary.
+
+	var packetData []byte
+	if appVersion == types.Version {
+		packetData = types.NewFungibleTokenPacketData(
+			packetTokens[0].Denom, packetTokens[0].Amount, sender.String(), receiver, memo,
+		).GetBytes()
+	} else {
+		packetData = types.NewMultiDenomFungibleTokenPacketData(
+			packetTokens, sender.String(), receiver, memo,
+		).GetBytes()
+	}
+
+	packet := channeltypes.NewPacket(
+		packetData,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sendToken escrows the token if the sender chain is the source of the token, otherwise
+// the token is burned. The full denomination path of the token is returned.
+func (k Keeper) sendToken(
+	ctx sdk.Context,
+	sourcePort, sourceChannel, destinationPort, destinationChannel string,
+	sender sdk.AccAddress,
+	token sdk.Coin,
+) (string, error) {
 	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
 	fullDenomPath := token.Denom
 
@@ -97,7 +206,7 @@ func (k Keeper) SendTransfer(
 	if strings.HasPrefix(token.Denom, "ibc/") {
 		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
@@ -106,10 +215,6 @@ func (k Keeper) SendTransfer(
 		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
 	}
 
-	// NOTE: SendTransfer simply sends the denomination as it exists on its own
-	// chain inside the packet data. The receiving chain will perform denom
-	// prefixing as necessary.
-
 	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
 		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
 
@@ -117,10 +222,8 @@ func (k Keeper) SendTransfer(
 		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 
 		// escrow source tokens. It fails if balance insufficient.
-		if err := k.bankKeeper.SendCoins(
-			ctx, sender, escrowAddress, sdk.NewCoins(token),
-		); err != nil {
-			return err
+		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
+			return "", err
 		}
 
 	} else {
@@ -130,7 +233,7 @@ func (k Keeper) SendTransfer(
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(
 			ctx, sender, types.ModuleName, sdk.NewCoins(token),
 		); err != nil {
-			return err
+			return "", err
 		}
 
 		if err := k.bankKeeper.BurnCoins(
@@ -143,25 +246,6 @@ func (k Keeper) SendTransfer(
 		}
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver,
-	)
-
-	packet := channeltypes.NewPacket(
-		packetData.GetBytes(),
-		sequence,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		timeoutHeight,
-		timeoutTimestamp,
-	)
-
-	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
-		return err
-	}
-
 	defer func() {
 		if token.Amount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -178,7 +262,7 @@ func (k Keeper) SendTransfer(
 		)
 	}()
 
-	return nil
+	return fullDenomPath, nil
 }
 
 // OnRecvPacket processes a cross chain fungible token transfer. If the
@@ -187,6 +271,14 @@ func (k Keeper) SendTransfer(
 // back tokens this chain originally transferred to it, the tokens are
 // unescrowed and sent to the receiving address.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	return k.OnRecvMultiDenomPacket(ctx, packet, data.ToMultiDenom())
+}
+
+// OnRecvMultiDenomPacket processes a cross chain transfer of one or more fungible
+// tokens. Each token is received as described in OnRecvPacket. Receiving is atomic:
+// if any token cannot be received, an error is returned and no state changes are
+// written, so that an error acknowledgement refunds every token on the sender chain.
+func (k Keeper) OnRecvMultiDenomPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiDenomFungibleTokenPacketData) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return err
@@ -202,6 +294,23 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, token := range data.Tokens {
+		if err := k.receiveToken(cacheCtx, packet, receiver, token); err != nil {
+			return err
+		}
+	}
+
+	writeCache()
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// receiveToken unescrows the token to the receiver if this chain is the source of
+// the token, otherwise vouchers are minted and sent to the receiver.
+func (k Keeper) receiveToken(ctx sdk.Context, packet channeltypes.Packet, receiver sdk.AccAddress, data types.Token) error {
 	// parse the transfer amount
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
@@ -239,18 +348,18 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		}
 		token := sdk.NewCoin(denom, transferAmount)
 
+		if !k.IsReceiveEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel(), denom) {
+			return sdkerrors.Wrapf(types.ErrReceiveDisabled, "port ID (%s) channel ID (%s) denom (%s)", packet.GetDestPort(), packet.GetDestChannel(), denom)
+		}
+
 		if k.bankKeeper.BlockedAddr(receiver) {
 			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 		}
 
 		// unescrow tokens
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
-			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
-			// counterparty module. The bug may occur in bank or any part of the code that allows
-			// the escrow address to be drained. A malicious counterparty module could drain the
-			// escrow address by allowing more tokens to be sent back then were escrowed.
-			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
+			return err
 		}
 
 		defer func() {
@@ -283,13 +392,18 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
+	voucherDenom := denomTrace.IBCDenom()
+
+	if !k.IsReceiveEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel(), voucherDenom) {
+		return sdkerrors.Wrapf(types.ErrReceiveDisabled, "port ID (%s) channel ID (%s) denom (%s)", packet.GetDestPort(), packet.GetDestChannel(), voucherDenom)
+	}
 
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
+		k.setDenomMetadata(ctx, denomTrace)
 	}
 
-	voucherDenom := denomTrace.IBCDenom()
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDenomTrace,
@@ -339,9 +453,16 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 // was a success then nothing occurs. If the acknowledgement failed, then
 // the sender is refunded their tokens using the refundPacketToken function.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	return k.OnAcknowledgementMultiDenomPacket(ctx, packet, data.ToMultiDenom(), ack)
+}
+
+// OnAcknowledgementMultiDenomPacket responds to the acknowledgement of a packet
+// transferring one or more tokens. If the acknowledgement failed, every token of
+// the packet is refunded to the sender.
+func (k Keeper) OnAcknowledgementMultiDenomPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiDenomFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		return k.refundPacketTokens(ctx, packet, data)
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
@@ -352,14 +473,38 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	return k.OnTimeoutMultiDenomPacket(ctx, packet, data.ToMultiDenom())
+}
+
+// OnTimeoutMultiDenomPacket refunds every token of the packet to the sender since
+// the original packet sent was never received and has been timed out.
+func (k Keeper) OnTimeoutMultiDenomPacket(ctx sdk.Context, packet channeltypes.Packet, data types.MultiDenomFungibleTokenPacketData) error {
+	return k.refundPacketTokens(ctx, packet, data)
+}
+
+// refundPacketTokens refunds every token of the packet to the sender using the
+// refundPacketToken function.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.MultiDenomFungibleTokenPacketData) error {
+	// decode the sender address
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range data.Tokens {
+		if err := k.refundPacketToken(ctx, packet, sender, token); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
 // the sending address.
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, sender sdk.AccAddress, data types.Token) error {
 	// NOTE: packet data type already checked in handler.go
 
 	// parse the denomination from the full denom path
@@ -372,21 +517,11 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	}
 	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
-	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
-	if err != nil {
-		return err
-	}
-
 	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(token)); err != nil {
-			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
-			// counterparty module. The bug may occur in bank or any part of the code that allows
-			// the escrow address to be drained. A malicious counterparty module could drain the
-			// escrow address by allowing more tokens to be sent back then were escrowed.
-			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+		if err := k.unescrowToken(ctx, escrowAddress, sender, token); err != nil {
+			return err
 		}
 
 		return nil
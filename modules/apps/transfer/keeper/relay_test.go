@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -82,6 +83,34 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.chainA.GetSimApp().ScopedTransferKeeper.ReleaseCapability(suite.chainA.GetContext(), cap)
 				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
 			}, true, false},
+		{"send disabled over channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "", false, true))
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, false},
+		{"send disabled for denom over channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, false, true))
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, false},
+		{"successful transfer with send disabled for another denom over channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "atom", false, true))
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, true},
+		{"successful transfer with receive disabled over channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+					types.NewTransferEnabledOverride(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "", true, false))
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, true},
 	}
 
 	for _, tc := range testCases {
@@ -97,12 +126,12 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			if !tc.sendFromSource {
 				// send coin from chainB to chainA
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
 				_, err = suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
 				// receive coin on chainA from chainB
-				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
 				packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 110), 0)
 
 				// get proof of packet commitment from chainB
@@ -119,12 +148,103 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount,
 				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+				"",
 			)
 
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), amount.Denom)
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				if tc.sendFromSource {
+					suite.Require().Equal(amount, totalEscrow)
+				} else {
+					suite.Require().True(totalEscrow.IsZero())
+				}
 			} else {
 				suite.Require().Error(err)
+				suite.Require().True(totalEscrow.IsZero())
+			}
+		})
+	}
+}
+
+// test sending multiple tokens in a single packet from chainA. The tokens are the native
+// token of chainA and a voucher of a token of chainB, minted directly on chainA.
+func (suite *KeeperTestSuite) TestSendMultiDenomTransfer() {
+	var (
+		path    *ibctesting.Path
+		voucher sdk.Coin
+		tokens  sdk.Coins
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"success with a single token", func() {
+			tokens = sdk.NewCoins(voucher)
+		}, nil},
+		{"success with a single token on channel without multi-denom support", func() {
+			path.EndpointA.ChannelConfig.Version = types.Version
+			path.EndpointB.ChannelConfig.Version = types.Version
+			tokens = sdk.NewCoins(voucher)
+		}, nil},
+		{"channel without multi-denom support", func() {
+			path.EndpointA.ChannelConfig.Version = types.Version
+			path.EndpointB.ChannelConfig.Version = types.Version
+		}, types.ErrMultiDenomNotSupported},
+		{"send disabled for one of the denoms over channel", func() {
+			suite.chainA.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainA.GetContext(),
+				types.NewTransferEnabledOverride(path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID, voucher.Denom, false, true))
+		}, types.ErrSendDisabled},
+		{"no tokens", func() {
+			tokens = sdk.Coins{}
+		}, sdkerrors.ErrInvalidCoins},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.MultiDenomVersion
+			path.EndpointB.ChannelConfig.Version = types.MultiDenomVersion
+
+			trace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, "channel-0", sdk.DefaultBondDenom))
+			suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+			voucher = sdk.NewCoin(trace.IBCDenom(), sdk.NewInt(100))
+
+			suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, sdk.NewCoins(voucher)))
+			suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(voucher)))
+
+			tokens = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), voucher)
+
+			tc.malleate()
+
+			suite.coordinator.Setup(path)
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SendMultiDenomTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tokens,
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+				"",
+			)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucher.Denom)
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				// the voucher is burned and the native token is escrowed
+				suite.Require().True(balance.IsZero())
+				suite.Require().True(tokens.AmountOf(sdk.DefaultBondDenom).Equal(totalEscrow.Amount))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+
+				suite.Require().Equal(voucher, balance)
+				suite.Require().True(totalEscrow.IsZero())
 			}
 		})
 	}
@@ -136,6 +256,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 // malleate function allows for testing invalid cases.
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	var (
+		path     *ibctesting.Path
 		trace    types.DenomTrace
 		amount   sdk.Int
 		receiver string
@@ -177,6 +298,24 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"failure: receive on module account on source chain", func() {
 			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
 		}, true, false},
+
+		{"failure: receive disabled over channel", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainB.GetContext(),
+				types.NewTransferEnabledOverride(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, "", true, false))
+		}, false, false},
+		{"failure: receive disabled for voucher denom over channel", func() {
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			suite.chainB.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainB.GetContext(),
+				types.NewTransferEnabledOverride(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherDenom, true, false))
+		}, false, false},
+		{"failure: receive disabled for native denom over channel on source chain", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainB.GetContext(),
+				types.NewTransferEnabledOverride(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom, true, false))
+		}, true, false},
+		{"success receive with send disabled over channel", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetTransferEnabledOverride(suite.chainB.GetContext(),
+				types.NewTransferEnabledOverride(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, "", false, true))
+		}, false, true},
 	}
 
 	for _, tc := range testCases {
@@ -185,7 +324,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := NewTransferPath(suite.chainA, suite.chainB)
+			path = NewTransferPath(suite.chainA, suite.chainB)
 			suite.coordinator.Setup(path)
 			receiver = suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
 
@@ -195,7 +334,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			if tc.recvIsSource {
 				// send coin from chainB to chainA, receive them, acknowledge them, and send back to chainB
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
 				res, err := suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
@@ -214,21 +353,37 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			}
 
 			// send coin from chainA to chainB
-			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0)
+			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0, "")
 			_, err := suite.chainA.SendMsgs(transferMsg)
 			suite.Require().NoError(err) // message committed
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver)
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
 
+			totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				// the tokens escrowed on chainB are unescrowed when received back
+				suite.Require().True(totalEscrow.IsZero())
+
+				if !tc.recvIsSource {
+					// the denomination metadata of the minted vouchers is set
+					voucherTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), trace.GetFullDenomPath()))
+					metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucherTrace.IBCDenom())
+					suite.Require().True(found)
+					suite.Require().Equal(voucherTrace.GetDenomMetadata(), metadata)
+				}
 			} else {
 				suite.Require().Error(err)
+
+				if tc.recvIsSource {
+					suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), totalEscrow)
+				}
 			}
 		})
 	}
@@ -263,6 +418,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
 			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 		}, false, true},
 		{"unsuccessful refund from source", failedAck,
 			func() {
@@ -289,7 +445,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
@@ -306,6 +462,9 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 					suite.Require().Equal(amount, deltaAmount, "failed ack did not trigger refund")
 				}
 
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
+				suite.Require().True(totalEscrow.IsZero())
+
 			} else {
 				suite.Require().Error(err)
 			}
@@ -337,6 +496,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 			}, true},
 		{"successful timeout from external chain",
 			func() {
@@ -375,7 +535,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
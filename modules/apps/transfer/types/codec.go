@@ -1,10 +1,16 @@
 package types
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/ibc transfer interfaces and concrete types
@@ -17,6 +23,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &TransferEnabledProposal{})
+	registry.RegisterImplementations((*authz.Authorization)(nil), &TransferAuthorization{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -39,3 +47,22 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	amino.Seal()
 }
+
+// mustProtoMarshalJSON provides an auxiliary function to return Proto3 JSON encoded
+// bytes of a message. It mirrors codec.ProtoMarshalJSON, but sets EmitDefaults to
+// false so that unpopulated optional fields (such as the memo) are not marshalled.
+// This keeps the encoding of packets which do not make use of the memo unchanged.
+func mustProtoMarshalJSON(msg proto.Message) []byte {
+	jm := &jsonpb.Marshaler{OrigName: true, EmitDefaults: false, AnyResolver: nil}
+
+	if err := codectypes.UnpackInterfaces(msg, codectypes.ProtoJSONPacker{JSONPBMarshaler: jm}); err != nil {
+		panic(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := jm.Marshal(buf, msg); err != nil {
+		panic(err)
+	}
+
+	return buf.Bytes()
+}
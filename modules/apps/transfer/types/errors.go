@@ -14,4 +14,7 @@ var (
 	ErrSendDisabled            = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
 	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
 	ErrMaxTransferChannels     = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidOverride         = sdkerrors.Register(ModuleName, 10, "invalid transfer enabled override")
+	ErrMultiDenomNotSupported  = sdkerrors.Register(ModuleName, 11, "multi-denom transfers are not supported by the channel")
+	ErrInvalidAuthorization    = sdkerrors.Register(ModuleName, 12, "invalid transfer authorization")
 )
@@ -8,6 +8,8 @@ const (
 	EventTypeChannelClose = "channel_closed"
 	EventTypeDenomTrace   = "denomination_trace"
 
+	EventTypeTransferEnabledOverride = "transfer_enabled_override"
+
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
 	AttributeKeyAmount         = "amount"
@@ -18,4 +20,9 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyMemo           = "memo"
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeySendEnabled    = "send_enabled"
+	AttributeKeyReceiveEnabled = "receive_enabled"
 )
@@ -1,24 +1,30 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denomTraces Traces, params Params) *GenesisState {
+func NewGenesisState(portID string, denomTraces Traces, params Params, overrides []TransferEnabledOverride, totalEscrowed sdk.Coins) *GenesisState {
 	return &GenesisState{
-		PortId:      portID,
-		DenomTraces: denomTraces,
-		Params:      params,
+		PortId:                   portID,
+		DenomTraces:              denomTraces,
+		Params:                   params,
+		TransferEnabledOverrides: overrides,
+		TotalEscrowed:            totalEscrowed,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:      PortID,
-		DenomTraces: Traces{},
-		Params:      DefaultParams(),
+		PortId:                   PortID,
+		DenomTraces:              Traces{},
+		Params:                   DefaultParams(),
+		TransferEnabledOverrides: []TransferEnabledOverride{},
+		TotalEscrowed:            sdk.Coins{},
 	}
 }
 
@@ -31,5 +37,11 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
+	if err := TransferEnabledOverrides(gs.TransferEnabledOverrides).Validate(); err != nil {
+		return err
+	}
+	if err := gs.TotalEscrowed.Validate(); err != nil {
+		return err
+	}
 	return gs.Params.Validate()
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,9 +27,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ibc-transfer genesis state
 type GenesisState struct {
-	PortId      string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	DenomTraces Traces `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
-	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	PortId                   string                    `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	DenomTraces              Traces                    `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
+	Params                   Params                    `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	TransferEnabledOverrides []TransferEnabledOverride `protobuf:"bytes,4,rep,name=transfer_enabled_overrides,json=transferEnabledOverrides,proto3" json:"transfer_enabled_overrides" yaml:"transfer_enabled_overrides"`
+	// total_escrowed contains the total amount of tokens escrowed
+	// by the transfer module
+	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed" yaml:"total_escrowed"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +90,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTransferEnabledOverrides() []TransferEnabledOverride {
+	if m != nil {
+		return m.TransferEnabledOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -93,28 +113,36 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x27, 0x15, 0xbb, 0xe1, 0xa1, 0x7a, 0x18, 0x43, 0xda, 0x51, 0x14, 0x8a, 0xc3,
-	0x84, 0x6d, 0x07, 0xc1, 0x63, 0x11, 0xc4, 0x9b, 0x4e, 0x4f, 0x5e, 0x46, 0x9a, 0xc6, 0x1a, 0x58,
-	0x9b, 0x92, 0x7f, 0x36, 0xd8, 0x5b, 0xf8, 0x1c, 0x3e, 0xc9, 0x8e, 0x3b, 0x7a, 0x9a, 0xb2, 0xbd,
-	0xc1, 0x7c, 0x01, 0x49, 0x37, 0xc7, 0x4e, 0xbd, 0x7d, 0x24, 0xbf, 0xef, 0xfb, 0xfe, 0x7c, 0xce,
-	0x95, 0x88, 0x19, 0xa1, 0x45, 0x31, 0x12, 0x8c, 0x6a, 0x21, 0x73, 0x20, 0x5a, 0xd1, 0x1c, 0xde,
-	0xb8, 0x22, 0x93, 0x2e, 0x49, 0x79, 0xce, 0x41, 0x00, 0x2e, 0x94, 0xd4, 0xd2, 0x3d, 0x17, 0x31,
-	0xc3, 0xfb, 0x2c, 0xfe, 0x67, 0xf1, 0xa4, 0xdb, 0xea, 0x54, 0x26, 0xed, 0xc8, 0x32, 0xaa, 0x75,
-	0x96, 0xca, 0x54, 0x96, 0x92, 0x18, 0xb5, 0x79, 0x0d, 0x7e, 0x91, 0xd3, 0xb8, 0xdf, 0x54, 0x3e,
-	0x6b, 0xaa, 0xb9, 0xdb, 0x71, 0x8e, 0x0a, 0xa9, 0xf4, 0x50, 0x24, 0x4d, 0xd4, 0x46, 0xe1, 0x71,
-	0xe4, 0xae, 0x17, 0xfe, 0xc9, 0x94, 0x66, 0xa3, 0xdb, 0x60, 0xfb, 0x11, 0x0c, 0x6c, 0xa3, 0x1e,
-	0x12, 0x57, 0x39, 0x8d, 0x84, 0xe7, 0x32, 0x1b, 0x6a, 0x45, 0x19, 0x87, 0xe6, 0x41, 0xbb, 0x16,
-	0xd6, 0x7b, 0x21, 0xae, 0xba, 0x1a, 0xdf, 0x19, 0xc7, 0x8b, 0x31, 0x44, 0x97, 0xb3, 0x85, 0x6f,
-	0xad, 0x17, 0xfe, 0xe9, 0x26, 0x7f, 0x3f, 0x2b, 0xf8, 0xfc, 0xf6, 0xed, 0x92, 0x82, 0x41, 0x3d,
-	0xd9, 0x59, 0xc0, 0x8d, 0x1c, 0xbb, 0xa0, 0x8a, 0x66, 0xd0, 0xac, 0xb5, 0x51, 0x58, 0xef, 0x5d,
-	0x54, 0xb7, 0x3d, 0x96, 0x6c, 0x74, 0x68, 0x9a, 0x06, 0x5b, 0x67, 0xf4, 0x34, 0x5b, 0x7a, 0x68,
-	0xbe, 0xf4, 0xd0, 0xcf, 0xd2, 0x43, 0x1f, 0x2b, 0xcf, 0x9a, 0xaf, 0x3c, 0xeb, 0x6b, 0xe5, 0x59,
-	0xaf, 0x37, 0xa9, 0xd0, 0xef, 0xe3, 0x18, 0x33, 0x99, 0x11, 0x26, 0x21, 0x93, 0x40, 0x44, 0xcc,
-	0xae, 0x53, 0x49, 0x26, 0x7d, 0x92, 0xc9, 0x64, 0x3c, 0xe2, 0x60, 0x26, 0xdf, 0x9b, 0x5a, 0x4f,
-	0x0b, 0x0e, 0xb1, 0x5d, 0xee, 0xd9, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x49, 0x1b, 0x71, 0xa7,
-	0xde, 0x01, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6e, 0xd4, 0x30,
+	0x14, 0x86, 0x27, 0x74, 0x18, 0x44, 0xa6, 0x74, 0x11, 0x40, 0x0a, 0x23, 0x94, 0x19, 0x22, 0x90,
+	0x02, 0x55, 0x6d, 0x4d, 0x2b, 0x84, 0xc4, 0x32, 0x50, 0xa1, 0xae, 0x80, 0xd0, 0x15, 0x9b, 0xc8,
+	0xb1, 0x4d, 0xb0, 0x48, 0xe2, 0xc8, 0xcf, 0x0d, 0xea, 0x19, 0xd8, 0x70, 0x02, 0x16, 0x2c, 0x39,
+	0x49, 0x97, 0x5d, 0xb2, 0x1a, 0xd0, 0xcc, 0x0d, 0x7a, 0x02, 0x14, 0xc7, 0x1d, 0x0d, 0x82, 0x66,
+	0x95, 0xa7, 0xf8, 0xff, 0xfe, 0xdf, 0xcf, 0xef, 0xb9, 0x4f, 0x44, 0x46, 0x31, 0xa9, 0xeb, 0x42,
+	0x50, 0xa2, 0x85, 0xac, 0x00, 0x6b, 0x45, 0x2a, 0xf8, 0xc0, 0x15, 0x6e, 0xe6, 0x38, 0xe7, 0x15,
+	0x07, 0x01, 0xa8, 0x56, 0x52, 0x4b, 0xef, 0xbe, 0xc8, 0x28, 0xda, 0xd4, 0xa2, 0x4b, 0x2d, 0x6a,
+	0xe6, 0x93, 0xdd, 0x5e, 0xa7, 0xb5, 0xd2, 0x58, 0x4d, 0xee, 0xe4, 0x32, 0x97, 0xa6, 0xc4, 0x6d,
+	0x65, 0xff, 0x06, 0x54, 0x42, 0x29, 0x01, 0x67, 0x04, 0x38, 0x6e, 0xe6, 0x19, 0xd7, 0x64, 0x8e,
+	0xa9, 0x14, 0x55, 0x77, 0x1e, 0x7e, 0x1f, 0xba, 0xdb, 0xaf, 0xba, 0x2b, 0xbd, 0xd3, 0x44, 0x73,
+	0x6f, 0xd7, 0xbd, 0x51, 0x4b, 0xa5, 0x53, 0xc1, 0x7c, 0x67, 0xe6, 0x44, 0x37, 0x63, 0xef, 0x62,
+	0x31, 0xdd, 0x39, 0x25, 0x65, 0xf1, 0x3c, 0xb4, 0x07, 0x61, 0x32, 0x6a, 0xab, 0x23, 0xe6, 0x29,
+	0x77, 0x9b, 0xf1, 0x4a, 0x96, 0xa9, 0x56, 0x84, 0x72, 0xf0, 0xaf, 0xcd, 0xb6, 0xa2, 0xf1, 0x7e,
+	0x84, 0xfa, 0xba, 0x42, 0x2f, 0x5b, 0xe2, 0xb8, 0x05, 0xe2, 0x47, 0x67, 0x8b, 0xe9, 0xe0, 0x62,
+	0x31, 0xbd, 0xdd, 0xf9, 0x6f, 0x7a, 0x85, 0x3f, 0x7e, 0x4d, 0x47, 0x46, 0x05, 0xc9, 0x98, 0xad,
+	0x11, 0xf0, 0x62, 0x77, 0x54, 0x13, 0x45, 0x4a, 0xf0, 0xb7, 0x66, 0x4e, 0x34, 0xde, 0x7f, 0xd8,
+	0x9f, 0xf6, 0xc6, 0x68, 0xe3, 0x61, 0x9b, 0x94, 0x58, 0xd2, 0xfb, 0xe6, 0xb8, 0x93, 0x4b, 0x51,
+	0xca, 0x2b, 0x92, 0x15, 0x9c, 0xa5, 0xb2, 0xe1, 0x4a, 0x09, 0xc6, 0xc1, 0x1f, 0x9a, 0x36, 0x9e,
+	0xf6, 0x1b, 0x1f, 0xdb, 0xfa, 0xb0, 0xc3, 0x5f, 0x5b, 0x3a, 0x7e, 0x6c, 0x7b, 0x7a, 0xd0, 0xf5,
+	0x74, 0x75, 0x4c, 0x98, 0xf8, 0xfa, 0xff, 0x1e, 0xe0, 0x7d, 0x71, 0xdc, 0x1d, 0x2d, 0x35, 0x29,
+	0x52, 0x0e, 0x54, 0xc9, 0xcf, 0x9c, 0xf9, 0xd7, 0xcd, 0xa5, 0xee, 0xa1, 0x6e, 0xa0, 0xa8, 0x1d,
+	0x28, 0xb2, 0x03, 0x45, 0x2f, 0xa4, 0xa8, 0xe2, 0x23, 0x1b, 0x7c, 0xd7, 0x06, 0xff, 0x85, 0xb7,
+	0xcf, 0x19, 0xe5, 0x42, 0x7f, 0x3c, 0xc9, 0x10, 0x95, 0x25, 0xb6, 0x6b, 0xd1, 0x7d, 0xf6, 0x80,
+	0x7d, 0xc2, 0xfa, 0xb4, 0xe6, 0x60, 0x9c, 0x20, 0xb9, 0x65, 0xe0, 0x43, 0xcb, 0xc6, 0x6f, 0xcf,
+	0x96, 0x81, 0x73, 0xbe, 0x0c, 0x9c, 0xdf, 0xcb, 0xc0, 0xf9, 0xba, 0x0a, 0x06, 0xe7, 0xab, 0x60,
+	0xf0, 0x73, 0x15, 0x0c, 0xde, 0x3f, 0xfb, 0xd7, 0x52, 0x64, 0x74, 0x2f, 0x97, 0xb8, 0x39, 0xc0,
+	0xa5, 0x64, 0x27, 0x05, 0x87, 0x76, 0x83, 0x37, 0x36, 0xd7, 0xe4, 0x64, 0x23, 0xb3, 0x7e, 0x07,
+	0x7f, 0x06, 0x00, 0xfe, 0x48, 0x97, 0xbb, 0x2d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -137,6 +165,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TotalEscrowed) > 0 {
+		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TransferEnabledOverrides) > 0 {
+		for iNdEx := len(m.TransferEnabledOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferEnabledOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -200,6 +256,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransferEnabledOverrides) > 0 {
+		for _, e := range m.TransferEnabledOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for _, e := range m.TotalEscrowed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -337,6 +405,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferEnabledOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferEnabledOverrides = append(m.TransferEnabledOverrides, TransferEnabledOverride{})
+			if err := m.TransferEnabledOverrides[len(m.TransferEnabledOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEscrowed = append(m.TotalEscrowed, types.Coin{})
+			if err := m.TotalEscrowed[len(m.TotalEscrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -26,6 +27,24 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid total escrowed",
+			&types.GenesisState{
+				PortId:        "portidone",
+				TotalEscrowed: sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-1)}},
+			},
+			false,
+		},
+		{
+			"invalid transfer enabled override",
+			&types.GenesisState{
+				PortId: "portidone",
+				TransferEnabledOverrides: []types.TransferEnabledOverride{
+					types.NewTransferEnabledOverride("transfer", "(INVALIDCHANNEL)", "", false, false),
+				},
+			},
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -15,6 +15,10 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// MultiDenomVersion defines the version of the IBC transfer module
+	// which supports transferring multiple tokens in a single packet
+	MultiDenomVersion = "ics20-2"
+
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
 
@@ -36,8 +40,25 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// TransferEnabledOverrideKey defines the key to store the send and receive enabled overrides in store
+	TransferEnabledOverrideKey = []byte{0x03}
+	// TotalEscrowKey defines the key to store the total amount of tokens escrowed per denomination in store
+	TotalEscrowKey = []byte{0x04}
 )
 
+// GetTransferEnabledOverrideKey returns the store key, relative to TransferEnabledOverrideKey,
+// of the send and receive enabled override for the given channel and denomination.
+// An empty denomination refers to the override of the channel itself.
+func GetTransferEnabledOverrideKey(portID, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", portID, channelID, denom))
+}
+
+// GetTotalEscrowKey returns the store key, relative to TotalEscrowKey, of the total amount of tokens
+// escrowed for the given denomination.
+func GetTotalEscrowKey(denom string) []byte {
+	return []byte(denom)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -21,6 +21,7 @@ func NewMsgTransfer(
 	sourcePort, sourceChannel string,
 	token sdk.Coin, sender, receiver string,
 	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
 ) *MsgTransfer {
 	return &MsgTransfer{
 		SourcePort:       sourcePort,
@@ -30,6 +31,28 @@ func NewMsgTransfer(
 		Receiver:         receiver,
 		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// NewMsgMultiDenomTransfer creates a new MsgTransfer instance which transfers
+// multiple tokens in a single packet
+//nolint:interfacer
+func NewMsgMultiDenomTransfer(
+	sourcePort, sourceChannel string,
+	tokens sdk.Coins, sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Tokens:           tokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
 	}
 }
 
@@ -54,11 +77,18 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return sdkerrors.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Token.String())
+	if len(msg.Tokens) != 0 && !isEmptyCoin(msg.Token) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "token and tokens cannot both be set")
+	}
+	tokens := msg.GetTokens()
+	if len(tokens) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "no tokens to transfer")
 	}
-	if !msg.Token.IsPositive() {
-		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.Token.String())
+	if err := tokens.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !tokens.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, tokens.String())
 	}
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -68,7 +98,12 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+	for _, token := range tokens {
+		if err := ValidateIBCDenom(token.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // GetSignBytes implements sdk.Msg.
@@ -84,3 +119,21 @@ func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// GetTokens returns the tokens transferred by the message. These are either the
+// multiple tokens set in Tokens or the single Token.
+func (msg MsgTransfer) GetTokens() sdk.Coins {
+	if len(msg.Tokens) != 0 {
+		return msg.Tokens
+	}
+	if isEmptyCoin(msg.Token) {
+		return nil
+	}
+	return sdk.Coins{msg.Token}
+}
+
+// isEmptyCoin returns true if neither the denomination nor the amount of the coin are set.
+// NOTE: an unset amount is decoded as zero.
+func isEmptyCoin(coin sdk.Coin) bool {
+	return coin.Denom == "" && (coin.Amount.IsNil() || coin.Amount.IsZero())
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewTransferEnabledOverride creates a new TransferEnabledOverride instance. An empty denom
+// creates an override for all denominations transferred over the channel.
+func NewTransferEnabledOverride(portID, channelID, denom string, sendEnabled, receiveEnabled bool) TransferEnabledOverride {
+	return TransferEnabledOverride{
+		PortId:         portID,
+		ChannelId:      channelID,
+		Denom:          denom,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// Validate performs a basic validation of the TransferEnabledOverride fields.
+func (o TransferEnabledOverride) Validate() error {
+	if err := host.PortIdentifierValidator(o.PortId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid port ID %s", o.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(o.ChannelId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid channel ID %s", o.ChannelId)
+	}
+	if o.Denom == "" {
+		return nil
+	}
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidOverride, err.Error())
+	}
+	if strings.HasPrefix(o.Denom, DenomPrefix+"/") {
+		if _, err := ParseHexHash(strings.TrimPrefix(o.Denom, DenomPrefix+"/")); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidOverride, "invalid IBC denomination %s: %s", o.Denom, err)
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true if the override enables both sending and receiving, in
+// which case it has no effect on transfers.
+func (o TransferEnabledOverride) IsEmpty() bool {
+	return o.SendEnabled && o.ReceiveEnabled
+}
+
+// TransferEnabledOverrides defines a wrapper type for a slice of TransferEnabledOverride.
+type TransferEnabledOverrides []TransferEnabledOverride
+
+// Validate performs a basic validation of each override, returning an error if
+// any of them is invalid or if an override is duplicated.
+func (o TransferEnabledOverrides) Validate() error {
+	seen := make(map[string]bool)
+	for i, override := range o {
+		key := string(GetTransferEnabledOverrideKey(override.PortId, override.ChannelId, override.Denom))
+		if seen[key] {
+			return sdkerrors.Wrapf(ErrInvalidOverride, "duplicated override for port ID %s, channel ID %s and denom %s", override.PortId, override.ChannelId, override.Denom)
+		}
+
+		if err := override.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "failed to validate override %d", i)
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
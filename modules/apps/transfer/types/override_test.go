@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+func TestTransferEnabledOverridesValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		overrides types.TransferEnabledOverrides
+		expPass   bool
+	}{
+		{"empty overrides", types.TransferEnabledOverrides{}, true},
+		{"valid channel override", types.TransferEnabledOverrides{types.NewTransferEnabledOverride("transfer", "channel-0", "", false, true)}, true},
+		{"valid native denom override", types.TransferEnabledOverrides{types.NewTransferEnabledOverride("transfer", "channel-0", "uatom", true, false)}, true},
+		{"valid ibc denom override", types.TransferEnabledOverrides{types.NewTransferEnabledOverride("transfer", "channel-0", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", false, false)}, true},
+		{"valid channel and denom overrides", types.TransferEnabledOverrides{
+			types.NewTransferEnabledOverride("transfer", "channel-0", "", false, true),
+			types.NewTransferEnabledOverride("transfer", "channel-0", "uatom", true, false),
+		}, true},
+		{"duplicated override", types.TransferEnabledOverrides{
+			types.NewTransferEnabledOverride("transfer", "channel-0", "uatom", false, true),
+			types.NewTransferEnabledOverride("transfer", "channel-0", "uatom", true, false),
+		}, false},
+		{"invalid port ID", types.TransferEnabledOverrides{types.NewTransferEnabledOverride("(INVALIDPORT)", "channel-0", "", false, true)}, false},
+		{"invalid channel ID", types.TransferEnabledOverrides{types.NewTransferEnabledOverride("transfer", "", "", false, true)}, false},
+		{"invalid denom", types.TransferEnabledOverrides{types.NewTransferEnabledOverride("transfer", "channel-0", "1uatom", false, true)}, false},
+		{"invalid ibc denom hash", types.TransferEnabledOverrides{types.NewTransferEnabledOverride("transfer", "channel-0", "ibc/invalidhash", false, true)}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.overrides.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestTransferEnabledProposalValidateBasic(t *testing.T) {
+	override := types.NewTransferEnabledOverride("transfer", "channel-0", "", false, false)
+
+	testCases := []struct {
+		name     string
+		proposal *types.TransferEnabledProposal
+		expPass  bool
+	}{
+		{"valid proposal", &types.TransferEnabledProposal{Title: "title", Description: "description", Overrides: []types.TransferEnabledOverride{override}}, true},
+		{"empty title", &types.TransferEnabledProposal{Title: "", Description: "description", Overrides: []types.TransferEnabledOverride{override}}, false},
+		{"no overrides", &types.TransferEnabledProposal{Title: "title", Description: "description"}, false},
+		{"invalid override", &types.TransferEnabledProposal{Title: "title", Description: "description", Overrides: []types.TransferEnabledOverride{
+			types.NewTransferEnabledOverride("transfer", "", "", false, false),
+		}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -25,12 +25,14 @@ var (
 func NewFungibleTokenPacketData(
 	denom string, amount string,
 	sender, receiver string,
+	memo string,
 ) FungibleTokenPacketData {
 	return FungibleTokenPacketData{
 		Denom:    denom,
 		Amount:   amount,
 		Sender:   sender,
 		Receiver: receiver,
+		Memo:     memo,
 	}
 }
 
@@ -54,7 +56,122 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	return ValidatePrefixedDenom(ftpd.Denom)
 }
 
-// GetBytes is a helper for serialising
+// GetBytes is a helper for serialising. Unpopulated fields are omitted so that
+// packets without a memo are encoded identically to those of prior versions.
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ftpd))
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
+}
+
+// ToMultiDenom returns the packet data as a MultiDenomFungibleTokenPacketData containing
+// the single token of the packet.
+func (ftpd FungibleTokenPacketData) ToMultiDenom() MultiDenomFungibleTokenPacketData {
+	return NewMultiDenomFungibleTokenPacketData(
+		[]Token{NewToken(ftpd.Denom, ftpd.Amount)},
+		ftpd.Sender, ftpd.Receiver, ftpd.Memo,
+	)
+}
+
+// NewMultiDenomFungibleTokenPacketData contructs a new MultiDenomFungibleTokenPacketData instance
+func NewMultiDenomFungibleTokenPacketData(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+) MultiDenomFungibleTokenPacketData {
+	return MultiDenomFungibleTokenPacketData{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic is used for validating the multi-denom token transfer. Every token must be
+// valid and each denomination may only be transferred once.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (mftpd MultiDenomFungibleTokenPacketData) ValidateBasic() error {
+	if len(mftpd.Tokens) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAmount, "packet must contain at least one token")
+	}
+
+	seenDenoms := make(map[string]bool, len(mftpd.Tokens))
+	for _, token := range mftpd.Tokens {
+		if err := token.ValidateBasic(); err != nil {
+			return err
+		}
+		if seenDenoms[token.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "duplicate denomination %s", token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+	}
+
+	if strings.TrimSpace(mftpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(mftpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (mftpd MultiDenomFungibleTokenPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&mftpd))
+}
+
+// GetPacketSender returns the sender of the packet. The source port is not used, as
+// the sender address is part of the packet data.
+func (mftpd MultiDenomFungibleTokenPacketData) GetPacketSender(_ string) string {
+	return mftpd.Sender
+}
+
+// NewToken constructs a new Token instance
+func NewToken(denom, amount string) Token {
+	return Token{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+// ValidateBasic checks that the token amount is strictly positive and that the
+// denomination is a valid prefixed denomination.
+func (t Token) ValidateBasic() error {
+	amount, ok := sdk.NewIntFromString(t.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+	return ValidatePrefixedDenom(t.Denom)
+}
+
+// UnmarshalPacketData decodes the packet data bytes of a packet sent on a channel
+// with the given ICS-20 version. Packets of channels using the original version
+// are converted into a MultiDenomFungibleTokenPacketData containing a single token.
+func UnmarshalPacketData(bz []byte, version string) (MultiDenomFungibleTokenPacketData, error) {
+	switch version {
+	case Version:
+		var data FungibleTokenPacketData
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return MultiDenomFungibleTokenPacketData{}, err
+		}
+
+		return data.ToMultiDenom(), nil
+	case MultiDenomVersion:
+		var data MultiDenomFungibleTokenPacketData
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return MultiDenomFungibleTokenPacketData{}, err
+		}
+
+		return data, nil
+	default:
+		return MultiDenomFungibleTokenPacketData{}, sdkerrors.Wrapf(ErrInvalidVersion, "unsupported ICS-20 version %s", version)
+	}
+}
+
+// IsSupportedVersion returns true if the given version is an ICS-20 version supported
+// by the transfer module.
+func IsSupportedVersion(version string) bool {
+	return version == Version || version == MultiDenomVersion
 }
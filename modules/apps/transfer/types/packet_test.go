@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,15 +21,16 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		packetData FungibleTokenPacketData
 		expPass    bool
 	}{
-		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2), true},
-		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2), true},
-		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2), false},
-		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2), false},
-		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2), false},
-		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2), false},
-		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2), false},
-		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2), false},
-		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr), false},
+		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2, ""), true},
+		{"valid packet with memo", NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo"), true},
+		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2, ""), true},
+		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2, ""), false},
+		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2, ""), false},
+		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2, ""), false},
+		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2, ""), false},
+		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr, ""), false},
 	}
 
 	for i, tc := range testCases {
@@ -40,3 +42,68 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataGetBytes tests that the memo is only encoded when it is populated
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "")
+	expBytes := fmt.Sprintf(`{"amount":"%s","denom":"%s","receiver":"%s","sender":"%s"}`, amount, denom, addr2, addr1)
+	require.Equal(t, expBytes, string(packetData.GetBytes()))
+
+	packetData.Memo = "memo"
+	expBytes = fmt.Sprintf(`{"amount":"%s","denom":"%s","memo":"memo","receiver":"%s","sender":"%s"}`, amount, denom, addr2, addr1)
+	require.Equal(t, expBytes, string(packetData.GetBytes()))
+
+	var decoded FungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(packetData.GetBytes(), &decoded))
+	require.Equal(t, packetData, decoded)
+}
+
+// TestMultiDenomFungibleTokenPacketDataValidateBasic tests ValidateBasic for MultiDenomFungibleTokenPacketData
+func TestMultiDenomFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	token := NewToken(denom, amount)
+
+	testCases := []struct {
+		name       string
+		packetData MultiDenomFungibleTokenPacketData
+		expPass    bool
+	}{
+		{"valid packet", NewMultiDenomFungibleTokenPacketData([]Token{token}, addr1, addr2, ""), true},
+		{"valid packet with multiple tokens", NewMultiDenomFungibleTokenPacketData([]Token{token, NewToken("uatom", largeAmount)}, addr1, addr2, "memo"), true},
+		{"no tokens", NewMultiDenomFungibleTokenPacketData(nil, addr1, addr2, ""), false},
+		{"duplicate denom", NewMultiDenomFungibleTokenPacketData([]Token{token, token}, addr1, addr2, ""), false},
+		{"invalid denom", NewMultiDenomFungibleTokenPacketData([]Token{token, NewToken("", amount)}, addr1, addr2, ""), false},
+		{"invalid zero amount", NewMultiDenomFungibleTokenPacketData([]Token{token, NewToken("uatom", "0")}, addr1, addr2, ""), false},
+		{"invalid large amount", NewMultiDenomFungibleTokenPacketData([]Token{NewToken(denom, invalidLargeAmount)}, addr1, addr2, ""), false},
+		{"missing sender address", NewMultiDenomFungibleTokenPacketData([]Token{token}, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewMultiDenomFungibleTokenPacketData([]Token{token}, addr1, emptyAddr, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestUnmarshalPacketData tests that packet data is decoded according to the channel version
+func TestUnmarshalPacketData(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+	multiDenomPacketData := NewMultiDenomFungibleTokenPacketData([]Token{NewToken(denom, amount), NewToken("uatom", largeAmount)}, addr1, addr2, "memo")
+
+	decoded, err := UnmarshalPacketData(packetData.GetBytes(), Version)
+	require.NoError(t, err)
+	require.Equal(t, packetData.ToMultiDenom(), decoded)
+
+	decoded, err = UnmarshalPacketData(multiDenomPacketData.GetBytes(), MultiDenomVersion)
+	require.NoError(t, err)
+	require.Equal(t, multiDenomPacketData, decoded)
+
+	_, err = UnmarshalPacketData(multiDenomPacketData.GetBytes(), Version)
+	require.Error(t, err)
+
+	_, err = UnmarshalPacketData(packetData.GetBytes(), "ics20-3")
+	require.ErrorIs(t, err, ErrInvalidVersion)
+}
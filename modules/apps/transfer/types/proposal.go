@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeTransferEnabled defines the type for a TransferEnabledProposal
+	ProposalTypeTransferEnabled = "TransferEnabled"
+)
+
+var _ govtypes.Content = &TransferEnabledProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTransferEnabled)
+}
+
+// NewTransferEnabledProposal creates a new transfer enabled proposal.
+func NewTransferEnabledProposal(title, description string, overrides []TransferEnabledOverride) govtypes.Content {
+	return &TransferEnabledProposal{
+		Title:       title,
+		Description: description,
+		Overrides:   overrides,
+	}
+}
+
+// GetTitle returns the title of a transfer enabled proposal.
+func (tep *TransferEnabledProposal) GetTitle() string { return tep.Title }
+
+// GetDescription returns the description of a transfer enabled proposal.
+func (tep *TransferEnabledProposal) GetDescription() string { return tep.Description }
+
+// ProposalRoute returns the routing key of a transfer enabled proposal.
+func (tep *TransferEnabledProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a transfer enabled proposal.
+func (tep *TransferEnabledProposal) ProposalType() string { return ProposalTypeTransferEnabled }
+
+// ValidateBasic runs basic stateless validity checks
+func (tep *TransferEnabledProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(tep); err != nil {
+		return err
+	}
+
+	if len(tep.Overrides) == 0 {
+		return sdkerrors.Wrap(ErrInvalidOverride, "proposal must contain at least one override")
+	}
+
+	return TransferEnabledOverrides(tep.Overrides).Validate()
+}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -70,6 +71,28 @@ func (dt DenomTrace) GetFullDenomPath() string {
 	return dt.GetPrefix() + dt.BaseDenom
 }
 
+// GetDenomMetadata returns the bank denomination metadata of the voucher denomination of the trace.
+// The voucher denomination is the base and display unit of the metadata, since a voucher is worth
+// exactly one token on the counterparty chain. The description contains the full denomination path.
+func (dt DenomTrace) GetDenomMetadata() banktypes.Metadata {
+	voucherDenom := dt.IBCDenom()
+	fullDenomPath := dt.GetFullDenomPath()
+
+	return banktypes.Metadata{
+		Description: fullDenomPath,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    voucherDenom,
+				Exponent: 0,
+			},
+		},
+		Base:    voucherDenom,
+		Display: voucherDenom,
+		Name:    fmt.Sprintf("%s IBC token", fullDenomPath),
+		Symbol:  strings.ToUpper(dt.BaseDenom),
+	}
+}
+
 func validateTraceIdentifiers(identifiers []string) error {
 	if len(identifiers) == 0 || len(identifiers)%2 != 0 {
 		return fmt.Errorf("trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
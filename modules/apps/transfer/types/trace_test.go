@@ -68,6 +68,26 @@ func TestDenomTrace_Validate(t *testing.T) {
 	}
 }
 
+func TestDenomTrace_GetDenomMetadata(t *testing.T) {
+	trace := DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToA"}
+
+	metadata := trace.GetDenomMetadata()
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, trace.IBCDenom(), metadata.Base)
+	require.Equal(t, trace.IBCDenom(), metadata.Display)
+	require.Equal(t, "transfer/channelToA/uatom", metadata.Description)
+	require.Equal(t, "UATOM", metadata.Symbol)
+	require.Len(t, metadata.DenomUnits, 1)
+	require.Equal(t, trace.IBCDenom(), metadata.DenomUnits[0].Denom)
+	require.Equal(t, uint32(0), metadata.DenomUnits[0].Exponent)
+	require.Empty(t, metadata.DenomUnits[0].Aliases)
+
+	// a trace without a path is displayed with its base denomination
+	metadata = DenomTrace{BaseDenom: "uatom"}.GetDenomMetadata()
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, "uatom", metadata.Display)
+}
+
 func TestTraces_Validate(t *testing.T) {
 	testCases := []struct {
 		name     string
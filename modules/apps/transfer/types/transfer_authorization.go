@@ -0,0 +1,151 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &TransferAuthorization{}
+
+// NewTransferAuthorization creates a new TransferAuthorization object.
+func NewTransferAuthorization(allocations ...Allocation) *TransferAuthorization {
+	return &TransferAuthorization{
+		Allocations: allocations,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTransfer{})
+}
+
+// Accept implements Authorization.Accept. The transfer is accepted if an allocation exists for its
+// source port and channel, the tokens transferred do not exceed the spend limit of the allocation and
+// the receiver is in the allow list of the allocation, if one is set. The spend limit of the allocation
+// is decremented by the tokens transferred and the allocation is removed once its spend limit is spent.
+func (a TransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgTransfer, ok := msg.(*MsgTransfer)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	for index, allocation := range a.Allocations {
+		if allocation.SourceChannel != msgTransfer.SourceChannel || allocation.SourcePort != msgTransfer.SourcePort {
+			continue
+		}
+
+		limitLeft, isNegative := allocation.SpendLimit.SafeSub(msgTransfer.GetTokens())
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+		}
+
+		if !isAllowedAddress(ctx, msgTransfer.Receiver, allocation.AllowList) {
+			return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
+		}
+
+		// copy the allocations so that the authorization the request was accepted against is not modified
+		allocations := make([]Allocation, 0, len(a.Allocations))
+		allocations = append(allocations, a.Allocations[:index]...)
+		if !limitLeft.IsZero() {
+			allocation.SpendLimit = limitLeft
+			allocations = append(allocations, allocation)
+		}
+		allocations = append(allocations, a.Allocations[index+1:]...)
+
+		if len(allocations) == 0 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+
+		return authz.AcceptResponse{Accept: true, Delete: false, Updated: NewTransferAuthorization(allocations...)}, nil
+	}
+
+	return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrNotFound, "requested port and channel allocation does not exist")
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferAuthorization) ValidateBasic() error {
+	if len(a.Allocations) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "allocations cannot be empty")
+	}
+
+	foundChannels := make(map[string]bool)
+
+	for _, allocation := range a.Allocations {
+		if err := allocation.ValidateBasic(); err != nil {
+			return err
+		}
+
+		path := allocation.SourcePort + "/" + allocation.SourceChannel
+		if foundChannels[path] {
+			return sdkerrors.Wrapf(ErrInvalidAuthorization, "duplicate source port and channel %s", path)
+		}
+
+		foundChannels[path] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a basic validation of the allocation: the source port and channel identifiers
+// must be valid, the spend limit must be valid and positive and the allow list must not contain
+// empty or duplicate addresses.
+func (allocation Allocation) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(allocation.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(allocation.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+
+	if allocation.SpendLimit == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be nil")
+	}
+
+	if err := allocation.SpendLimit.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !allocation.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+	}
+
+	found := make(map[string]bool)
+	for _, address := range allocation.AllowList {
+		if strings.TrimSpace(address) == "" {
+			return sdkerrors.Wrap(ErrInvalidAuthorization, "allow list address cannot be blank")
+		}
+
+		if found[address] {
+			return sdkerrors.Wrapf(ErrInvalidAuthorization, "duplicate entry in allow list %s", address)
+		}
+
+		found[address] = true
+	}
+
+	return nil
+}
+
+// isAllowedAddress returns true if the allow list is empty or contains the given receiver address.
+// Gas is consumed for every entry of the allow list checked.
+func isAllowedAddress(ctx sdk.Context, receiver string, allowList []string) bool {
+	if len(allowList) == 0 {
+		return true
+	}
+
+	for _, address := range allowList {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "transfer authorization")
+		if address == receiver {
+			return true
+		}
+	}
+
+	return false
+}
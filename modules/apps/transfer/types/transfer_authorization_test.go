@@ -0,0 +1,394 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+var (
+	coins    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	receiver = "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+)
+
+func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
+	var (
+		transferAuthz types.TransferAuthorization
+		msgTransfer   types.MsgTransfer
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		assertResult func(res authz.AcceptResponse, err error)
+	}{
+		{
+			"success",
+			func() {},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().True(res.Delete)
+				suite.Require().Nil(res.Updated)
+			},
+		},
+		{
+			"success: with spend limit updated",
+			func() {
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				remainder := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)))
+				suite.Require().Equal(remainder, updatedAuthz.Allocations[0].SpendLimit)
+
+				// the original authorization is not modified
+				suite.Require().Equal(coins, transferAuthz.Allocations[0].SpendLimit)
+			},
+		},
+		{
+			"success: with multiple tokens",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = coins.Add(sdk.NewCoin("atom", sdk.NewInt(100)))
+
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin("atom", sdk.NewInt(40)))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(60))), updatedAuthz.Allocations[0].SpendLimit)
+			},
+		},
+		{
+			"success: with empty allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().True(res.Delete)
+				suite.Require().Nil(res.Updated)
+			},
+		},
+		{
+			"success: with multiple allocations",
+			func() {
+				alloc := types.Allocation{
+					SourcePort:    ibctesting.MockPort,
+					SourceChannel: "channel-9",
+					SpendLimit:    coins,
+				}
+
+				transferAuthz.Allocations = append(transferAuthz.Allocations, alloc)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				// assert spent spendlimit is removed from the list
+				suite.Require().Len(updatedAuthz.Allocations, 1)
+				suite.Require().Equal("channel-9", updatedAuthz.Allocations[0].SourceChannel)
+			},
+		},
+		{
+			"no spend limit set for MsgTransfer port/channel",
+			func() {
+				msgTransfer.SourcePort = ibctesting.MockPort
+				msgTransfer.SourceChannel = "channel-9"
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().Error(err)
+			},
+		},
+		{
+			"requested transfer amount is more than the spend limit",
+			func() {
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().Error(err)
+			},
+		},
+		{
+			"requested transfer denomination is not in the spend limit",
+			func() {
+				msgTransfer.Token = sdk.NewCoin("atom", sdk.NewInt(10))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().Error(err)
+			},
+		},
+		{
+			"receiver address not permitted via allow list",
+			func() {
+				msgTransfer.Receiver = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().Error(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			transferAuthz = types.TransferAuthorization{
+				Allocations: []types.Allocation{
+					{
+						SourcePort:    path.EndpointA.ChannelConfig.PortID,
+						SourceChannel: path.EndpointA.ChannelID,
+						SpendLimit:    coins,
+						AllowList:     []string{receiver},
+					},
+				},
+			}
+
+			msgTransfer = types.MsgTransfer{
+				SourcePort:    path.EndpointA.ChannelConfig.PortID,
+				SourceChannel: path.EndpointA.ChannelID,
+				Token:         sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				Sender:        suite.chainA.SenderAccount.GetAddress().String(),
+				Receiver:      receiver,
+				TimeoutHeight: clienttypes.NewHeight(0, 110),
+			}
+
+			tc.malleate()
+
+			res, err := transferAuthz.Accept(suite.chainA.GetContext(), &msgTransfer)
+			tc.assertResult(res, err)
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestTransferAuthorizationMsgExec() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	granter := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	grantee := suite.chainA.SenderAccounts[1]
+
+	transferAuthz := types.NewTransferAuthorization(types.Allocation{
+		SourcePort:    path.EndpointA.ChannelConfig.PortID,
+		SourceChannel: path.EndpointA.ChannelID,
+		SpendLimit:    coins,
+	})
+
+	msgGrant, err := authz.NewMsgGrant(granter, grantee.SenderAccount.GetAddress(), transferAuthz, suite.chainA.GetContext().BlockTime().AddDate(1, 0, 0))
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.SendMsgs(msgGrant)
+	suite.Require().NoError(err)
+
+	msgTransfer := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(60)),
+		granter.String(), receiver, clienttypes.NewHeight(0, 110), 0, "",
+	)
+	msgExec := authz.NewMsgExec(grantee.SenderAccount.GetAddress(), []sdk.Msg{msgTransfer})
+
+	// the grantee signs the MsgExec
+	suite.chainA.SenderPrivKey, suite.chainA.SenderAccount = grantee.SenderPrivKey, grantee.SenderAccount
+	_, err = suite.chainA.SendMsgs(&msgExec)
+	suite.Require().NoError(err)
+
+	authorization, _ := suite.chainA.GetSimApp().AuthzKeeper.GetCleanAuthorization(suite.chainA.GetContext(), grantee.SenderAccount.GetAddress(), granter, transferAuthz.MsgTypeURL())
+	suite.Require().NotNil(authorization)
+
+	updatedAuthz, ok := authorization.(*types.TransferAuthorization)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40))), updatedAuthz.Allocations[0].SpendLimit)
+
+	// the remaining spend limit is exceeded
+	msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))
+	_, err = suite.chainA.GetSimApp().AuthzKeeper.DispatchActions(suite.chainA.GetContext(), grantee.SenderAccount.GetAddress(), []sdk.Msg{msgTransfer})
+	suite.Require().Error(err)
+
+	escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(sdk.NewInt(60), suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrow, sdk.DefaultBondDenom).Amount)
+}
+
+func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
+	var transferAuthz types.TransferAuthorization
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{}
+			},
+			true,
+		},
+		{
+			"success: with multiple allocations",
+			func() {
+				transferAuthz.Allocations = append(transferAuthz.Allocations, types.Allocation{
+					SourcePort:    types.PortID,
+					SourceChannel: "channel-1",
+					SpendLimit:    coins,
+				})
+			},
+			true,
+		},
+		{
+			"empty allocations",
+			func() {
+				transferAuthz = types.TransferAuthorization{Allocations: []types.Allocation{}}
+			},
+			false,
+		},
+		{
+			"nil allocations",
+			func() {
+				transferAuthz = types.TransferAuthorization{}
+			},
+			false,
+		},
+		{
+			"nil spend limit coins",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = nil
+			},
+			false,
+		},
+		{
+			"invalid spend limit coins",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.Coins{sdk.Coin{Denom: ""}}
+			},
+			false,
+		},
+		{
+			"zero spend limit coins",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}}
+			},
+			false,
+		},
+		{
+			"duplicate entry in allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{receiver, receiver}
+			},
+			false,
+		},
+		{
+			"blank entry in allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{" "}
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				transferAuthz.Allocations[0].SourcePort = ""
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				transferAuthz.Allocations[0].SourceChannel = ""
+			},
+			false,
+		},
+		{
+			"same channel ID on a different port",
+			func() {
+				allocation := types.Allocation{
+					SourcePort:    mock.PortID,
+					SourceChannel: transferAuthz.Allocations[0].SourceChannel,
+					SpendLimit:    coins,
+					AllowList:     []string{receiver},
+				}
+
+				transferAuthz.Allocations = append(transferAuthz.Allocations, allocation)
+			},
+			true,
+		},
+		{
+			"duplicate port and channel ID",
+			func() {
+				transferAuthz.Allocations = append(transferAuthz.Allocations, transferAuthz.Allocations[0])
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			transferAuthz = types.TransferAuthorization{
+				Allocations: []types.Allocation{
+					{
+						SourcePort:    types.PortID,
+						SourceChannel: ibctesting.FirstChannelID,
+						SpendLimit:    coins,
+						AllowList:     []string{receiver},
+					},
+				},
+			}
+
+			tc.malleate()
+
+			err := transferAuthz.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// NewTransferPath returns a transfer path between the given chains.
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
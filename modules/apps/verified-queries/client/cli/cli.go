@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the verified queries module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "verified-queries",
+		Short:                      "IBC verified queries query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryResults(),
+		GetCmdQueryResult(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the verified queries module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "verified-queries",
+		Short:                      "IBC verified queries transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSubmitQueryResultTxCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+)
+
+// GetCmdQueryResults defines the command to query all the verified query results
+func GetCmdQueryResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "results",
+		Short:   "Query all the verified query results",
+		Long:    "Query all the verified query results of keys in counterparty stores",
+		Example: fmt.Sprintf("%s query verified-queries results", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryQueryResultsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.QueryResults(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "query results")
+
+	return cmd
+}
+
+// GetCmdQueryResult defines the command to query the verified query result of a key in a counterparty store
+func GetCmdQueryResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "result [client-id] [store-key] [hex-key]",
+		Short:   "Query the verified query result of a key in a counterparty store",
+		Long:    "Query the latest verified query result of a hex encoded key in a counterparty store, verified by the given client",
+		Example: fmt.Sprintf("%s query verified-queries result 07-tendermint-0 bank 0200", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			key, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryQueryResultRequest{
+				ClientId: args[0],
+				StoreKey: args[1],
+				Key:      key,
+			}
+
+			res, err := queryClient.QueryResult(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
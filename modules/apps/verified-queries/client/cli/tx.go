@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectionutils "github.com/cosmos/ibc-go/v3/modules/core/03-connection/client/utils"
+)
+
+const (
+	flagValue = "value"
+)
+
+// NewSubmitQueryResultTxCmd returns the command to create a MsgSubmitQueryResult
+func NewSubmitQueryResultTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-result [client-id] [store-key] [hex-key] [proof-height] [path/to/proof.json]",
+		Short: "Submit the proven value of a key in a counterparty store",
+		Long: strings.TrimSpace(`Submit the value of a hex encoded key in a counterparty store, proven by a merkle proof
+at the given height. The proof is verified against the consensus state of the given client before the result is stored.
+Omit the --value flag to submit a proof of absence of the key.`),
+		Example: fmt.Sprintf("%s tx verified-queries submit-result 07-tendermint-0 bank 0200 1-100 proof.json --value 0a0574", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			proofHeight, err := clienttypes.ParseHeight(args[3])
+			if err != nil {
+				return err
+			}
+
+			proof, err := connectionutils.ParseProof(clientCtx.LegacyAmino, args[4])
+			if err != nil {
+				return err
+			}
+
+			valueStr, err := cmd.Flags().GetString(flagValue)
+			if err != nil {
+				return err
+			}
+
+			value, err := hex.DecodeString(valueStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitQueryResult(args[0], args[1], key, value, proof, proofHeight, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagValue, "", "Hex encoded value of the key. Leave empty to prove the absence of the key.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+)
+
+// InitGenesis initializes the verified queries state from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, result := range state.Results {
+		k.SetQueryResult(ctx, result)
+	}
+}
+
+// ExportGenesis returns the verified queries exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Results: k.GetAllQueryResults(ctx),
+	}
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// QueryResults implements the Query/QueryResults gRPC method
+func (k Keeper) QueryResults(goCtx context.Context, req *types.QueryQueryResultsRequest) (*types.QueryQueryResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var results []types.QueryResult
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.QueryResultKeyPrefix))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.QueryResult
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		results = append(results, result)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueryResultsResponse{
+		Results:    results,
+		Pagination: pageRes,
+	}, nil
+}
+
+// QueryResult implements the Query/QueryResult gRPC method
+func (k Keeper) QueryResult(goCtx context.Context, req *types.QueryQueryResultRequest) (*types.QueryQueryResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateQueryKey(req.ClientId, req.StoreKey, req.Key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	result, found := k.GetQueryResult(ctx, req.ClientId, req.StoreKey, req.Key)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrQueryResultNotFound, "client ID (%s) store key (%s) key (%X)", req.ClientId, req.StoreKey, req.Key).Error(),
+		)
+	}
+
+	return &types.QueryQueryResultResponse{
+		Result: result,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Keeper defines the verified queries keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	clientKeeper types.ClientKeeper
+}
+
+// NewKeeper creates a new verified queries Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, clientKeeper types.ClientKeeper) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     key,
+		clientKeeper: clientKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// VerifyQueryResult verifies the membership of the key with the given value in the counterparty store, or the
+// absence of the key if the value is empty, against the root of the consensus state of the given client at the
+// proof height. The proof must be a proto encoded merkle proof. The verified result is returned without being stored.
+func (k Keeper) VerifyQueryResult(
+	ctx sdk.Context,
+	clientID, storeKey string,
+	key, value, proof []byte,
+	proofHeight clienttypes.Height,
+) (types.QueryResult, error) {
+	if err := types.ValidateQueryKey(clientID, storeKey, key); err != nil {
+		return types.QueryResult{}, err
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return types.QueryResult{}, sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc); status != ibcexported.Active {
+		return types.QueryResult{}, sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	merkleClientState, ok := clientState.(types.MerkleClientState)
+	if !ok {
+		return types.QueryResult{}, sdkerrors.Wrapf(types.ErrUnsupportedClient, "client type %s", clientState.ClientType())
+	}
+
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, proofHeight)
+	if !found {
+		return types.QueryResult{}, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "client (%s) height (%s)", clientID, proofHeight)
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := k.cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return types.QueryResult{}, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal merkle proof: %v", err)
+	}
+
+	result := types.NewQueryResult(clientID, storeKey, key, value, proofHeight, consensusState.GetTimestamp())
+	if result.Exists() {
+		if err := merkleProof.VerifyMembership(merkleClientState.GetProofSpecs(), consensusState.GetRoot(), result.MerklePath(), value); err != nil {
+			return types.QueryResult{}, err
+		}
+	} else {
+		if err := merkleProof.VerifyNonMembership(merkleClientState.GetProofSpecs(), consensusState.GetRoot(), result.MerklePath()); err != nil {
+			return types.QueryResult{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// SubmitQueryResult verifies the query result as described in VerifyQueryResult and stores it, replacing any
+// result of the same key verified by the same client at a lower or equal proof height.
+func (k Keeper) SubmitQueryResult(
+	ctx sdk.Context,
+	clientID, storeKey string,
+	key, value, proof []byte,
+	proofHeight clienttypes.Height,
+) (types.QueryResult, error) {
+	result, err := k.VerifyQueryResult(ctx, clientID, storeKey, key, value, proof, proofHeight)
+	if err != nil {
+		return types.QueryResult{}, err
+	}
+
+	if existing, found := k.GetQueryResult(ctx, clientID, storeKey, key); found && existing.ProofHeight.GT(proofHeight) {
+		return types.QueryResult{}, sdkerrors.Wrapf(types.ErrStaleQueryResult, "proof height %s is lower than stored proof height %s", proofHeight, existing.ProofHeight)
+	}
+
+	k.SetQueryResult(ctx, result)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitQueryResult,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyStoreKey, storeKey),
+			sdk.NewAttribute(types.AttributeKeyKey, hex.EncodeToString(key)),
+			sdk.NewAttribute(types.AttributeKeyValue, hex.EncodeToString(value)),
+			sdk.NewAttribute(types.AttributeKeyProofHeight, proofHeight.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("query result verified", "client-id", clientID, "store-key", storeKey, "proof-height", proofHeight, "exists", result.Exists())
+
+	return result, nil
+}
+
+// SetQueryResult stores the query result keyed by its client, store key and key
+func (k Keeper) SetQueryResult(ctx sdk.Context, result types.QueryResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyQueryResult(result.ClientId, result.StoreKey, result.Key), k.cdc.MustMarshal(&result))
+}
+
+// GetQueryResult retrieves the latest query result of the given key in the given counterparty store verified by the
+// given client
+func (k Keeper) GetQueryResult(ctx sdk.Context, clientID, storeKey string, key []byte) (types.QueryResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyQueryResult(clientID, storeKey, key))
+	if bz == nil {
+		return types.QueryResult{}, false
+	}
+
+	var result types.QueryResult
+	k.cdc.MustUnmarshal(bz, &result)
+
+	return result, true
+}
+
+// DeleteQueryResult deletes the query result of the given key in the given counterparty store verified by the given client
+func (k Keeper) DeleteQueryResult(ctx sdk.Context, clientID, storeKey string, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyQueryResult(clientID, storeKey, key))
+}
+
+// GetAllQueryResults returns all query results stored
+func (k Keeper) GetAllQueryResults(ctx sdk.Context) []types.QueryResult {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.QueryResultKeyPrefix))
+	defer iterator.Close()
+
+	var results []types.QueryResult
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.QueryResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,377 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(suite.path)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().VerifiedQueriesKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// balanceKey returns the key under which the balance of the sender account of chainB is stored in the bank store
+func (suite *KeeperTestSuite) balanceKey(denom string) []byte {
+	return append(banktypes.CreateAccountBalancesPrefix(suite.chainB.SenderAccount.GetAddress()), []byte(denom)...)
+}
+
+// balanceValue returns the value under which the balance of the sender account of chainB is stored in the bank store
+func (suite *KeeperTestSuite) balanceValue(denom string) []byte {
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), denom)
+	return suite.chainB.App.AppCodec().MustMarshal(&balance)
+}
+
+// proveKey updates the client of chainB on chainA to the latest height of chainB and returns the proof
+// of the key in the given store of chainB at that height
+func (suite *KeeperTestSuite) proveKey(storeKey string, key []byte) ([]byte, clienttypes.Height) {
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	return suite.chainB.QueryProofForStore(storeKey, key, suite.chainB.App.LastBlockHeight())
+}
+
+func (suite *KeeperTestSuite) TestSubmitQueryResult() {
+	var (
+		msg *types.MsgSubmitQueryResult
+		err error
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: absence of key",
+			func() {
+				msg.Key = suite.balanceKey("nonexistent")
+				msg.Value = nil
+				msg.Proof, msg.ProofHeight = suite.proveKey(banktypes.StoreKey, msg.Key)
+			},
+			true,
+		},
+		{
+			"success: result at a lower proof height is replaced",
+			func() {
+				result := types.NewQueryResult(msg.ClientId, msg.StoreKey, msg.Key, msg.Value, clienttypes.NewHeight(msg.ProofHeight.RevisionNumber, 1), 0)
+				suite.chainA.GetSimApp().VerifiedQueriesKeeper.SetQueryResult(suite.chainA.GetContext(), result)
+			},
+			true,
+		},
+		{
+			"result at a greater proof height is stored",
+			func() {
+				result := types.NewQueryResult(msg.ClientId, msg.StoreKey, msg.Key, msg.Value, msg.ProofHeight.Increment().(clienttypes.Height), 0)
+				suite.chainA.GetSimApp().VerifiedQueriesKeeper.SetQueryResult(suite.chainA.GetContext(), result)
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"client is not active",
+			func() {
+				clientState := suite.path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				suite.path.EndpointA.SetClientState(clientState)
+			},
+			false,
+		},
+		{
+			"client does not support merkle proofs",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1)
+				suite.path.EndpointA.SetClientState(solomachine.ClientState())
+			},
+			false,
+		},
+		{
+			"consensus state not found",
+			func() {
+				msg.ProofHeight = msg.ProofHeight.Increment().(clienttypes.Height)
+			},
+			false,
+		},
+		{
+			"invalid proof",
+			func() {
+				msg.Proof = []byte("invalid proof")
+			},
+			false,
+		},
+		{
+			"value does not match",
+			func() {
+				msg.Value = []byte("invalid value")
+			},
+			false,
+		},
+		{
+			"absence of existing key",
+			func() {
+				msg.Value = nil
+			},
+			false,
+		},
+		{
+			"membership of absent key",
+			func() {
+				msg.Key = suite.balanceKey("nonexistent")
+				msg.Proof, msg.ProofHeight = suite.proveKey(banktypes.StoreKey, msg.Key)
+			},
+			false,
+		},
+		{
+			"proof of a different store",
+			func() {
+				msg.StoreKey = stakingtypes.StoreKey
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			key := suite.balanceKey(sdk.DefaultBondDenom)
+			proof, proofHeight := suite.proveKey(banktypes.StoreKey, key)
+			msg = types.NewMsgSubmitQueryResult(
+				suite.path.EndpointA.ClientID, banktypes.StoreKey, key, suite.balanceValue(sdk.DefaultBondDenom),
+				proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(suite.chainA.GetSimApp().VerifiedQueriesKeeper)
+			_, err = msgServer.SubmitQueryResult(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			result, found := suite.chainA.GetSimApp().VerifiedQueriesKeeper.GetQueryResult(suite.chainA.GetContext(), msg.ClientId, msg.StoreKey, msg.Key)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+
+				consensusState := suite.path.EndpointA.GetConsensusState(msg.ProofHeight)
+				expResult := types.NewQueryResult(msg.ClientId, msg.StoreKey, msg.Key, msg.Value, msg.ProofHeight, consensusState.GetTimestamp())
+				suite.Require().Equal(expResult, result)
+				suite.Require().Equal(len(msg.Value) != 0, result.Exists())
+			} else {
+				suite.Require().Error(err)
+				if found {
+					suite.Require().NotEqual(msg.ProofHeight, result.ProofHeight)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifyQueryResult() {
+	key := suite.balanceKey(sdk.DefaultBondDenom)
+	value := suite.balanceValue(sdk.DefaultBondDenom)
+	proof, proofHeight := suite.proveKey(banktypes.StoreKey, key)
+
+	result, err := suite.chainA.GetSimApp().VerifiedQueriesKeeper.VerifyQueryResult(
+		suite.chainA.GetContext(), suite.path.EndpointA.ClientID, banktypes.StoreKey, key, value, proof, proofHeight,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(value, result.Value)
+	suite.Require().Equal(proofHeight, result.ProofHeight)
+
+	// verified results are not stored
+	_, found := suite.chainA.GetSimApp().VerifiedQueriesKeeper.GetQueryResult(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, banktypes.StoreKey, key)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	results := []types.QueryResult{
+		types.NewQueryResult(ibctesting.FirstClientID, banktypes.StoreKey, []byte("key"), []byte("value"), clienttypes.NewHeight(1, 10), 100),
+		types.NewQueryResult(ibctesting.FirstClientID, stakingtypes.StoreKey, []byte("key"), nil, clienttypes.NewHeight(1, 10), 100),
+	}
+
+	genesis := types.NewGenesisState(results)
+
+	suite.Require().NotPanics(func() {
+		suite.chainA.GetSimApp().VerifiedQueriesKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+	})
+
+	exported := suite.chainA.GetSimApp().VerifiedQueriesKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().ElementsMatch(results, exported.Results)
+}
+
+func (suite *KeeperTestSuite) TestQueryQueryResults() {
+	var (
+		req        *types.QueryQueryResultsRequest
+		expResults []types.QueryResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryQueryResultsRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expResults = []types.QueryResult{
+					types.NewQueryResult(ibctesting.FirstClientID, banktypes.StoreKey, []byte("key"), []byte("value"), clienttypes.NewHeight(1, 10), 100),
+					types.NewQueryResult(ibctesting.FirstClientID, stakingtypes.StoreKey, []byte("key"), nil, clienttypes.NewHeight(1, 10), 100),
+				}
+
+				for _, result := range expResults {
+					suite.chainA.GetSimApp().VerifiedQueriesKeeper.SetQueryResult(suite.chainA.GetContext(), result)
+				}
+
+				req = &types.QueryQueryResultsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expResults = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.QueryResults(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expResults, res.Results)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryQueryResult() {
+	var (
+		req       *types.QueryQueryResultRequest
+		expResult types.QueryResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expResult = types.NewQueryResult(ibctesting.FirstClientID, banktypes.StoreKey, []byte("key"), []byte("value"), clienttypes.NewHeight(1, 10), 100)
+				suite.chainA.GetSimApp().VerifiedQueriesKeeper.SetQueryResult(suite.chainA.GetContext(), expResult)
+
+				req = &types.QueryQueryResultRequest{
+					ClientId: ibctesting.FirstClientID,
+					StoreKey: banktypes.StoreKey,
+					Key:      []byte("key"),
+				}
+			},
+			true,
+		},
+		{
+			"query result not found",
+			func() {
+				req = &types.QueryQueryResultRequest{
+					ClientId: ibctesting.FirstClientID,
+					StoreKey: banktypes.StoreKey,
+					Key:      []byte("key"),
+				}
+			},
+			false,
+		},
+		{
+			"empty key",
+			func() {
+				req = &types.QueryQueryResultRequest{
+					ClientId: ibctesting.FirstClientID,
+					StoreKey: banktypes.StoreKey,
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.QueryResult(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expResult, res.Result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the verified queries MsgServer interface
+// for the provided Keeper
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SubmitQueryResult defines a rpc handler method for MsgSubmitQueryResult. The query result is verified against
+// the consensus state of the light client at the proof height and stored upon success.
+func (s msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmitQueryResult) (*types.MsgSubmitQueryResultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := s.Keeper.SubmitQueryResult(ctx, msg.ClientId, msg.StoreKey, msg.Key, msg.Value, msg.Proof, msg.ProofHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitQueryResultResponse{}, nil
+}
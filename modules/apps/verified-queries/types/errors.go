@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// verified queries sentinel errors
+var (
+	ErrInvalidStoreKey     = sdkerrors.Register(ModuleName, 2, "invalid counterparty store key")
+	ErrInvalidKey          = sdkerrors.Register(ModuleName, 3, "invalid counterparty key")
+	ErrUnsupportedClient   = sdkerrors.Register(ModuleName, 4, "client does not support merkle proof verification")
+	ErrQueryResultNotFound = sdkerrors.Register(ModuleName, 5, "query result not found")
+	ErrStaleQueryResult    = sdkerrors.Register(ModuleName, 6, "query result is older than the stored result")
+	ErrInvalidQueryResult  = sdkerrors.Register(ModuleName, 7, "invalid query result")
+)
@@ -0,0 +1,13 @@
+package types
+
+// verified queries events
+const (
+	EventTypeSubmitQueryResult = "submit_query_result"
+
+	AttributeKeyClientID    = "client_id"
+	AttributeKeyStoreKey    = "store_key"
+	AttributeKeyKey         = "key"
+	AttributeKeyValue       = "value"
+	AttributeKeyProofHeight = "proof_height"
+	AttributeKeySigner      = "signer"
+)
@@ -0,0 +1,23 @@
+package types
+
+import (
+	ics23 "github.com/confio/ics23/go"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height) (ibcexported.ConsensusState, bool)
+	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+}
+
+// MerkleClientState defines a client state whose consensus state roots commit to the counterparty stores
+// as merkle trees described by its proof specs, such as the 07-tendermint client state
+type MerkleClientState interface {
+	ibcexported.ClientState
+
+	GetProofSpecs() []*ics23.ProofSpec
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a verified queries GenesisState instance.
+func NewGenesisState(results []QueryResult) *GenesisState {
+	return &GenesisState{
+		Results: results,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no query results.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Results: []QueryResult{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenResults := make(map[string]bool)
+	for _, result := range gs.Results {
+		if err := result.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyQueryResult(result.ClientId, result.StoreKey, result.Key))
+		if seenResults[key] {
+			return sdkerrors.Wrapf(ErrInvalidQueryResult, "duplicated query result for client ID %s, store key %s and key %X", result.ClientId, result.StoreKey, result.Key)
+		}
+
+		seenResults[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default genesis",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState([]types.QueryResult{
+				types.NewQueryResult(ibctesting.FirstClientID, "bank", []byte("key"), []byte("value"), clienttypes.NewHeight(1, 10), 100),
+				types.NewQueryResult(ibctesting.FirstClientID, "bank", []byte("other key"), nil, clienttypes.NewHeight(1, 10), 100),
+			}),
+			true,
+		},
+		{
+			"duplicated query result",
+			types.NewGenesisState([]types.QueryResult{
+				types.NewQueryResult(ibctesting.FirstClientID, "bank", []byte("key"), []byte("value"), clienttypes.NewHeight(1, 10), 100),
+				types.NewQueryResult(ibctesting.FirstClientID, "bank", []byte("key"), nil, clienttypes.NewHeight(1, 11), 100),
+			}),
+			false,
+		},
+		{
+			"invalid store key",
+			types.NewGenesisState([]types.QueryResult{
+				types.NewQueryResult(ibctesting.FirstClientID, "", []byte("key"), []byte("value"), clienttypes.NewHeight(1, 10), 100),
+			}),
+			false,
+		},
+		{
+			"zero proof height",
+			types.NewGenesisState([]types.QueryResult{
+				types.NewQueryResult(ibctesting.FirstClientID, "bank", []byte("key"), []byte("value"), clienttypes.ZeroHeight(), 100),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the verified queries module name
+	ModuleName = "verifiedqueries"
+
+	// StoreKey is the store key string for the verified queries module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the verified queries module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the verified queries module
+	QuerierRoute = ModuleName
+
+	// QueryResultKeyPrefix is the key prefix for query results stored by client, store key and key
+	QueryResultKeyPrefix = "queryResult"
+)
+
+// KeyQueryResult returns the key under which the query result of the given key in the given counterparty store,
+// verified by the given client, is stored
+func KeyQueryResult(clientID, storeKey string, key []byte) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/%s/", QueryResultKeyPrefix, clientID, storeKey)), key...)
+}
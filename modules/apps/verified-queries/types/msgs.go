@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+)
+
+// msg types
+const (
+	TypeMsgSubmitQueryResult = "submitQueryResult"
+)
+
+var _ sdk.Msg = &MsgSubmitQueryResult{}
+
+// NewMsgSubmitQueryResult creates a new instance of MsgSubmitQueryResult
+func NewMsgSubmitQueryResult(clientID, storeKey string, key, value, proof []byte, proofHeight clienttypes.Height, signer string) *MsgSubmitQueryResult {
+	return &MsgSubmitQueryResult{
+		ClientId:    clientID,
+		StoreKey:    storeKey,
+		Key:         key,
+		Value:       value,
+		Proof:       proof,
+		ProofHeight: proofHeight,
+		Signer:      signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSubmitQueryResult fields
+func (msg MsgSubmitQueryResult) ValidateBasic() error {
+	if err := ValidateQueryKey(msg.ClientId, msg.StoreKey, msg.Key); err != nil {
+		return err
+	}
+
+	if len(msg.Proof) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof cannot be empty")
+	}
+
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeight, "proof height cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSubmitQueryResult) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgSubmitQueryResult) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgSubmitQueryResult) Type() string {
+	return TypeMsgSubmitQueryResult
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgSubmitQueryResult) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var defaultAccAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+func TestMsgSubmitQueryResultValidation(t *testing.T) {
+	var msg *types.MsgSubmitQueryResult
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty value",
+			func() {
+				msg.Value = nil
+			},
+			true,
+		},
+		{
+			"invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			false,
+		},
+		{
+			"empty store key",
+			func() {
+				msg.StoreKey = " "
+			},
+			false,
+		},
+		{
+			"store key contains a separator",
+			func() {
+				msg.StoreKey = "bank/balances"
+			},
+			false,
+		},
+		{
+			"empty key",
+			func() {
+				msg.Key = nil
+			},
+			false,
+		},
+		{
+			"empty proof",
+			func() {
+				msg.Proof = nil
+			},
+			false,
+		},
+		{
+			"zero proof height",
+			func() {
+				msg.ProofHeight = clienttypes.ZeroHeight()
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgSubmitQueryResult(
+			ibctesting.FirstClientID, "bank", []byte("key"), []byte("value"), []byte("proof"), clienttypes.NewHeight(1, 10), defaultAccAddress,
+		)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgSubmitQueryResultGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgSubmitQueryResult(
+		ibctesting.FirstClientID, "bank", []byte("key"), []byte("value"), []byte("proof"), clienttypes.NewHeight(1, 10), accAddress.String(),
+	)
+	require.Equal(t, []sdk.AccAddress{accAddress}, msg.GetSigners())
+}
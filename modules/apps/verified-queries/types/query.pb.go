@@ -0,0 +1,1174 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/verified_queries/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryQueryResultsRequest defines the request type for the QueryResults rpc
+type QueryQueryResultsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueryResultsRequest) Reset()         { *m = QueryQueryResultsRequest{} }
+func (m *QueryQueryResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryResultsRequest) ProtoMessage()    {}
+func (*QueryQueryResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d4a27a1e3cf217, []int{0}
+}
+func (m *QueryQueryResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueryResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryResultsRequest.Merge(m, src)
+}
+func (m *QueryQueryResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueryResultsRequest proto.InternalMessageInfo
+
+func (m *QueryQueryResultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueryResultsResponse defines the response type for the QueryResults rpc
+type QueryQueryResultsResponse struct {
+	// list of verified query results
+	Results []QueryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueryResultsResponse) Reset()         { *m = QueryQueryResultsResponse{} }
+func (m *QueryQueryResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryResultsResponse) ProtoMessage()    {}
+func (*QueryQueryResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d4a27a1e3cf217, []int{1}
+}
+func (m *QueryQueryResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueryResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryResultsResponse.Merge(m, src)
+}
+func (m *QueryQueryResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueryResultsResponse proto.InternalMessageInfo
+
+func (m *QueryQueryResultsResponse) GetResults() []QueryResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryQueryResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueryResultRequest defines the request type for the QueryResult rpc
+type QueryQueryResultRequest struct {
+	// the light client used to verify the result
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the name of the counterparty store holding the key
+	StoreKey string `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// the key within the counterparty store
+	Key []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *QueryQueryResultRequest) Reset()         { *m = QueryQueryResultRequest{} }
+func (m *QueryQueryResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryResultRequest) ProtoMessage()    {}
+func (*QueryQueryResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d4a27a1e3cf217, []int{2}
+}
+func (m *QueryQueryResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueryResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryResultRequest.Merge(m, src)
+}
+func (m *QueryQueryResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueryResultRequest proto.InternalMessageInfo
+
+func (m *QueryQueryResultRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryQueryResultRequest) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *QueryQueryResultRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// QueryQueryResultResponse defines the response type for the QueryResult rpc
+type QueryQueryResultResponse struct {
+	// the verified query result
+	Result QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+}
+
+func (m *QueryQueryResultResponse) Reset()         { *m = QueryQueryResultResponse{} }
+func (m *QueryQueryResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueryResultResponse) ProtoMessage()    {}
+func (*QueryQueryResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d4a27a1e3cf217, []int{3}
+}
+func (m *QueryQueryResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueryResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueryResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueryResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueryResultResponse.Merge(m, src)
+}
+func (m *QueryQueryResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueryResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueryResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueryResultResponse proto.InternalMessageInfo
+
+func (m *QueryQueryResultResponse) GetResult() QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return QueryResult{}
+}
+
+func init() {
+	proto.RegisterType((*QueryQueryResultsRequest)(nil), "ibc.applications.verified_queries.v1.QueryQueryResultsRequest")
+	proto.RegisterType((*QueryQueryResultsResponse)(nil), "ibc.applications.verified_queries.v1.QueryQueryResultsResponse")
+	proto.RegisterType((*QueryQueryResultRequest)(nil), "ibc.applications.verified_queries.v1.QueryQueryResultRequest")
+	proto.RegisterType((*QueryQueryResultResponse)(nil), "ibc.applications.verified_queries.v1.QueryQueryResultResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/verified_queries/v1/query.proto", fileDescriptor_59d4a27a1e3cf217)
+}
+
+var fileDescriptor_59d4a27a1e3cf217 = []byte{
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x4f,
+	0x18, 0xc6, 0x33, 0xcd, 0xff, 0x5f, 0x9b, 0x49, 0x0f, 0x32, 0x08, 0xc6, 0x28, 0x6b, 0x08, 0x6a,
+	0x83, 0x90, 0x19, 0x93, 0x1e, 0xc5, 0x16, 0x7a, 0x50, 0xa4, 0x88, 0x76, 0x3d, 0xe9, 0x25, 0xec,
+	0x6e, 0x5e, 0xd7, 0xa1, 0xc9, 0xce, 0x76, 0x67, 0x76, 0x21, 0x94, 0x5e, 0xfc, 0x04, 0x82, 0x5f,
+	0xc3, 0xbb, 0x37, 0xcf, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x4d, 0xfc, 0x20, 0xb2, 0x33, 0xb3, 0x71,
+	0xd3, 0x06, 0xad, 0xb9, 0x2c, 0xc3, 0xcc, 0x3e, 0xcf, 0xfb, 0x9b, 0xf7, 0x7d, 0x18, 0xfc, 0x80,
+	0xfb, 0x01, 0xf3, 0xe2, 0x78, 0xc4, 0x03, 0x4f, 0x71, 0x11, 0x49, 0x96, 0x41, 0xc2, 0xdf, 0x70,
+	0x18, 0x0e, 0x8e, 0x52, 0x48, 0x38, 0x48, 0x96, 0xf5, 0x58, 0xbe, 0x9c, 0xd0, 0x38, 0x11, 0x4a,
+	0x90, 0x3b, 0xdc, 0x0f, 0x68, 0x59, 0x41, 0xcf, 0x2b, 0x68, 0xd6, 0x6b, 0x5e, 0x0b, 0x45, 0x28,
+	0xb4, 0x80, 0xe5, 0x2b, 0xa3, 0x6d, 0xde, 0x0a, 0x85, 0x08, 0x47, 0xc0, 0xbc, 0x98, 0x33, 0x2f,
+	0x8a, 0x84, 0xb2, 0x0e, 0xe6, 0xf4, 0x7e, 0x20, 0xe4, 0x58, 0x48, 0xe6, 0x7b, 0x12, 0x4c, 0x49,
+	0x96, 0xf5, 0x7c, 0x50, 0x5e, 0x8f, 0xc5, 0x5e, 0xc8, 0x23, 0xfd, 0xb3, 0xfd, 0xf7, 0xe1, 0xa5,
+	0xb8, 0x2f, 0x90, 0x69, 0x71, 0xdb, 0xc7, 0x8d, 0x83, 0xdc, 0x5e, 0x7f, 0x5c, 0x90, 0xe9, 0x48,
+	0x49, 0x17, 0x8e, 0x52, 0x90, 0x8a, 0x3c, 0xc6, 0xf8, 0x77, 0xb1, 0x06, 0x6a, 0xa1, 0x4e, 0xbd,
+	0x7f, 0x8f, 0x1a, 0x32, 0x9a, 0x93, 0x51, 0xd3, 0x0c, 0x4b, 0x46, 0x5f, 0x78, 0x21, 0x58, 0xad,
+	0x5b, 0x52, 0xb6, 0x3f, 0x21, 0x7c, 0x63, 0x49, 0x11, 0x19, 0x8b, 0x48, 0x02, 0x39, 0xc0, 0x57,
+	0x12, 0xb3, 0xd5, 0x40, 0xad, 0x6a, 0xa7, 0xde, 0xef, 0xd1, 0xcb, 0xb4, 0x95, 0x96, 0xcc, 0xf6,
+	0xfe, 0x3b, 0xfd, 0x76, 0xbb, 0xe2, 0x16, 0x3e, 0xe4, 0xc9, 0x02, 0xf8, 0x9a, 0x06, 0xdf, 0xfa,
+	0x2b, 0xb8, 0xe1, 0x59, 0x20, 0x07, 0x7c, 0xfd, 0x3c, 0x78, 0xd1, 0x9c, 0x9b, 0xb8, 0x16, 0x8c,
+	0x38, 0x44, 0x6a, 0xc0, 0x87, 0xba, 0x37, 0x35, 0x77, 0xc3, 0x6c, 0x3c, 0x1d, 0xe6, 0x87, 0x52,
+	0x89, 0x04, 0x06, 0x87, 0x30, 0xd1, 0xf5, 0x6b, 0xee, 0x86, 0xde, 0xd8, 0x87, 0x09, 0xb9, 0x8a,
+	0xab, 0xf9, 0x76, 0xb5, 0x85, 0x3a, 0x9b, 0x6e, 0xbe, 0x6c, 0x1f, 0x5e, 0x1c, 0xc2, 0xbc, 0x3d,
+	0xcf, 0xf1, 0xba, 0xb9, 0x96, 0x1d, 0xc0, 0xca, 0xdd, 0xb1, 0x36, 0xfd, 0x8f, 0x55, 0xfc, 0xbf,
+	0x3e, 0x25, 0x9f, 0x11, 0xde, 0x2c, 0x8f, 0x84, 0xec, 0xfc, 0x83, 0xf7, 0x92, 0xc0, 0x34, 0x77,
+	0x57, 0xd6, 0x9b, 0xcb, 0xb6, 0xbb, 0xef, 0xbe, 0xfc, 0xfc, 0xb0, 0xb6, 0x45, 0xee, 0x32, 0x9b,
+	0xe9, 0xe5, 0x59, 0x2e, 0xe6, 0xfc, 0x03, 0xe1, 0x7a, 0xc9, 0x87, 0x3c, 0x5a, 0xad, 0x7e, 0x81,
+	0xbf, 0xb3, 0xaa, 0xdc, 0xd2, 0xbf, 0xd4, 0xf4, 0xcf, 0xc8, 0xfe, 0x9f, 0xe9, 0x4d, 0x4a, 0x24,
+	0x3b, 0x9e, 0xe7, 0xe7, 0x84, 0xe9, 0x70, 0x48, 0x76, 0x3c, 0x4f, 0xcd, 0x89, 0xbd, 0xe4, 0xde,
+	0xab, 0xd3, 0xa9, 0x83, 0xce, 0xa6, 0x0e, 0xfa, 0x3e, 0x75, 0xd0, 0xfb, 0x99, 0x53, 0x39, 0x9b,
+	0x39, 0x95, 0xaf, 0x33, 0xa7, 0xf2, 0x7a, 0x37, 0xe4, 0xea, 0x6d, 0xea, 0xd3, 0x40, 0x8c, 0x99,
+	0x7d, 0x2e, 0xb8, 0x1f, 0x74, 0x43, 0xc1, 0xb2, 0x6d, 0x36, 0x16, 0xc3, 0x74, 0x04, 0x72, 0x91,
+	0xa2, 0x5b, 0x50, 0xa8, 0x49, 0x0c, 0xd2, 0x5f, 0xd7, 0x4f, 0xc0, 0xf6, 0xaf, 0x01, 0x00, 0x79,
+	0x37, 0x61, 0xda, 0xf9, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueryResults returns all verified query results
+	QueryResults(ctx context.Context, in *QueryQueryResultsRequest, opts ...grpc.CallOption) (*QueryQueryResultsResponse, error)
+	// QueryResult returns the verified query result of a key in a counterparty store
+	QueryResult(ctx context.Context, in *QueryQueryResultRequest, opts ...grpc.CallOption) (*QueryQueryResultResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryResults(ctx context.Context, in *QueryQueryResultsRequest, opts ...grpc.CallOption) (*QueryQueryResultsResponse, error) {
+	out := new(QueryQueryResultsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.verified_queries.v1.Query/QueryResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryResult(ctx context.Context, in *QueryQueryResultRequest, opts ...grpc.CallOption) (*QueryQueryResultResponse, error) {
+	out := new(QueryQueryResultResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.verified_queries.v1.Query/QueryResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueryResults returns all verified query results
+	QueryResults(context.Context, *QueryQueryResultsRequest) (*QueryQueryResultsResponse, error)
+	// QueryResult returns the verified query result of a key in a counterparty store
+	QueryResult(context.Context, *QueryQueryResultRequest) (*QueryQueryResultResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryResults(ctx context.Context, req *QueryQueryResultsRequest) (*QueryQueryResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryResults not implemented")
+}
+func (*UnimplementedQueryServer) QueryResult(ctx context.Context, req *QueryQueryResultRequest) (*QueryQueryResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryResult not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueryResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.verified_queries.v1.Query/QueryResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryResults(ctx, req.(*QueryQueryResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueryResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.verified_queries.v1.Query/QueryResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryResult(ctx, req.(*QueryQueryResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.verified_queries.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryResults",
+			Handler:    _Query_QueryResults_Handler,
+		},
+		{
+			MethodName: "QueryResult",
+			Handler:    _Query_QueryResult_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/verified_queries/v1/query.proto",
+}
+
+func (m *QueryQueryResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueryResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueryResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryQueryResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueryResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueryResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueryResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryQueryResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, QueryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueryResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueryResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueryResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"net/url"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewQueryResult creates a new QueryResult instance
+func NewQueryResult(clientID, storeKey string, key, value []byte, proofHeight clienttypes.Height, timestamp uint64) QueryResult {
+	return QueryResult{
+		ClientId:    clientID,
+		StoreKey:    storeKey,
+		Key:         key,
+		Value:       value,
+		ProofHeight: proofHeight,
+		Timestamp:   timestamp,
+	}
+}
+
+// Exists returns true if the query result records the presence of the key in the counterparty store
+func (qr QueryResult) Exists() bool {
+	return len(qr.Value) != 0
+}
+
+// MerklePath returns the merkle path of the key in the counterparty store
+func (qr QueryResult) MerklePath() commitmenttypes.MerklePath {
+	return NewMerklePath(qr.StoreKey, qr.Key)
+}
+
+// Validate performs a basic validation of the query result fields
+func (qr QueryResult) Validate() error {
+	if err := ValidateQueryKey(qr.ClientId, qr.StoreKey, qr.Key); err != nil {
+		return err
+	}
+
+	if qr.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeight, "proof height cannot be zero")
+	}
+
+	return nil
+}
+
+// NewMerklePath returns the merkle path of the given key in the given counterparty store. The key is URL escaped
+// since the merkle path unescapes its elements to obtain the key bytes.
+func NewMerklePath(storeKey string, key []byte) commitmenttypes.MerklePath {
+	return commitmenttypes.NewMerklePath(storeKey, url.PathEscape(string(key)))
+}
+
+// ValidateQueryKey validates the client identifier, counterparty store key and key identifying a query result
+func ValidateQueryKey(clientID, storeKey string, key []byte) error {
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(storeKey) == "" {
+		return sdkerrors.Wrap(ErrInvalidStoreKey, "store key cannot be empty")
+	}
+
+	if strings.Contains(storeKey, "/") {
+		return sdkerrors.Wrapf(ErrInvalidStoreKey, "store key %s cannot contain '/'", storeKey)
+	}
+
+	if len(key) == 0 {
+		return sdkerrors.Wrap(ErrInvalidKey, "key cannot be empty")
+	}
+
+	return nil
+}
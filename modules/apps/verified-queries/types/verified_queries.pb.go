@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/verified_queries/v1/verified_queries.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryResult defines the value of a key in a store of a counterparty chain, verified against the consensus
+// state of a light client at the height at which it was proven. An empty value records the verified absence
+// of the key.
+type QueryResult struct {
+	// the light client used to verify the result
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// the name of the counterparty store holding the key
+	StoreKey string `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty" yaml:"store_key"`
+	// the key within the counterparty store
+	Key []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// the value of the key, empty if the key is absent
+	Value []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// the height of the consensus state against which the result was verified
+	ProofHeight types.Height `protobuf:"bytes,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height" yaml:"proof_height"`
+	// the timestamp, in nanoseconds, of the consensus state against which the result was verified
+	Timestamp uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *QueryResult) Reset()         { *m = QueryResult{} }
+func (m *QueryResult) String() string { return proto.CompactTextString(m) }
+func (*QueryResult) ProtoMessage()    {}
+func (*QueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7a79329ee286c8c, []int{0}
+}
+func (m *QueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResult.Merge(m, src)
+}
+func (m *QueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResult proto.InternalMessageInfo
+
+func (m *QueryResult) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryResult) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *QueryResult) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *QueryResult) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryResult) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
+func (m *QueryResult) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryResult)(nil), "ibc.applications.verified_queries.v1.QueryResult")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/verified_queries/v1/verified_queries.proto", fileDescriptor_e7a79329ee286c8c)
+}
+
+var fileDescriptor_e7a79329ee286c8c = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x31, 0xae, 0xda, 0x40,
+	0x10, 0x86, 0xbd, 0xef, 0x01, 0x02, 0x43, 0x81, 0x1c, 0x0a, 0x8b, 0x44, 0xb6, 0x65, 0xa5, 0x70,
+	0x83, 0x57, 0x0e, 0x5d, 0x52, 0x44, 0xa2, 0x4a, 0x94, 0x2a, 0xee, 0x42, 0x63, 0xd9, 0xeb, 0xc1,
+	0xac, 0x62, 0xb3, 0x8e, 0x77, 0x6d, 0xc9, 0xb7, 0x48, 0x93, 0x3b, 0x51, 0x52, 0xa6, 0x42, 0x11,
+	0xdc, 0x80, 0x13, 0x44, 0xeb, 0x4d, 0x00, 0x3d, 0xba, 0x7f, 0x66, 0xfe, 0x4f, 0xa3, 0x99, 0x5f,
+	0xff, 0x40, 0x13, 0x82, 0xe3, 0xb2, 0xcc, 0x29, 0x89, 0x05, 0x65, 0x3b, 0x8e, 0x1b, 0xa8, 0xe8,
+	0x86, 0x42, 0x1a, 0xfd, 0xa8, 0xa1, 0xa2, 0xc0, 0x71, 0x13, 0x3c, 0xf4, 0xfc, 0xb2, 0x62, 0x82,
+	0x19, 0x6f, 0x69, 0x42, 0xfc, 0x7b, 0xd8, 0x7f, 0x30, 0x36, 0xc1, 0x7c, 0x96, 0xb1, 0x8c, 0x75,
+	0x00, 0x96, 0x4a, 0xb1, 0x73, 0x5b, 0x2e, 0x26, 0xac, 0x02, 0x4c, 0x72, 0x0a, 0x3b, 0x21, 0xd7,
+	0x28, 0xa5, 0x0c, 0xee, 0xaf, 0x27, 0x7d, 0xfc, 0xb5, 0x86, 0xaa, 0x0d, 0x81, 0xd7, 0xb9, 0x30,
+	0x02, 0x7d, 0xa4, 0xe6, 0x11, 0x4d, 0x4d, 0xe4, 0x20, 0x6f, 0xb4, 0x9a, 0x5d, 0x8e, 0xf6, 0xb4,
+	0x8d, 0x8b, 0xfc, 0xbd, 0x7b, 0x1d, 0xb9, 0xe1, 0x50, 0xe9, 0xcf, 0xa9, 0x44, 0xb8, 0x60, 0x15,
+	0x44, 0xdf, 0xa1, 0x35, 0x9f, 0x5e, 0x22, 0xd7, 0x91, 0x1b, 0x0e, 0x3b, 0xfd, 0x05, 0x5a, 0x63,
+	0xaa, 0x3f, 0x4b, 0xf3, 0xb3, 0x83, 0xbc, 0x49, 0x28, 0xa5, 0x31, 0xd3, 0xfb, 0x4d, 0x9c, 0xd7,
+	0x60, 0xf6, 0xba, 0x9e, 0x2a, 0x8c, 0xb5, 0x3e, 0x29, 0x2b, 0xc6, 0x36, 0xd1, 0x16, 0x68, 0xb6,
+	0x15, 0x66, 0xdf, 0x41, 0xde, 0xf8, 0xdd, 0xdc, 0x97, 0x1f, 0x91, 0x57, 0xf9, 0xff, 0x6e, 0x69,
+	0x02, 0xff, 0x53, 0xe7, 0x58, 0xbd, 0xde, 0x1f, 0x6d, 0xed, 0x72, 0xb4, 0x5f, 0xa9, 0xed, 0xf7,
+	0xb4, 0x1b, 0x8e, 0xbb, 0x52, 0x39, 0x8d, 0x37, 0xfa, 0x48, 0xd0, 0x02, 0xb8, 0x88, 0x8b, 0xd2,
+	0x1c, 0x38, 0xc8, 0xeb, 0x85, 0xb7, 0xc6, 0xea, 0xdb, 0xfe, 0x64, 0xa1, 0xc3, 0xc9, 0x42, 0x7f,
+	0x4e, 0x16, 0xfa, 0x79, 0xb6, 0xb4, 0xc3, 0xd9, 0xd2, 0x7e, 0x9f, 0x2d, 0x6d, 0xfd, 0x31, 0xa3,
+	0x62, 0x5b, 0x27, 0x3e, 0x61, 0x05, 0x26, 0x8c, 0x17, 0x8c, 0x63, 0x9a, 0x90, 0x45, 0xc6, 0x70,
+	0xb3, 0xc4, 0x05, 0x4b, 0xeb, 0x1c, 0xb8, 0xcc, 0xfa, 0x96, 0xf1, 0xe2, 0x7f, 0xc6, 0xa2, 0x2d,
+	0x81, 0x27, 0x83, 0xee, 0xf3, 0xcb, 0xbf, 0x03, 0x00, 0x84, 0x78, 0x3c, 0x98, 0x15, 0x02, 0x00,
+	0x00,
+}
+
+func (m *QueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintVerifiedQueries(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVerifiedQueries(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintVerifiedQueries(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintVerifiedQueries(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintVerifiedQueries(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintVerifiedQueries(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVerifiedQueries(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVerifiedQueries(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovVerifiedQueries(uint64(l))
+	}
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovVerifiedQueries(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovVerifiedQueries(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovVerifiedQueries(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovVerifiedQueries(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovVerifiedQueries(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovVerifiedQueries(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVerifiedQueries(x uint64) (n int) {
+	return sovVerifiedQueries(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVerifiedQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVerifiedQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVerifiedQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVerifiedQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVerifiedQueries(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVerifiedQueries
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVerifiedQueries
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVerifiedQueries
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVerifiedQueries
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVerifiedQueries
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVerifiedQueries
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVerifiedQueries        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVerifiedQueries          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVerifiedQueries = fmt.Errorf("proto: unexpected end of group")
+)
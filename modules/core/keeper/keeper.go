@@ -30,6 +30,8 @@ type Keeper struct {
 	ChannelKeeper    channelkeeper.Keeper
 	PortKeeper       portkeeper.Keeper
 	Router           *porttypes.Router
+
+	hooks types.RelayerHooks
 }
 
 // NewKeeper creates a new ibc Keeper
@@ -76,3 +78,13 @@ func (k *Keeper) SetRouter(rtr *porttypes.Router) {
 	k.Router = rtr
 	k.Router.Seal()
 }
+
+// SetRelayerHooks sets the RelayerHooks in IBC Keeper. The method panics if
+// the hooks have already been set.
+func (k *Keeper) SetRelayerHooks(hooks types.RelayerHooks) {
+	if k.hooks != nil {
+		panic("cannot set relayer hooks twice")
+	}
+
+	k.hooks = hooks
+}
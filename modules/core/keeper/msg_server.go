@@ -50,10 +50,27 @@ func (k Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateCl
 		return nil, err
 	}
 
+	clientState, found := k.ClientKeeper.GetClientState(ctx, msg.ClientId)
+	if !found {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "cannot update client with ID %s", msg.ClientId)
+	}
+
+	// the latest height is captured before the update, so that the hooks can tell whether the update advanced the client
+	previousHeight := clientState.GetLatestHeight()
+
 	if err = k.ClientKeeper.UpdateClient(ctx, msg.ClientId, header); err != nil {
 		return nil, err
 	}
 
+	if k.hooks != nil {
+		relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+		}
+
+		k.hooks.AfterClientUpdate(ctx, msg.ClientId, previousHeight, relayer)
+	}
+
 	return &clienttypes.MsgUpdateClientResponse{}, nil
 }
 
@@ -302,6 +319,15 @@ func (k Keeper) ChannelOpenConfirm(goCtx context.Context, msg *channeltypes.MsgC
 	// Write channel into state
 	k.ChannelKeeper.WriteOpenConfirmChannel(ctx, msg.PortId, msg.ChannelId)
 
+	if k.hooks != nil {
+		relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+		}
+
+		k.hooks.AfterChanOpenConfirm(ctx, msg.PortId, msg.ChannelId, relayer)
+	}
+
 	return &channeltypes.MsgChannelOpenConfirmResponse{}, nil
 }
 
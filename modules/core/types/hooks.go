@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// RelayerHooks defines the hooks invoked by the IBC message server with the address of the relayer
+// which submitted a successfully executed client update or channel handshake message. The hooks
+// cannot fail the execution of the message.
+type RelayerHooks interface {
+	// AfterClientUpdate is called after a MsgUpdateClient for the given client has been executed with
+	// the latest height of the client before the update
+	AfterClientUpdate(ctx sdk.Context, clientID string, previousHeight exported.Height, relayer sdk.AccAddress)
+	// AfterChanOpenConfirm is called after a MsgChannelOpenConfirm for the given channel has been executed
+	// and the channel has been written to state
+	AfterChanOpenConfirm(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress)
+}
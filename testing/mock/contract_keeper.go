@@ -0,0 +1,92 @@
+package mock
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+const (
+	// SuccessContract is the address of a mock callback target which succeeds
+	SuccessContract = "successcontract"
+	// ErrorContract is the address of a mock callback target which returns an error
+	ErrorContract = "errorcontract"
+	// PanicContract is the address of a mock callback target which panics
+	PanicContract = "panicscontract"
+	// OutOfGasContract is the address of a mock callback target which runs out of gas
+	OutOfGasContract = "oogcontract"
+
+	// CallbackGasConsumed is the amount of gas consumed by mock callback targets which do not run out of gas
+	CallbackGasConsumed = uint64(100000)
+
+	// EventTypeMockCallback is the event emitted by mock callback targets
+	EventTypeMockCallback = "mock_callback"
+	// AttributeKeyCallbackType is the attribute of the mock callback event containing the callback type
+	AttributeKeyCallbackType = "callback_type"
+)
+
+// ContractKeeper is a mock implementation of the contract keeper of the callbacks middleware. The behaviour
+// of a callback depends on the callback address. Every callback consumes CallbackGasConsumed gas and emits
+// an event before succeeding, returning an error, panicking or running out of gas. The number of
+// callbacks executed for each address is counted, regardless of their result.
+type ContractKeeper struct {
+	Counters map[string]int
+}
+
+// NewContractKeeper creates a new mock ContractKeeper
+func NewContractKeeper() *ContractKeeper {
+	return &ContractKeeper{
+		Counters: make(map[string]int),
+	}
+}
+
+// IBCOnAcknowledgementPacketCallback implements the contract keeper interface of the callbacks middleware
+func (k *ContractKeeper) IBCOnAcknowledgementPacketCallback(
+	ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress,
+	contractAddress, packetSenderAddress string,
+) error {
+	return k.processMockCallback(ctx, "acknowledgement_packet", contractAddress)
+}
+
+// IBCOnTimeoutPacketCallback implements the contract keeper interface of the callbacks middleware
+func (k *ContractKeeper) IBCOnTimeoutPacketCallback(
+	ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress,
+	contractAddress, packetSenderAddress string,
+) error {
+	return k.processMockCallback(ctx, "timeout_packet", contractAddress)
+}
+
+// IBCReceivePacketCallback implements the contract keeper interface of the callbacks middleware
+func (k *ContractKeeper) IBCReceivePacketCallback(
+	ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement, contractAddress string,
+) error {
+	return k.processMockCallback(ctx, "receive_packet", contractAddress)
+}
+
+// processMockCallback executes the mock behaviour of the given callback address
+func (k *ContractKeeper) processMockCallback(ctx sdk.Context, callbackType, contractAddress string) error {
+	k.Counters[contractAddress]++
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMockCallback, sdk.NewAttribute(AttributeKeyCallbackType, callbackType)),
+	)
+
+	switch contractAddress {
+	case OutOfGasContract:
+		ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "mock out of gas callback")
+	default:
+		ctx.GasMeter().ConsumeGas(CallbackGasConsumed, "mock callback")
+	}
+
+	switch contractAddress {
+	case ErrorContract:
+		return fmt.Errorf("mock %s callback failed", callbackType)
+	case PanicContract:
+		panic(fmt.Errorf("mock %s callback panicked", callbackType))
+	default:
+		return nil
+	}
+}
@@ -86,15 +86,29 @@ import (
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
+	icahostclient "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/client"
 	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibcfee "github.com/cosmos/ibc-go/v3/modules/apps/29-fee"
+	ibcfeeclient "github.com/cosmos/ibc-go/v3/modules/apps/29-fee/client"
 	ibcfeekeeper "github.com/cosmos/ibc-go/v3/modules/apps/29-fee/keeper"
 	ibcfeetypes "github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	ibccallbacks "github.com/cosmos/ibc-go/v3/modules/apps/callbacks"
+	packetforward "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward"
+	packetforwardkeeper "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	ratelimiting "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting"
+	ratelimitingclient "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/client"
+	ratelimitingkeeper "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/keeper"
+	ratelimitingtypes "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
 	transfer "github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	transferclient "github.com/cosmos/ibc-go/v3/modules/apps/transfer/client"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	verifiedqueries "github.com/cosmos/ibc-go/v3/modules/apps/verified-queries"
+	verifiedquerieskeeper "github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/keeper"
+	verifiedqueriestypes "github.com/cosmos/ibc-go/v3/modules/apps/verified-queries/types"
 	ibc "github.com/cosmos/ibc-go/v3/modules/core"
 	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/02-client"
 	ibcclientclient "github.com/cosmos/ibc-go/v3/modules/core/02-client/client"
@@ -119,6 +133,9 @@ const (
 	MockFeePort string = ibcmock.ModuleName + ibcfeetypes.ModuleName
 )
 
+// MaxCallbackGas is the maximum amount of gas a callback executed by the callbacks middleware may consume
+const MaxCallbackGas = uint64(500000)
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -137,6 +154,10 @@ var (
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			transferclient.TransferEnabledProposalHandler,
+			ratelimitingclient.SetRateLimitProposalHandler, ratelimitingclient.RemoveRateLimitProposalHandler,
+			icahostclient.MigrateInterchainAccountProposalHandler,
+			ibcfeeclient.UnlockFeeModuleProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -151,6 +172,9 @@ var (
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
+		ratelimiting.AppModuleBasic{},
+		verifiedqueries.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -189,25 +213,28 @@ type SimApp struct {
 	memKeys map[string]*sdk.MemoryStoreKey
 
 	// keepers
-	AccountKeeper       authkeeper.AccountKeeper
-	BankKeeper          bankkeeper.Keeper
-	CapabilityKeeper    *capabilitykeeper.Keeper
-	StakingKeeper       stakingkeeper.Keeper
-	SlashingKeeper      slashingkeeper.Keeper
-	MintKeeper          mintkeeper.Keeper
-	DistrKeeper         distrkeeper.Keeper
-	GovKeeper           govkeeper.Keeper
-	CrisisKeeper        crisiskeeper.Keeper
-	UpgradeKeeper       upgradekeeper.Keeper
-	ParamsKeeper        paramskeeper.Keeper
-	AuthzKeeper         authzkeeper.Keeper
-	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	IBCFeeKeeper        ibcfeekeeper.Keeper
-	ICAControllerKeeper icacontrollerkeeper.Keeper
-	ICAHostKeeper       icahostkeeper.Keeper
-	EvidenceKeeper      evidencekeeper.Keeper
-	TransferKeeper      ibctransferkeeper.Keeper
-	FeeGrantKeeper      feegrantkeeper.Keeper
+	AccountKeeper         authkeeper.AccountKeeper
+	BankKeeper            bankkeeper.Keeper
+	CapabilityKeeper      *capabilitykeeper.Keeper
+	StakingKeeper         stakingkeeper.Keeper
+	SlashingKeeper        slashingkeeper.Keeper
+	MintKeeper            mintkeeper.Keeper
+	DistrKeeper           distrkeeper.Keeper
+	GovKeeper             govkeeper.Keeper
+	CrisisKeeper          crisiskeeper.Keeper
+	UpgradeKeeper         upgradekeeper.Keeper
+	ParamsKeeper          paramskeeper.Keeper
+	AuthzKeeper           authzkeeper.Keeper
+	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	IBCFeeKeeper          ibcfeekeeper.Keeper
+	PacketForwardKeeper   packetforwardkeeper.Keeper
+	RateLimitingKeeper    ratelimitingkeeper.Keeper
+	VerifiedQueriesKeeper verifiedquerieskeeper.Keeper
+	ICAControllerKeeper   icacontrollerkeeper.Keeper
+	ICAHostKeeper         icahostkeeper.Keeper
+	EvidenceKeeper        evidencekeeper.Keeper
+	TransferKeeper        ibctransferkeeper.Keeper
+	FeeGrantKeeper        feegrantkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
@@ -224,6 +251,9 @@ type SimApp struct {
 	ICAAuthModule ibcmock.IBCModule
 	FeeMockModule ibcmock.IBCModule
 
+	// make mock contract keeper public for test purposes
+	MockContractKeeper *ibcmock.ContractKeeper
+
 	// the module manager
 	mm *module.Manager
 
@@ -263,7 +293,8 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey, ibcfeetypes.StoreKey,
+		authzkeeper.StoreKey, ibcfeetypes.StoreKey, packetforwardtypes.StoreKey,
+		ratelimitingtypes.StoreKey, verifiedqueriestypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -341,34 +372,88 @@ func NewSimApp(
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(appCodec, keys[ibcfeetypes.StoreKey], app.GetSubspace(ibcfeetypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ClientKeeper, &app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Create Rate Limiting Keeper and pass IBCFeeKeeper as expected ICS4Wrapper
+	// since fee middleware will wrap the IBCKeeper for the rate limiting middleware.
+	app.RateLimitingKeeper = ratelimitingkeeper.NewKeeper(
+		appCodec, keys[ratelimitingtypes.StoreKey], app.IBCFeeKeeper, app.BankKeeper,
+	)
+	rateLimitingModule := ratelimiting.NewAppModule(app.RateLimitingKeeper)
+
+	// Create Verified Queries Keeper which verifies counterparty state against the IBC light clients
+	app.VerifiedQueriesKeeper = verifiedquerieskeeper.NewKeeper(
+		appCodec, keys[verifiedqueriestypes.StoreKey], app.IBCKeeper.ClientKeeper,
+	)
+	verifiedQueriesModule := verifiedqueries.NewAppModule(app.VerifiedQueriesKeeper)
+
+	// Create Transfer Keeper and pass RateLimitingKeeper as expected ICS4Wrapper
+	// since rate limiting middleware will wrap the fee middleware for underlying application.
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		app.RateLimitingKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
+	)
+	transferModule := transfer.NewAppModule(app.TransferKeeper)
+	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCFeeKeeper, // use ics29 fee as ics4Wrapper in middleware stack
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper, app.MsgServiceRouter(),
+	)
+
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCFeeKeeper, // use ics29 fee as ics4Wrapper in middleware stack
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(), app.GRPCQueryRouter(),
+	)
+
 	// register the proposal types
+	// NOTE: the gov keeper is created after the transfer, fee, rate limiting and interchain accounts host keepers since they handle proposals
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcfee.NewClientProposalHandler(app.IBCFeeKeeper, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))).
+		AddRoute(ibctransfertypes.RouterKey, transfer.NewTransferProposalHandler(app.TransferKeeper)).
+		AddRoute(ratelimitingtypes.RouterKey, ratelimiting.NewRateLimitProposalHandler(app.RateLimitingKeeper)).
+		AddRoute(icahosttypes.RouterKey, icahost.NewProposalHandler(app.ICAHostKeeper)).
+		AddRoute(ibcfeetypes.RouterKey, ibcfee.NewProposalHandler(app.IBCFeeKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
 	)
 
-	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(appCodec, keys[ibcfeetypes.StoreKey], app.GetSubspace(ibcfeetypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
+	// Create Packet Forward Keeper and pass RateLimitingKeeper as expected ICS4Wrapper
+	// since the forward middleware writes asynchronous acknowledgements through the rate limiting middleware.
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec, keys[packetforwardtypes.StoreKey], app.TransferKeeper,
+		app.RateLimitingKeeper, app.IBCKeeper.ChannelKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
+	packetForwardModule := packetforward.NewAppModule(app.PacketForwardKeeper)
 
-	// Create Transfer Keeper and pass IBCFeeKeeper as expected Channel and PortKeeper
-	// since fee middleware will wrap the IBCKeeper for underlying application.
-	app.TransferKeeper = ibctransferkeeper.NewKeeper(
-		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCFeeKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
-	)
-	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+	// NOTE: the mock contract keeper executes the callbacks registered in packet data for testing purposes.
+	// Chains should provide a contract keeper dispatching callbacks to their modules or smart contracts.
+	app.MockContractKeeper = ibcmock.NewContractKeeper()
+
+	// create callbacks wrapped transfer module, passing RateLimitingKeeper as expected ICS4Wrapper
+	callbacksTransferModule := ibccallbacks.NewIBCMiddleware(transferIBCModule, app.RateLimitingKeeper, app.MockContractKeeper, MaxCallbackGas)
+
+	// create packet forward wrapped callbacks transfer module
+	packetForwardTransferModule := packetforward.NewIBCMiddleware(app.PacketForwardKeeper, callbacksTransferModule)
+
+	// create rate limited packet forward transfer module
+	rateLimitedTransferModule := ratelimiting.NewIBCMiddleware(app.RateLimitingKeeper, packetForwardTransferModule)
 
-	// create fee-wrapped transfer module
-	feeTransferModule := ibcfee.NewIBCModule(app.IBCFeeKeeper, transferIBCModule)
+	// create fee-wrapped rate limited packet forward transfer module
+	feeTransferModule := ibcfee.NewIBCModule(app.IBCFeeKeeper, rateLimitedTransferModule)
 
 	feeModule := ibcfee.NewAppModule(app.IBCFeeKeeper)
 
@@ -384,27 +469,22 @@ func NewSimApp(
 
 	mockIBCModule := ibcmock.NewIBCModule(&mockModule, ibcmock.NewMockIBCApp(ibcmock.ModuleName, scopedIBCMockKeeper))
 
-	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
-		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
-		app.IBCKeeper.ChannelKeeper, // may be replaced with middleware such as ics29 fee
-		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		scopedICAControllerKeeper, app.MsgServiceRouter(),
-	)
-
-	app.ICAHostKeeper = icahostkeeper.NewKeeper(
-		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
-		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
-	)
-
 	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
 
 	// initialize ICA module with mock module as the authentication module on the controller side
 	icaAuthModule := ibcmock.NewIBCModule(&mockModule, ibcmock.NewMockIBCApp("", scopedICAMockKeeper))
 	app.ICAAuthModule = icaAuthModule
 
-	icaControllerIBCModule := icacontroller.NewIBCModule(app.ICAControllerKeeper, icaAuthModule)
-	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+	// create fee and callbacks wrapped interchain accounts controller and host modules, passing IBCFeeKeeper as
+	// expected ICS4Wrapper of the callbacks middleware since the fee middleware wraps it
+	icaControllerIBCModule := ibcfee.NewIBCModule(app.IBCFeeKeeper, ibccallbacks.NewIBCMiddleware(
+		icacontroller.NewIBCModule(app.ICAControllerKeeper, icaAuthModule),
+		app.IBCFeeKeeper, app.MockContractKeeper, MaxCallbackGas,
+	))
+	icaHostIBCModule := ibcfee.NewIBCModule(app.IBCFeeKeeper, ibccallbacks.NewIBCMiddleware(
+		icahost.NewIBCModule(app.ICAHostKeeper),
+		app.IBCFeeKeeper, app.MockContractKeeper, MaxCallbackGas,
+	))
 
 	// Create static IBC router, add app routes, then set and seal it
 	// pass in top-level (fully-wrapped) IBCModules to IBC Router
@@ -418,6 +498,9 @@ func NewSimApp(
 
 	app.IBCKeeper.SetRouter(ibcRouter)
 
+	// pay the fee middleware client update and channel handshake bounties to relayers
+	app.IBCKeeper.SetRelayerHooks(app.IBCFeeKeeper)
+
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -456,6 +539,9 @@ func NewSimApp(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		transferModule,
 		feeModule,
+		packetForwardModule,
+		rateLimitingModule,
+		verifiedQueriesModule,
 		icaModule,
 		mockModule,
 	)
@@ -469,13 +555,13 @@ func NewSimApp(
 		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName, authtypes.ModuleName,
 		banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
-		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName,
+		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, packetforwardtypes.ModuleName, ratelimitingtypes.ModuleName, verifiedqueriestypes.ModuleName, ibcmock.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, feegrant.ModuleName, paramstypes.ModuleName,
-		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName,
+		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, packetforwardtypes.ModuleName, ratelimitingtypes.ModuleName, verifiedqueriestypes.ModuleName, ibcmock.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -487,7 +573,7 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
-		icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		icatypes.ModuleName, ibcfeetypes.ModuleName, packetforwardtypes.ModuleName, ratelimitingtypes.ModuleName, verifiedqueriestypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -763,6 +849,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(ibcfeetypes.ModuleName)
 
 	return paramsKeeper
 }